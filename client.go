@@ -23,6 +23,7 @@ package grammes
 import (
 	"sync"
 
+	"github.com/northwesternmutual/grammes/dialect"
 	"github.com/northwesternmutual/grammes/gremconnect"
 	"github.com/northwesternmutual/grammes/gremerror"
 	"github.com/northwesternmutual/grammes/logging"
@@ -47,6 +48,15 @@ type Client struct {
 	// The gremlinVersion is defaulted to 2. Grammes supports 2 and 3.
 	// Neptune: https://docs.aws.amazon.com/neptune/latest/userguide/access-graph-gremlin-differences.html
 	gremlinVersion string
+	// tinkerpopVersion is the version of TinkerPop run by the server,
+	// such as "3.6.2". When it's empty the newest steps are used.
+	tinkerpopVersion string
+	// inlineValues keeps the string values of traversals
+	// in the scripts instead of sending them as bindings.
+	inlineValues bool
+	// dialect is the Gremlin understood by the graph database.
+	// Queries that it doesn't support aren't sent.
+	dialect *dialect.Dialect
 	// errs is a channel to pass errors that involve connection,
 	// responses, and requests to and from the TinkerPop server.
 	err chan error
@@ -94,6 +104,9 @@ func Dial(conn gremconnect.Dialer, cfgs ...ClientConfiguration) (*Client, error)
 
 	// GraphManager should be set because it's after the connection is created.
 	c.GraphManager = manager.NewGraphManager(c.conn, c.logger, c.executeRequest)
+	c.GraphManager.SetTinkerPopVersion(c.tinkerpopVersion)
+	c.GraphManager.SetInlineValues(c.inlineValues)
+	c.GraphManager.SetDialect(c.dialect)
 
 	return c, nil
 }
@@ -0,0 +1,310 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+/*
+Command grammes-schema generates a schema definition from tagged structs.
+
+It is meant to be run with go generate:
+
+	//go:generate grammes-schema -type Person,Knows
+
+This reads the structs named by -type from the Go files in the
+current directory and writes a model.Schema variable holding their
+vertex labels, edge labels, property keys, indexes, and connections.
+The struct tags are read and the data types are inferred the same way
+as schema.FromStructs does. The package is type checked with the
+packages it imports read from source, so structs embedded from other
+packages are added as well.
+
+Usage:
+
+	grammes-schema -type T1,T2 [-var Schema] [-output grammes_schema.go] [-dir .]
+*/
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/northwesternmutual/grammes/schema"
+)
+
+const schemaPath = "github.com/northwesternmutual/grammes/schema"
+
+func main() {
+	var (
+		types   = flag.String("type", "", "comma separated list of struct names; required")
+		varName = flag.String("var", "Schema", "name of the generated variable")
+		output  = flag.String("output", "grammes_schema.go", "name of the generated file")
+		dir     = flag.String("dir", ".", "directory of the package holding the structs")
+	)
+	flag.Parse()
+
+	if *types == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	src, err := generate(*dir, strings.Split(*types, ","), *varName)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "grammes-schema:", err)
+		os.Exit(1)
+	}
+
+	if err = os.WriteFile(filepath.Join(*dir, *output), src, 0644); err != nil {
+		fmt.Fprintln(os.Stderr, "grammes-schema:", err)
+		os.Exit(1)
+	}
+}
+
+// generate returns the source of the file holding the
+// schema generated from the named structs in the directory.
+func generate(dir string, names []string, varName string) ([]byte, error) {
+	pkg, err := checkPackage(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	b := schema.NewBuilder()
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		obj, ok := pkg.Scope().Lookup(name).(*types.TypeName)
+		if !ok {
+			return nil, fmt.Errorf("type %s not found in %s", name, dir)
+		}
+		st, ok := obj.Type().Underlying().(*types.Struct)
+		if !ok {
+			return nil, fmt.Errorf("type %s is not a struct", name)
+		}
+
+		tag, edge := markerTag(st)
+		if err = b.Element(name, tag, edge); err != nil {
+			return nil, err
+		}
+		if err = addFields(b, st); err != nil {
+			return nil, err
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by grammes-schema; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package %s\n\n", pkg.Name())
+	fmt.Fprintf(&buf, "import %q\n\n", "github.com/northwesternmutual/grammes/model")
+	fmt.Fprintf(&buf, "// %s is the schema generated from %s.\n", varName, strings.Join(names, ", "))
+	fmt.Fprintf(&buf, "var %s = %s\n", varName, literal(reflect.ValueOf(b.Schema())))
+
+	return format.Source(buf.Bytes())
+}
+
+// checkPackage type checks the non-test Go files of the
+// directory, importing other packages from their source.
+// Type errors are ignored since the package may refer to
+// the schema that hasn't been generated yet.
+func checkPackage(dir string) (*types.Package, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	fset := token.NewFileSet()
+	var parsed []*ast.File
+	for _, name := range files {
+		if strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, name, nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, f)
+	}
+	if len(parsed) == 0 {
+		return nil, errors.New("no Go files in " + dir)
+	}
+
+	conf := types.Config{
+		Importer: importer.ForCompiler(fset, "source", nil),
+		Error:    func(error) {},
+	}
+	pkg, _ := conf.Check(parsed[0].Name.Name, fset, parsed, nil)
+	return pkg, nil
+}
+
+// marker returns whether the type is the Vertex
+// or Edge marker, and whether it's an edge.
+func marker(t types.Type) (bool, bool) {
+	name, ok := namedType(t, schemaPath)
+	return name == "Vertex" || name == "Edge", ok && name == "Edge"
+}
+
+// namedType returns the name of the type
+// if it's declared in the package.
+func namedType(t types.Type, path string) (string, bool) {
+	n, ok := t.(*types.Named)
+	if !ok || n.Obj().Pkg() == nil || n.Obj().Pkg().Path() != path {
+		return "", false
+	}
+	return n.Obj().Name(), true
+}
+
+func markerTag(st *types.Struct) (string, bool) {
+	for i := 0; i < st.NumFields(); i++ {
+		if ok, edge := marker(st.Field(i).Type()); ok {
+			return reflect.StructTag(st.Tag(i)).Get(schema.TagName), edge
+		}
+	}
+	return "", false
+}
+
+// addFields adds a property for every exported field of the
+// struct, the same way as schema.FromStructs does.
+func addFields(b *schema.Builder, st *types.Struct) error {
+	for i := 0; i < st.NumFields(); i++ {
+		f := st.Field(i)
+		if ok, _ := marker(f.Type()); ok {
+			continue
+		}
+		tag, tagged := reflect.StructTag(st.Tag(i)).Lookup(schema.TagName)
+		if embedded, ok := f.Type().Underlying().(*types.Struct); ok && f.Embedded() && !tagged && !isTime(f.Type()) {
+			if err := addFields(b, embedded); err != nil {
+				return err
+			}
+			continue
+		}
+		if !f.Exported() {
+			continue
+		}
+
+		dt, card := schema.InferType(reflectType(f.Type(), 0))
+		if err := b.Property(f.Name(), tag, dt, card); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	timeType      = reflect.TypeOf(time.Time{})
+	interfaceType = reflect.TypeOf((*interface{})(nil)).Elem()
+	emptyType     = reflect.TypeOf(struct{}{})
+	// otherType stands for every type without a data type.
+	otherType = reflect.TypeOf(struct{ _ chan struct{} }{})
+)
+
+// basicTypes maps the basic types to their reflect types.
+var basicTypes = map[types.BasicKind]reflect.Type{
+	types.Bool:    reflect.TypeOf(false),
+	types.Int:     reflect.TypeOf(int(0)),
+	types.Int8:    reflect.TypeOf(int8(0)),
+	types.Int16:   reflect.TypeOf(int16(0)),
+	types.Int32:   reflect.TypeOf(int32(0)),
+	types.Int64:   reflect.TypeOf(int64(0)),
+	types.Uint:    reflect.TypeOf(uint(0)),
+	types.Uint8:   reflect.TypeOf(uint8(0)),
+	types.Uint16:  reflect.TypeOf(uint16(0)),
+	types.Uint32:  reflect.TypeOf(uint32(0)),
+	types.Uint64:  reflect.TypeOf(uint64(0)),
+	types.Float32: reflect.TypeOf(float32(0)),
+	types.Float64: reflect.TypeOf(float64(0)),
+	types.String:  reflect.TypeOf(""),
+}
+
+func isTime(t types.Type) bool {
+	name, ok := namedType(t, "time")
+	return ok && name == "Time"
+}
+
+// reflectType returns a reflect type with the same shape as the
+// type, so schema.InferType maps it the way it does at run time.
+func reflectType(t types.Type, depth int) reflect.Type {
+	if depth > 10 {
+		return otherType
+	}
+	if isTime(t) {
+		return timeType
+	}
+
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		if rt, ok := basicTypes[u.Kind()]; ok {
+			return rt
+		}
+	case *types.Pointer:
+		return reflect.PtrTo(reflectType(u.Elem(), depth+1))
+	case *types.Slice:
+		return reflect.SliceOf(reflectType(u.Elem(), depth+1))
+	case *types.Array:
+		return reflect.ArrayOf(int(u.Len()), reflectType(u.Elem(), depth+1))
+	case *types.Map:
+		return reflect.MapOf(reflectType(u.Key(), depth+1), reflectType(u.Elem(), depth+1))
+	case *types.Interface:
+		return interfaceType
+	case *types.Struct:
+		if u.NumFields() == 0 {
+			return emptyType
+		}
+	}
+	return otherType
+}
+
+// literal renders the value as a Go composite literal,
+// leaving out every field holding its zero value.
+func literal(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.Struct:
+		var b strings.Builder
+		b.WriteString(v.Type().String() + "{\n")
+		for i := 0; i < v.NumField(); i++ {
+			if v.Field(i).IsZero() {
+				continue
+			}
+			b.WriteString(v.Type().Field(i).Name + ": " + literal(v.Field(i)) + ",\n")
+		}
+		b.WriteString("}")
+		return b.String()
+	case reflect.Slice:
+		var b strings.Builder
+		b.WriteString(v.Type().String() + "{\n")
+		for i := 0; i < v.Len(); i++ {
+			b.WriteString(literal(v.Index(i)) + ",\n")
+		}
+		b.WriteString("}")
+		return b.String()
+	case reflect.String:
+		return strconv.Quote(v.String())
+	}
+	return fmt.Sprint(v.Interface())
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"path/filepath"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+const testGenerated = `// Code generated by grammes-schema; DO NOT EDIT.
+
+package people
+
+import "github.com/northwesternmutual/grammes/model"
+
+// GraphSchema is the schema generated from Person, Knows.
+var GraphSchema = model.Schema{
+	VertexLabels: []model.VertexLabelSchema{
+		model.VertexLabelSchema{
+			Name: "person",
+		},
+	},
+	EdgeLabels: []model.EdgeLabelSchema{
+		model.EdgeLabelSchema{
+			Name:         "knows",
+			Multiplicity: "MULTI",
+			Directed:     true,
+		},
+	},
+	PropertyKeys: []model.PropertyKeySchema{
+		model.PropertyKeySchema{
+			Name:        "createdAt",
+			DataType:    "Date.class",
+			Cardinality: "single",
+		},
+		model.PropertyKeySchema{
+			Name:        "updatedAt",
+			DataType:    "Date.class",
+			Cardinality: "single",
+		},
+		model.PropertyKeySchema{
+			Name:        "version",
+			DataType:    "Long.class",
+			Cardinality: "single",
+		},
+		model.PropertyKeySchema{
+			Name:        "name",
+			DataType:    "String.class",
+			Cardinality: "single",
+		},
+		model.PropertyKeySchema{
+			Name:        "status",
+			DataType:    "String.class",
+			Cardinality: "single",
+		},
+		model.PropertyKeySchema{
+			Name:        "tags",
+			DataType:    "String.class",
+			Cardinality: "set",
+		},
+		model.PropertyKeySchema{
+			Name:        "weight",
+			DataType:    "Float.class",
+			Cardinality: "single",
+		},
+	},
+	Indexes: []model.IndexSchema{
+		model.IndexSchema{
+			Name:    "byName",
+			Type:    "composite",
+			Element: "Vertex",
+			Unique:  true,
+			Keys: []model.IndexKeySchema{
+				model.IndexKeySchema{
+					Name: "name",
+				},
+			},
+		},
+	},
+	Connections: []model.ConnectionSchema{
+		model.ConnectionSchema{
+			EdgeLabel:      "knows",
+			OutVertexLabel: "person",
+			InVertexLabel:  "person",
+		},
+	},
+}
+`
+
+func TestGenerate(t *testing.T) {
+	Convey("Given a package with tagged structs", t, func() {
+		dir := filepath.Join("testdata", "people")
+
+		Convey("When generate is called", func() {
+			src, err := generate(dir, []string{"Person", "Knows"}, "GraphSchema")
+			Convey("Then the schema should be written as a Go literal", func() {
+				So(err, ShouldBeNil)
+				So(string(src), ShouldEqual, testGenerated)
+			})
+		})
+		Convey("When generate is called with an unknown type", func() {
+			_, err := generate(dir, []string{"Company"}, "GraphSchema")
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
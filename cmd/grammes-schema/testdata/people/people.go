@@ -0,0 +1,53 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package people
+
+import (
+	"time"
+
+	gs "github.com/northwesternmutual/grammes/schema"
+
+	"github.com/northwesternmutual/grammes/cmd/grammes-schema/testdata/audit"
+)
+
+type Base struct {
+	CreatedAt time.Time `grammes:"createdAt"`
+}
+
+type Status string
+
+type Person struct {
+	gs.Vertex `grammes:"person"`
+	Base
+	audit.Audit
+	Name   string `grammes:"name,index=composite,unique"`
+	Status Status
+	Tags   map[string]bool `grammes:"tags"`
+	skip   int
+}
+
+type Knows struct {
+	gs.Edge `grammes:"knows,multiplicity=MULTI,out=person,in=person"`
+	Weight  *float32 `grammes:"weight"`
+}
+
+// GraphSchema is referred to before it has been generated.
+var _ = GraphSchema
@@ -24,6 +24,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/northwesternmutual/grammes/dialect"
 	"github.com/northwesternmutual/grammes/logging"
 )
 
@@ -55,6 +56,32 @@ func WithGremlinVersion(versionNumber int) ClientConfiguration {
 	}
 }
 
+// WithTinkerPopVersion sets the version of TinkerPop run by
+// the server, such as "3.5.4", so that queries made by the
+// client only use steps the server supports.
+func WithTinkerPopVersion(version string) ClientConfiguration {
+	return func(c *Client) {
+		c.tinkerpopVersion = version
+	}
+}
+
+// WithInlineValues keeps the string values of traversals in the
+// scripts sent to the server instead of sending them as bindings.
+func WithInlineValues() ClientConfiguration {
+	return func(c *Client) {
+		c.inlineValues = true
+	}
+}
+
+// WithDialect sets the dialect of the graph database, such as
+// dialect.Neptune. Queries are checked against it before they're
+// sent, and IDs are given the representation of the database.
+func WithDialect(d *dialect.Dialect) ClientConfiguration {
+	return func(c *Client) {
+		c.dialect = d
+	}
+}
+
 // WithMaxConcurrentMessages sets the limit as to how many
 // requests can be stored in the requests buffer.
 func WithMaxConcurrentMessages(limit int) ClientConfiguration {
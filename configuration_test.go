@@ -26,6 +26,8 @@ import (
 	"time"
 
 	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/dialect"
 )
 
 func TestWithErrorChannel(t *testing.T) {
@@ -72,6 +74,49 @@ func TestWithGremlinVersion(t *testing.T) {
 	})
 }
 
+func TestWithTinkerPopVersion(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given a TinkerPop version and dialer", t, func() {
+		v := "3.5.4"
+		dialer := &mockDialerStruct{}
+		Convey("When Dial is called with TinkerPop Version", func() {
+			c, _ := mockDial(dialer, WithTinkerPopVersion(v))
+			Convey("Then the client TinkerPop version should be set", func() {
+				So(c.tinkerpopVersion, ShouldEqual, v)
+			})
+		})
+	})
+}
+
+func TestWithInlineValues(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given a dialer", t, func() {
+		dialer := &mockDialerStruct{}
+		Convey("When Dial is called with inline values", func() {
+			c, _ := mockDial(dialer, WithInlineValues())
+			Convey("Then the client should keep values inline", func() {
+				So(c.inlineValues, ShouldBeTrue)
+			})
+		})
+	})
+}
+
+func TestWithDialect(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given a dialer", t, func() {
+		dialer := &mockDialerStruct{}
+		Convey("When Dial is called with a dialect", func() {
+			c, _ := mockDial(dialer, WithDialect(dialect.Neptune))
+			Convey("Then the client should use the dialect", func() {
+				So(c.dialect, ShouldEqual, dialect.Neptune)
+			})
+		})
+	})
+}
+
 func TestWithMaxConcurrentMessages(t *testing.T) {
 	t.Parallel()
 
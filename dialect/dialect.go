@@ -0,0 +1,137 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+/*
+Package dialect describes the Gremlin understood by the different
+graph databases, so that queries can be checked before they're sent.
+
+Each database leaves out parts of Gremlin. Neptune has no lambdas and
+no management API, Cosmos DB has no match(), sack() or program() steps
+and only string IDs, and only JanusGraph has the Text and Geo predicates.
+A Dialect refuses queries that use those parts with a gremerror.DialectError,
+and rewrites them instead when the database has something equivalent.
+*/
+package dialect
+
+// idType is how a database represents the IDs of elements.
+type idType int
+
+const (
+	// anyID leaves IDs the way they are given.
+	anyID idType = iota
+	// longID gives number IDs the Long type.
+	longID
+	// stringID turns every ID into a string.
+	stringID
+)
+
+// graphAPI is how much of the graph variable a database exposes.
+type graphAPI int
+
+const (
+	// noGraph is for databases that only have the g traversal source.
+	noGraph graphAPI = iota
+	// structureGraph has the graph but not its management system.
+	structureGraph
+	// managementGraph has the graph along with its management system.
+	managementGraph
+)
+
+// Dialect is the Gremlin understood by a graph database.
+// A nil Dialect accepts every query as it is.
+type Dialect struct {
+	name string
+	// unsupported are the steps the database doesn't have.
+	unsupported map[string]bool
+	// lambdas is whether Groovy closures can be used in steps.
+	lambdas bool
+	// janusGraph is whether the Text and Geo predicates can be used.
+	janusGraph bool
+	graph      graphAPI
+	ids        idType
+}
+
+var (
+	// Generic accepts every query as it is. It's
+	// used when the database isn't known.
+	Generic = &Dialect{
+		name:       "Generic",
+		lambdas:    true,
+		janusGraph: true,
+		graph:      managementGraph,
+	}
+	// JanusGraph has all of Gremlin, its own
+	// predicates and the management system.
+	JanusGraph = &Dialect{
+		name:       "JanusGraph",
+		lambdas:    true,
+		janusGraph: true,
+		graph:      managementGraph,
+	}
+	// TinkerGraph has all of Gremlin and the graph, but
+	// no management system. The IDs it makes are Longs.
+	TinkerGraph = &Dialect{
+		name:    "TinkerGraph",
+		lambdas: true,
+		graph:   structureGraph,
+		ids:     longID,
+	}
+	// Neptune has no lambdas, graph computer or graph
+	// variable, and its IDs are strings.
+	// https://docs.aws.amazon.com/neptune/latest/userguide/access-graph-gremlin-differences.html
+	Neptune = &Dialect{
+		name:        "Neptune",
+		unsupported: set("io", "program", "withComputer"),
+		ids:         stringID,
+	}
+	// CosmosDB has no lambdas, graph variable or match(),
+	// sack() and program() steps, and its IDs are strings.
+	// https://docs.microsoft.com/azure/cosmos-db/gremlin-support
+	CosmosDB = &Dialect{
+		name:        "CosmosDB",
+		unsupported: set("match", "sack", "withSack", "program", "withComputer", "io"),
+		ids:         stringID,
+	}
+)
+
+func set(names ...string) map[string]bool {
+	m := make(map[string]bool, len(names))
+	for _, n := range names {
+		m[n] = true
+	}
+	return m
+}
+
+// Name returns the name of the dialect, such as Neptune.
+func (d *Dialect) Name() string {
+	if d == nil {
+		return Generic.name
+	}
+	return d.name
+}
+
+// Supports returns whether the database has the step.
+func (d *Dialect) Supports(step string) bool {
+	return d == nil || !d.unsupported[step]
+}
+
+func (d *Dialect) String() string {
+	return d.Name()
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dialect
+
+import (
+	"regexp"
+
+	"github.com/northwesternmutual/grammes/query/graph"
+)
+
+var (
+	// graphVariable finds uses of the graph variable.
+	graphVariable = regexp.MustCompile(`(^|[^\w.])graph\.(\w+)`)
+	// managementCall finds uses of the management system of JanusGraph.
+	managementCall = regexp.MustCompile(`\b(openManagement|ManagementSystem|mgmt)\b`)
+)
+
+// Graph checks that the database has the graph variable and
+// the management system used by the graph query. The error
+// is a *gremerror.DialectError naming the call.
+func (d *Dialect) Graph(g graph.String) error {
+	return d.Script(g.String())
+}
+
+// Script checks a Groovy script the same way as Graph, for
+// scripts that use the management system through variables.
+func (d *Dialect) Script(script string) error {
+	if d == nil {
+		return nil
+	}
+
+	if d.graph < managementGraph {
+		if m := managementCall.FindStringSubmatch(script); m != nil {
+			return d.error(m[1], "the management system is")
+		}
+	}
+
+	if d.graph < structureGraph {
+		if m := graphVariable.FindStringSubmatch(script); m != nil {
+			return d.error(m[2], "the graph variable is")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dialect
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/query/graph"
+)
+
+func TestGraph(t *testing.T) {
+	Convey("Given a management query", t, func() {
+		g := graph.NewGraph().OpenManagement().MakePropertyKey("name", "String.class", "single").Make()
+
+		Convey("When Graph is called for JanusGraph", func() {
+			Convey("Then it should be accepted", func() {
+				So(JanusGraph.Graph(g), ShouldBeNil)
+				So(Generic.Graph(g), ShouldBeNil)
+			})
+		})
+
+		Convey("When Graph is called for TinkerGraph", func() {
+			err := TinkerGraph.Graph(g)
+			Convey("Then the management system should be refused", func() {
+				var dialectErr *gremerror.DialectError
+				So(errors.As(err, &dialectErr), ShouldBeTrue)
+				So(dialectErr.Dialect(), ShouldEqual, "TinkerGraph")
+				So(dialectErr.Step(), ShouldEqual, "openManagement")
+			})
+		})
+
+		Convey("When a query uses the graph without management", func() {
+			g := graph.NewGraph().AddVertex("person")
+			Convey("Then only databases with the graph variable should accept it", func() {
+				So(TinkerGraph.Graph(g), ShouldBeNil)
+				So(errors.Is(Neptune.Graph(g), gremerror.ErrNotInDialect), ShouldBeTrue)
+			})
+		})
+
+		Convey("When a script uses the management variable", func() {
+			err := CosmosDB.Script("mgmt.commit()")
+			Convey("Then it should be refused", func() {
+				So(errors.Is(err, gremerror.ErrNotInDialect), ShouldBeTrue)
+			})
+		})
+	})
+}
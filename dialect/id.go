@@ -0,0 +1,54 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dialect
+
+import (
+	"fmt"
+
+	"github.com/northwesternmutual/grammes/query/traversal"
+)
+
+// ID returns the ID the way the database represents it, so it
+// can be given to steps such as V() and hasId(). Databases with
+// string IDs get every ID as a string, and databases with Long
+// IDs get number IDs with the Long suffix.
+func (d *Dialect) ID(id interface{}) interface{} {
+	if d == nil {
+		return id
+	}
+
+	switch d.ids {
+	case stringID:
+		switch t := id.(type) {
+		case int, int8, int16, int32, int64,
+			uint, uint16, uint32, uint64:
+			return fmt.Sprint(t)
+		}
+	case longID:
+		switch t := id.(type) {
+		case int, int8, int16, int32, int64,
+			uint, uint16, uint32, uint64:
+			return traversal.Custom(fmt.Sprint(t) + "L")
+		}
+	}
+
+	return id
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dialect
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/query/traversal"
+)
+
+func TestID(t *testing.T) {
+	Convey("Given number and string IDs", t, func() {
+		Convey("When ID is called for a database with string IDs", func() {
+			Convey("Then every ID should be a string", func() {
+				So(CosmosDB.ID(1234), ShouldEqual, "1234")
+				So(Neptune.ID(int64(5)), ShouldEqual, "5")
+				So(Neptune.ID("a-b"), ShouldEqual, "a-b")
+			})
+		})
+
+		Convey("When ID is called for a database with Long IDs", func() {
+			Convey("Then number IDs should have the Long suffix", func() {
+				So(TinkerGraph.ID(1234), ShouldEqual, traversal.Custom("1234L"))
+				So(TinkerGraph.ID("a-b"), ShouldEqual, "a-b")
+			})
+		})
+
+		Convey("When ID is called for JanusGraph or a nil dialect", func() {
+			var d *Dialect
+			Convey("Then the IDs should be left as they are", func() {
+				So(JanusGraph.ID(1234), ShouldEqual, 1234)
+				So(d.ID(1234), ShouldEqual, 1234)
+			})
+		})
+	})
+}
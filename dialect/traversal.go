@@ -0,0 +1,200 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dialect
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/query/predicate"
+	"github.com/northwesternmutual/grammes/query/traversal"
+)
+
+// idSteps are the steps whose arguments are IDs.
+var idSteps = map[string]bool{"V": true, "E": true, "hasId": true}
+
+// textPRewrites are the JanusGraph predicates that have an
+// equivalent TextP predicate in TinkerPop.
+var textPRewrites = map[string]string{
+	"textPrefix":    "startingWith",
+	"textNotPrefix": "notStartingWith",
+	"textRegex":     "regex",
+	"textNotRegex":  "notRegex",
+}
+
+// janusGraphPredicate finds the Text and Geo predicates
+// of JanusGraph and the Geoshape values given to them.
+var janusGraphPredicate = regexp.MustCompile(`(^|[^\w.])(text[A-Z]\w*|geo[A-Z]\w*|Geoshape)\b`)
+
+// Traversal checks that the database supports every step
+// and value of the traversal, including nested traversals.
+// IDs are given the representation of the database and
+// predicates are rewritten when there's an equivalent. The
+// error is a *gremerror.DialectError naming the step.
+func (d *Dialect) Traversal(g traversal.String) (traversal.String, error) {
+	if d == nil {
+		return g, nil
+	}
+
+	for i, s := range g.Steps() {
+		s, err := d.step(s)
+		if err != nil {
+			return g, err
+		}
+		g = g.Replace(i, s)
+	}
+
+	return g, nil
+}
+
+// anonymous does the same as Traversal for a nested traversal.
+func (d *Dialect) anonymous(a traversal.Anonymous) (traversal.Anonymous, error) {
+	for i, s := range a.Steps() {
+		s, err := d.step(s)
+		if err != nil {
+			return a, err
+		}
+		a = a.Replace(i, s)
+	}
+
+	return a, nil
+}
+
+func (d *Dialect) step(s traversal.Step) (traversal.Step, error) {
+	if !d.Supports(s.Name) {
+		return s, d.error(s.Name, "the step is")
+	}
+
+	for i, arg := range s.Args {
+		var err error
+
+		switch t := arg.(type) {
+		case traversal.String:
+			s.Args[i], err = d.Traversal(t)
+		case traversal.Anonymous:
+			s.Args[i], err = d.anonymous(t)
+		case traversal.Custom:
+			if isLambda(t.String()) && !d.lambdas {
+				err = d.error(s.Name, "lambdas are")
+			}
+		case *predicate.Predicate:
+			s.Args[i], err = d.predicate(s.Name, t)
+		case predicate.Geoshape:
+			if !d.janusGraph {
+				err = d.error(s.Name, "Geoshape values are")
+			}
+		default:
+			if idSteps[s.Name] {
+				s.Args[i] = d.ID(arg)
+			}
+		}
+
+		if err != nil {
+			return s, err
+		}
+	}
+
+	return s, nil
+}
+
+// predicate rewrites the JanusGraph predicates that have an
+// equivalent and refuses the rest on other databases. Only the
+// names of the predicates are looked at, not the string values
+// given to them.
+func (d *Dialect) predicate(step string, p *predicate.Predicate) (*predicate.Predicate, error) {
+	if d.janusGraph {
+		return p, nil
+	}
+
+	var unsupported string
+	res := outsideLiterals(p.String(), func(code string) string {
+		code = janusGraphPredicate.ReplaceAllStringFunc(code, func(m string) string {
+			loc := janusGraphPredicate.FindStringSubmatchIndex(m)
+			name := m[loc[4]:loc[5]]
+			if to, ok := textPRewrites[name]; ok {
+				return m[:loc[4]] + to
+			}
+			return m
+		})
+
+		if m := janusGraphPredicate.FindStringSubmatch(code); m != nil && unsupported == "" {
+			unsupported = m[2]
+		}
+		return code
+	})
+
+	if unsupported != "" {
+		return p, d.error(step, fmt.Sprintf("the JanusGraph predicate %s is", unsupported))
+	}
+
+	rewritten := predicate.Predicate(res)
+	return &rewritten, nil
+}
+
+// outsideLiterals replaces the parts of the Groovy that
+// aren't in string literals with what fn returns for them.
+func outsideLiterals(groovy string, fn func(string) string) string {
+	var (
+		b     strings.Builder
+		start int
+	)
+	for i := 0; i < len(groovy); i++ {
+		quote := groovy[i]
+		if quote != '"' && quote != '\'' {
+			continue
+		}
+
+		b.WriteString(fn(groovy[start:i]))
+
+		end := i + 1
+		for end < len(groovy) && groovy[end] != quote {
+			if groovy[end] == '\\' {
+				end++
+			}
+			end++
+		}
+		if end < len(groovy) {
+			end++
+		} else {
+			end = len(groovy)
+		}
+
+		b.WriteString(groovy[i:end])
+		start, i = end, end-1
+	}
+
+	b.WriteString(fn(groovy[start:]))
+	return b.String()
+}
+
+// error returns a DialectError for the step
+// that uses what the database doesn't support.
+func (d *Dialect) error(step, what string) error {
+	return gremerror.NewDialectError(d.name, step,
+		fmt.Errorf("%s %w", what, gremerror.ErrNotInDialect))
+}
+
+// isLambda returns whether the Groovy is a closure.
+func isLambda(groovy string) bool {
+	return strings.HasPrefix(strings.TrimSpace(groovy), "{")
+}
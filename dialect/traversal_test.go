@@ -0,0 +1,123 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dialect
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	__ "github.com/northwesternmutual/grammes/query/anonymous"
+	"github.com/northwesternmutual/grammes/query/predicate"
+	"github.com/northwesternmutual/grammes/query/traversal"
+)
+
+func TestTraversal(t *testing.T) {
+	Convey("Given a graph traversal", t, func() {
+		g := traversal.NewTraversal()
+
+		Convey("When Traversal is called with IDs", func() {
+			res, err := CosmosDB.Traversal(g.V(1).Where(__.Out().HasID(2, "3")))
+			Convey("Then the IDs should use the representation of the database", func() {
+				So(err, ShouldBeNil)
+				So(res.String(), ShouldEqual, `g.V("1").where(__.out().hasId("2","3"))`)
+			})
+		})
+
+		Convey("When Traversal is called with a step the database doesn't have", func() {
+			_, err := CosmosDB.Traversal(g.V().Sack())
+			Convey("Then the step should be named in the error", func() {
+				var dialectErr *gremerror.DialectError
+				So(errors.As(err, &dialectErr), ShouldBeTrue)
+				So(dialectErr.Step(), ShouldEqual, "sack")
+				So(errors.Is(err, gremerror.ErrNotInDialect), ShouldBeTrue)
+			})
+		})
+
+		Convey("When a nested traversal uses a step the database doesn't have", func() {
+			_, err := Neptune.Traversal(g.V().Local(__.Program("p")))
+			Convey("Then it should be refused", func() {
+				So(errors.Is(err, gremerror.ErrNotInDialect), ShouldBeTrue)
+			})
+		})
+
+		Convey("When a traversal source uses the graph computer", func() {
+			_, err := Neptune.Traversal(traversal.NewTraversalSource().WithComputer().V())
+			Convey("Then it should be refused", func() {
+				So(errors.Is(err, gremerror.ErrNotInDialect), ShouldBeTrue)
+			})
+		})
+
+		Convey("When Traversal is called with a lambda", func() {
+			q := g.V().Where("{ it.get().value('age') > 29 }")
+			Convey("Then only databases with lambdas should accept it", func() {
+				_, err := JanusGraph.Traversal(q)
+				So(err, ShouldBeNil)
+				_, err = Neptune.Traversal(q)
+				So(errors.Is(err, gremerror.ErrNotInDialect), ShouldBeTrue)
+			})
+		})
+
+		Convey("When Traversal is called with a JanusGraph predicate that has an equivalent", func() {
+			res, err := Neptune.Traversal(g.V().Has("name", predicate.TextPrefix("mar")))
+			Convey("Then it should be rewritten to the TextP predicate", func() {
+				So(err, ShouldBeNil)
+				So(res.String(), ShouldEqual, `g.V().has("name",startingWith("mar"))`)
+			})
+		})
+
+		Convey("When Traversal is called with a JanusGraph predicate without an equivalent", func() {
+			q := g.V().Has("name", predicate.TextContains("mar"))
+			Convey("Then only JanusGraph should accept it", func() {
+				res, err := JanusGraph.Traversal(q)
+				So(err, ShouldBeNil)
+				So(res.String(), ShouldEqual, q.String())
+				_, err = TinkerGraph.Traversal(q)
+				So(errors.Is(err, gremerror.ErrNotInDialect), ShouldBeTrue)
+			})
+		})
+
+		Convey("When predicate values hold the names of JanusGraph predicates", func() {
+			res, err := Neptune.Traversal(g.V().
+				Has("bio", predicate.Equal("see textRegex docs")).
+				Has("note", predicate.Within("uses textContains", `a\"geoWithin`)).
+				Has("name", predicate.TextPrefix("textRegex")))
+			Convey("Then the values should be left as they are", func() {
+				So(err, ShouldBeNil)
+				So(res.String(), ShouldEqual, `g.V().has("bio",eq("see textRegex docs"))`+
+					`.has("note",within("uses textContains","a\\\"geoWithin"))`+
+					`.has("name",startingWith("textRegex"))`)
+			})
+		})
+
+		Convey("When Traversal is called with a nil dialect", func() {
+			var d *Dialect
+			q := g.V(1).Sack()
+			res, err := d.Traversal(q)
+			Convey("Then the traversal should be left as it is", func() {
+				So(err, ShouldBeNil)
+				So(res.String(), ShouldEqual, q.String())
+			})
+		})
+	})
+}
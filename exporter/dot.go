@@ -0,0 +1,110 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package exporter
+
+import (
+	"bufio"
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/northwesternmutual/grammes/model"
+)
+
+// writeDOT writes the selection as a Graphviz digraph. Every
+// property is an attribute, and the values of multi-properties
+// are joined with commas.
+func (x *Exporter) writeDOT(ctx context.Context, w *bufio.Writer, sel Selection) error {
+	w.WriteString("digraph G {\n")
+
+	err := x.vertexPages(ctx, sel.vertices, func(vertices []model.Vertex) error {
+		for _, v := range vertices {
+			attrs := [][2]string{{"label", v.Label()}}
+			for _, key := range propertyKeys(v.PropertyMap()) {
+				var texts []string
+				for _, p := range v.Value.Properties[key] {
+					_, text := textValue(p.Value.Value)
+					texts = append(texts, text)
+				}
+				attrs = append(attrs, [2]string{key, strings.Join(texts, ",")})
+			}
+			writeDOTStatement(w, strconv.Quote(idText(v.ID())), attrs)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = x.edgePages(ctx, sel.edges, func(edges []model.Edge) error {
+		for _, e := range edges {
+			attrs := [][2]string{{"label", e.Label()}}
+			for _, key := range edgePropertyKeys(e.Value.Properties) {
+				_, text := textValue(e.Value.Properties[key].Value.Value)
+				attrs = append(attrs, [2]string{key, text})
+			}
+			writeDOTStatement(w,
+				strconv.Quote(idText(e.OutVertexID()))+" -> "+strconv.Quote(idText(e.InVertexID())),
+				attrs,
+			)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = w.WriteString("}\n")
+	return err
+}
+
+// writeDOTStatement writes a node or edge with its attributes.
+func writeDOTStatement(w *bufio.Writer, statement string, attrs [][2]string) {
+	w.WriteString("  " + statement + " [")
+	for i, attr := range attrs {
+		if i > 0 {
+			w.WriteString(", ")
+		}
+		w.WriteString(strconv.Quote(attr[0]) + "=" + strconv.Quote(attr[1]))
+	}
+	w.WriteString("];\n")
+}
+
+// propertyKeys returns the keys of vertex properties in order.
+func propertyKeys(properties model.PropertyMap) []string {
+	keys := make([]string, 0, len(properties))
+	for k := range properties {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// edgePropertyKeys returns the keys of edge properties in order.
+func edgePropertyKeys(properties model.EdgeProperties) []string {
+	keys := make([]string, 0, len(properties))
+	for k := range properties {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
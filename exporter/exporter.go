@@ -0,0 +1,257 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+/*
+Package exporter writes the vertices and edges of the graph, or of
+the part of it reached by a traversal, as GraphML, GraphSON 3
+adjacency lists or Graphviz DOT.
+
+Elements are read from the graph a page at a time and written as
+they arrive, so the memory used depends on the page size rather
+than the size of the graph. Pages are read with range steps over
+the elements ordered by ID, so the graph shouldn't change while
+it's being exported.
+
+The GraphML and GraphSON files can be read back with the importer.
+*/
+package exporter
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/model"
+	__ "github.com/northwesternmutual/grammes/query/anonymous"
+	"github.com/northwesternmutual/grammes/query/token"
+	"github.com/northwesternmutual/grammes/query/traversal"
+)
+
+// Format is the format the graph is exported as.
+type Format string
+
+const (
+	// GraphML is the XML format read by most graph tools.
+	// Meta-properties are left out since it has no way
+	// to hold them.
+	GraphML Format = "graphml"
+	// GraphSON is a GraphSON 3 adjacency list with a
+	// vertex and its edges on every line, as written
+	// by the TinkerPop GraphSONWriter.
+	GraphSON Format = "graphson"
+	// DOT is the Graphviz format. It's meant for
+	// drawing the graph and cannot be imported.
+	DOT Format = "dot"
+)
+
+// defaultPageSize is how many elements are read in one query.
+const defaultPageSize = 1000
+
+// Querier is what is needed to read the graph.
+// This is implemented by *grammes.Client.
+type Querier interface {
+	ExecuteStringQuery(query string) ([][]byte, error)
+}
+
+// Selection is the part of the graph that's exported.
+type Selection struct {
+	vertices traversal.String
+	edges    traversal.String
+	// adjacent returns the edges of the selection going out
+	// of, or into, the vertices with the IDs given.
+	adjacent func(out bool, ids []interface{}) traversal.String
+}
+
+// WholeGraph selects every vertex and edge of the graph.
+func WholeGraph() Selection {
+	return Selection{
+		vertices: traversal.NewTraversal().V(),
+		edges:    traversal.NewTraversal().E(),
+		adjacent: func(out bool, ids []interface{}) traversal.String {
+			if out {
+				return traversal.NewTraversal().V(ids...).OutE()
+			}
+			return traversal.NewTraversal().V(ids...).InE()
+		},
+	}
+}
+
+// SubGraph selects the edges reached by the traversal and the
+// vertices they connect, like the subgraph step does. The
+// traversal can be something like g.E().hasLabel("knows").
+func SubGraph(edges traversal.String) Selection {
+	return Selection{
+		vertices: edges.BothV().Dedup(),
+		edges:    edges.Dedup(),
+		adjacent: func(out bool, ids []interface{}) traversal.String {
+			end := __.OutV()
+			if !out {
+				end = __.InV()
+			}
+			end.AddStep("hasId", ids...)
+			return edges.Dedup().Where(end)
+		},
+	}
+}
+
+// Option changes how the exporter works.
+type Option func(*Exporter)
+
+// WithPageSize sets how many elements are read in one query.
+func WithPageSize(n int) Option {
+	return func(x *Exporter) {
+		x.pageSize = n
+	}
+}
+
+// Exporter writes a selection of the graph to a file.
+type Exporter struct {
+	querier  Querier
+	pageSize int
+}
+
+// New returns an exporter that reads with the querier.
+func New(querier Querier, options ...Option) *Exporter {
+	x := &Exporter{
+		querier:  querier,
+		pageSize: defaultPageSize,
+	}
+
+	for _, option := range options {
+		option(x)
+	}
+
+	return x
+}
+
+// Export writes the selection to w in the format given.
+func (x *Exporter) Export(ctx context.Context, w io.Writer, format Format, sel Selection) error {
+	bw := bufio.NewWriter(w)
+
+	var err error
+	switch format {
+	case GraphML:
+		err = x.writeGraphML(ctx, bw, sel)
+	case GraphSON:
+		err = x.writeGraphSON(ctx, bw, sel)
+	case DOT:
+		err = x.writeDOT(ctx, bw, sel)
+	default:
+		err = fmt.Errorf("unknown export format %q", format)
+	}
+	if err != nil {
+		return gremerror.NewGrammesError("Export", err)
+	}
+
+	return bw.Flush()
+}
+
+// vertexPages calls fn with every page of vertices of the query.
+// The vertices are ordered by ID so the pages don't overlap.
+func (x *Exporter) vertexPages(ctx context.Context, query traversal.String, fn func([]model.Vertex) error) error {
+	for low := 0; ; low += x.pageSize {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		vertices, err := x.vertices(query.Order().By(token.ID).Range(low, low+x.pageSize))
+		if err != nil {
+			return err
+		}
+		if len(vertices) > 0 {
+			if err = fn(vertices); err != nil {
+				return err
+			}
+		}
+		if len(vertices) < x.pageSize {
+			return nil
+		}
+	}
+}
+
+// edgePages calls fn with every page of edges of the query.
+// The edges are ordered by ID so the pages don't overlap.
+func (x *Exporter) edgePages(ctx context.Context, query traversal.String, fn func([]model.Edge) error) error {
+	for low := 0; ; low += x.pageSize {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		edges, err := x.edges(query.Order().By(token.ID).Range(low, low+x.pageSize))
+		if err != nil {
+			return err
+		}
+		if len(edges) > 0 {
+			if err = fn(edges); err != nil {
+				return err
+			}
+		}
+		if len(edges) < x.pageSize {
+			return nil
+		}
+	}
+}
+
+// execute runs the query and unmarshals every response with fn.
+func (x *Exporter) execute(query traversal.String, fn func([]byte) error) error {
+	responses, err := x.querier.ExecuteStringQuery(query.String())
+	if err != nil {
+		return gremerror.NewQueryError("Export", query.String(), err)
+	}
+
+	for _, res := range responses {
+		if err = fn(res); err != nil {
+			return gremerror.NewUnmarshalError("Export", res, err)
+		}
+	}
+
+	return nil
+}
+
+// vertices returns the vertices of the query.
+func (x *Exporter) vertices(query traversal.String) ([]model.Vertex, error) {
+	var vertices []model.Vertex
+	err := x.execute(query, func(res []byte) error {
+		var list model.VertexList
+		if err := json.Unmarshal(res, &list); err != nil {
+			return err
+		}
+		vertices = append(vertices, list.Vertices...)
+		return nil
+	})
+	return vertices, err
+}
+
+// edges returns the edges of the query.
+func (x *Exporter) edges(query traversal.String) ([]model.Edge, error) {
+	var edges []model.Edge
+	err := x.execute(query, func(res []byte) error {
+		var list model.EdgeList
+		if err := json.Unmarshal(res, &list); err != nil {
+			return err
+		}
+		edges = append(edges, list.Edges...)
+		return nil
+	})
+	return edges, err
+}
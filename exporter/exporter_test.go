@@ -0,0 +1,155 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package exporter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/query/traversal"
+)
+
+const (
+	testVertex1 = `{"@type":"g:Vertex","@value":{"id":{"@type":"g:Int64","@value":1},"label":"person","properties":{` +
+		`"name":[{"@type":"g:VertexProperty","@value":{"id":{"@type":"g:Int64","@value":10},"value":"marko","label":"name"}}],` +
+		`"age":[{"@type":"g:VertexProperty","@value":{"id":{"@type":"g:Int64","@value":11},"value":{"@type":"g:Int32","@value":29},"label":"age"}}]}}}`
+	testVertex2 = `{"@type":"g:Vertex","@value":{"id":{"@type":"g:Int64","@value":2},"label":"person","properties":{` +
+		`"name":[{"@type":"g:VertexProperty","@value":{"id":{"@type":"g:Int64","@value":12},"value":"vadas","label":"name","properties":{"since":{"@type":"g:Int32","@value":2010}}}},` +
+		`{"@type":"g:VertexProperty","@value":{"id":{"@type":"g:Int64","@value":13},"value":"v & \"v\"","label":"name"}}]}}}`
+	testEdge = `{"@type":"g:Edge","@value":{"id":{"@type":"g:Int64","@value":7},"label":"knows","inVLabel":"person","outVLabel":"person",` +
+		`"inV":{"@type":"g:Int64","@value":2},"outV":{"@type":"g:Int64","@value":1},` +
+		`"properties":{"weight":{"@type":"g:Property","@value":{"key":"weight","value":{"@type":"g:Double","@value":0.5}}}}}}`
+	testVertexKeys = `{"@type":"g:VertexProperty","@value":{"id":{"@type":"g:Int64","@value":10},"value":"marko","label":"name"}},` +
+		`{"@type":"g:VertexProperty","@value":{"id":{"@type":"g:Int64","@value":11},"value":{"@type":"g:Int32","@value":29},"label":"age"}}`
+	testEdgeKeys = `{"@type":"g:Property","@value":{"key":"weight","value":{"@type":"g:Double","@value":0.5}}}`
+)
+
+func gList(items string) []byte {
+	return []byte(`{"@type":"g:List","@value":[` + items + `]}`)
+}
+
+// mockQuerier answers the queries it knows and
+// returns an empty list for any other query.
+type mockQuerier struct {
+	responses map[string]string
+	queries   []string
+	err       error
+}
+
+func newMockQuerier() *mockQuerier {
+	return &mockQuerier{responses: map[string]string{
+		"g.V().properties().dedup().by(T.key)": testVertexKeys,
+		"g.E().properties().dedup().by(T.key)": testEdgeKeys,
+		"g.V().order().by(T.id).range(0,1000)": testVertex1 + "," + testVertex2,
+		"g.E().order().by(T.id).range(0,1000)": testEdge,
+		"g.V(1,2).outE()":                      testEdge,
+		"g.V(1,2).inE()":                       testEdge,
+	}}
+}
+
+func (m *mockQuerier) ExecuteStringQuery(query string) ([][]byte, error) {
+	m.queries = append(m.queries, query)
+	if m.err != nil {
+		return nil, m.err
+	}
+	return [][]byte{gList(m.responses[query])}, nil
+}
+
+func TestSelection(t *testing.T) {
+	Convey("Given the whole graph", t, func() {
+		sel := WholeGraph()
+		Convey("Then every vertex and edge should be selected", func() {
+			So(sel.vertices.String(), ShouldEqual, "g.V()")
+			So(sel.edges.String(), ShouldEqual, "g.E()")
+			So(sel.adjacent(true, []interface{}{1, "a"}).String(), ShouldEqual, `g.V(1,"a").outE()`)
+			So(sel.adjacent(false, []interface{}{1}).String(), ShouldEqual, "g.V(1).inE()")
+		})
+	})
+	Convey("Given a subgraph of edges", t, func() {
+		sel := SubGraph(traversal.NewTraversal().E().HasLabel("knows"))
+		Convey("Then the edges and the vertices they connect should be selected", func() {
+			So(sel.vertices.String(), ShouldEqual, `g.E().hasLabel("knows").bothV().dedup()`)
+			So(sel.edges.String(), ShouldEqual, `g.E().hasLabel("knows").dedup()`)
+			So(sel.adjacent(true, []interface{}{1, 2}).String(), ShouldEqual,
+				`g.E().hasLabel("knows").dedup().where(__.outV().hasId(1,2))`)
+			So(sel.adjacent(false, []interface{}{1}).String(), ShouldEqual,
+				`g.E().hasLabel("knows").dedup().where(__.inV().hasId(1))`)
+		})
+	})
+}
+
+func TestExportPages(t *testing.T) {
+	Convey("Given an exporter with a page size of one", t, func() {
+		q := newMockQuerier()
+		q.responses["g.V().order().by(T.id).range(0,1)"] = testVertex1
+		q.responses["g.V().order().by(T.id).range(1,2)"] = testVertex2
+		q.responses["g.E().order().by(T.id).range(0,1)"] = testEdge
+		x := New(q, WithPageSize(1))
+		Convey("When the graph is exported", func() {
+			var buf bytes.Buffer
+			err := x.Export(context.Background(), &buf, DOT, WholeGraph())
+			Convey("Then pages should be read until one isn't full", func() {
+				So(err, ShouldBeNil)
+				So(q.queries, ShouldResemble, []string{
+					"g.V().order().by(T.id).range(0,1)",
+					"g.V().order().by(T.id).range(1,2)",
+					"g.V().order().by(T.id).range(2,3)",
+					"g.E().order().by(T.id).range(0,1)",
+					"g.E().order().by(T.id).range(1,2)",
+				})
+			})
+		})
+	})
+}
+
+func TestExportErrors(t *testing.T) {
+	Convey("Given an exporter", t, func() {
+		q := newMockQuerier()
+		x := New(q)
+		Convey("When an unknown format is used", func() {
+			err := x.Export(context.Background(), &bytes.Buffer{}, Format("csv"), WholeGraph())
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "unknown export format")
+			})
+		})
+		Convey("When the query fails", func() {
+			q.err = errors.New("ERROR")
+			err := x.Export(context.Background(), &bytes.Buffer{}, GraphSON, WholeGraph())
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+		Convey("When the context is done", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			err := x.Export(ctx, &bytes.Buffer{}, DOT, WholeGraph())
+			Convey("Then the context error should be returned", func() {
+				So(errors.Is(err, context.Canceled), ShouldBeTrue)
+				So(q.queries, ShouldBeEmpty)
+			})
+		})
+	})
+}
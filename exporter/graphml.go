@@ -0,0 +1,232 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package exporter
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"sort"
+
+	"github.com/northwesternmutual/grammes/model"
+	"github.com/northwesternmutual/grammes/query/token"
+	"github.com/northwesternmutual/grammes/query/traversal"
+)
+
+const (
+	// graphMLVertexLabel and graphMLEdgeLabel are the keys
+	// TinkerPop uses for the labels of vertices and edges.
+	graphMLVertexLabel = "labelV"
+	graphMLEdgeLabel   = "labelE"
+)
+
+// graphMLKey declares a property key used in the document.
+type graphMLKey struct {
+	id       string
+	name     string
+	attrType string
+}
+
+// writeGraphML writes the selection as GraphML. The keys have to
+// be declared before the graph, so the type of every property key
+// is found first from one value of it. Multi-properties are
+// written as a data element for every value, and the values of
+// keys added after the keys were declared are left out.
+func (x *Exporter) writeGraphML(ctx context.Context, w *bufio.Writer, sel Selection) error {
+	vertexKeys, edgeKeys, err := x.graphMLKeys(sel)
+	if err != nil {
+		return err
+	}
+
+	w.WriteString(`<?xml version="1.0" encoding="UTF-8"?>` + "\n")
+	w.WriteString(`<graphml xmlns="http://graphml.graphdrawing.org/xmlns">` + "\n")
+	writeGraphMLKeys(w, "node", graphMLVertexLabel, vertexKeys)
+	writeGraphMLKeys(w, "edge", graphMLEdgeLabel, edgeKeys)
+	w.WriteString(`  <graph id="G" edgedefault="directed">` + "\n")
+
+	err = x.vertexPages(ctx, sel.vertices, func(vertices []model.Vertex) error {
+		for _, v := range vertices {
+			w.WriteString(`    <node id="`)
+			xml.EscapeText(w, []byte(idText(v.ID())))
+			w.WriteString(`">`)
+			writeGraphMLData(w, graphMLVertexLabel, v.Label())
+			for _, key := range propertyKeys(v.PropertyMap()) {
+				id, ok := keyID(vertexKeys, key)
+				if !ok {
+					continue
+				}
+				for _, p := range v.Value.Properties[key] {
+					_, text := textValue(p.Value.Value)
+					writeGraphMLData(w, id, text)
+				}
+			}
+			w.WriteString("</node>\n")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = x.edgePages(ctx, sel.edges, func(edges []model.Edge) error {
+		for _, e := range edges {
+			w.WriteString(`    <edge id="`)
+			xml.EscapeText(w, []byte(idText(e.ID())))
+			w.WriteString(`" source="`)
+			xml.EscapeText(w, []byte(idText(e.OutVertexID())))
+			w.WriteString(`" target="`)
+			xml.EscapeText(w, []byte(idText(e.InVertexID())))
+			w.WriteString(`">`)
+			writeGraphMLData(w, graphMLEdgeLabel, e.Label())
+			for _, key := range edgePropertyKeys(e.Value.Properties) {
+				id, ok := keyID(edgeKeys, key)
+				if !ok {
+					continue
+				}
+				_, text := textValue(e.Value.Properties[key].Value.Value)
+				writeGraphMLData(w, id, text)
+			}
+			w.WriteString("</edge>\n")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = w.WriteString("  </graph>\n</graphml>\n")
+	return err
+}
+
+// graphMLKeys finds the property keys of the vertices and edges of
+// the selection. A single property is read for every key, and its
+// type is used for all of the values of the key.
+func (x *Exporter) graphMLKeys(sel Selection) (map[string]graphMLKey, map[string]graphMLKey, error) {
+	// the IDs of the keys are unique in the document.
+	taken := map[string]bool{graphMLVertexLabel: true, graphMLEdgeLabel: true}
+
+	vertexKeys := map[string]graphMLKey{}
+	err := x.execute(propertyPerKey(sel.vertices), func(res []byte) error {
+		var properties []model.Property
+		if err := unmarshalList(res, &properties); err != nil {
+			return err
+		}
+		for _, p := range properties {
+			if _, ok := vertexKeys[p.GetLabel()]; ok {
+				continue
+			}
+			attrType, _ := textValue(p.Value.Value)
+			id := uniqueKeyID(taken, "v_", p.GetLabel())
+			vertexKeys[p.GetLabel()] = graphMLKey{id: id, name: p.GetLabel(), attrType: attrType}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	edgeKeys := map[string]graphMLKey{}
+	err = x.execute(propertyPerKey(sel.edges), func(res []byte) error {
+		var properties []model.EdgePropertyDetails
+		if err := unmarshalList(res, &properties); err != nil {
+			return err
+		}
+		for _, p := range properties {
+			if _, ok := edgeKeys[p.Value.Key]; ok {
+				continue
+			}
+			attrType, _ := textValue(p.Value.Value)
+			id := uniqueKeyID(taken, "e_", p.Value.Key)
+			edgeKeys[p.Value.Key] = graphMLKey{id: id, name: p.Value.Key, attrType: attrType}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return vertexKeys, edgeKeys, nil
+}
+
+// uniqueKeyID returns the name as the ID of a key, with the
+// prefix added until it isn't the ID of another key.
+func uniqueKeyID(taken map[string]bool, prefix, name string) string {
+	id := name
+	for taken[id] {
+		id = prefix + id
+	}
+	taken[id] = true
+	return id
+}
+
+// keyID returns the ID of the declared key with the name.
+func keyID(keys map[string]graphMLKey, name string) (string, bool) {
+	key, ok := keys[name]
+	return key.id, ok
+}
+
+// propertyPerKey returns a traversal of one property
+// for every key used by the elements of the query.
+func propertyPerKey(elements traversal.String) traversal.String {
+	return elements.Properties().Dedup().By(token.Key)
+}
+
+// unmarshalList reads a g:List or a plain JSON array into items.
+func unmarshalList(data []byte, items interface{}) error {
+	var list model.List
+	if err := json.Unmarshal(data, &list); err == nil && list.Type != "" {
+		if data, err = json.Marshal(list.Value); err != nil {
+			return err
+		}
+	}
+	return json.Unmarshal(data, items)
+}
+
+// writeGraphMLKeys declares the label and property keys in order.
+func writeGraphMLKeys(w *bufio.Writer, kind, label string, keys map[string]graphMLKey) {
+	all := []graphMLKey{{id: label, name: label, attrType: "string"}}
+	names := make([]string, 0, len(keys))
+	for name := range keys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		all = append(all, keys[name])
+	}
+
+	for _, key := range all {
+		w.WriteString(`  <key id="`)
+		xml.EscapeText(w, []byte(key.id))
+		w.WriteString(`" for="` + kind + `" attr.name="`)
+		xml.EscapeText(w, []byte(key.name))
+		w.WriteString(`" attr.type="` + key.attrType + `"/>` + "\n")
+	}
+}
+
+// writeGraphMLData writes the value of a key.
+func writeGraphMLData(w *bufio.Writer, key, text string) {
+	w.WriteString(`<data key="`)
+	xml.EscapeText(w, []byte(key))
+	w.WriteString(`">`)
+	xml.EscapeText(w, []byte(text))
+	w.WriteString(`</data>`)
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package exporter
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestExportGraphML(t *testing.T) {
+	Convey("Given a graph with two vertices and an edge", t, func() {
+		x := New(newMockQuerier())
+		Convey("When it's exported as GraphML", func() {
+			var buf bytes.Buffer
+			err := x.Export(context.Background(), &buf, GraphML, WholeGraph())
+			Convey("Then the keys should be declared with their types before the graph", func() {
+				So(err, ShouldBeNil)
+				So(buf.String(), ShouldEqual, `<?xml version="1.0" encoding="UTF-8"?>
+<graphml xmlns="http://graphml.graphdrawing.org/xmlns">
+  <key id="labelV" for="node" attr.name="labelV" attr.type="string"/>
+  <key id="age" for="node" attr.name="age" attr.type="int"/>
+  <key id="name" for="node" attr.name="name" attr.type="string"/>
+  <key id="labelE" for="edge" attr.name="labelE" attr.type="string"/>
+  <key id="weight" for="edge" attr.name="weight" attr.type="double"/>
+  <graph id="G" edgedefault="directed">
+    <node id="1"><data key="labelV">person</data><data key="age">29</data><data key="name">marko</data></node>
+    <node id="2"><data key="labelV">person</data><data key="name">vadas</data><data key="name">v &amp; &#34;v&#34;</data></node>
+    <edge id="7" source="1" target="2"><data key="labelE">knows</data><data key="weight">0.5</data></edge>
+  </graph>
+</graphml>
+`)
+			})
+			Convey("Then the document should be well formed", func() {
+				dec := xml.NewDecoder(&buf)
+				var err error
+				for err == nil {
+					_, err = dec.Token()
+				}
+				So(err.Error(), ShouldEqual, "EOF")
+			})
+		})
+	})
+	Convey("Given vertices and edges with a property of the same name", t, func() {
+		q := newMockQuerier()
+		q.responses["g.E().properties().dedup().by(T.key)"] =
+			`{"@type":"g:Property","@value":{"key":"name","value":"x"}}`
+		x := New(q)
+		Convey("When the keys are found", func() {
+			vertexKeys, edgeKeys, err := x.graphMLKeys(WholeGraph())
+			Convey("Then the edge key should have another ID", func() {
+				So(err, ShouldBeNil)
+				So(vertexKeys["name"].id, ShouldEqual, "name")
+				So(edgeKeys["name"], ShouldResemble, graphMLKey{id: "e_name", name: "name", attrType: "string"})
+			})
+		})
+	})
+	Convey("Given a vertex property named like the label key", t, func() {
+		q := newMockQuerier()
+		q.responses["g.V().properties().dedup().by(T.key)"] =
+			`{"@type":"g:VertexProperty","@value":{"id":{"@type":"g:Int64","@value":10},"value":"x","label":"labelV"}}`
+		x := New(q)
+		Convey("When the keys are found", func() {
+			vertexKeys, _, err := x.graphMLKeys(WholeGraph())
+			Convey("Then the property key should have another ID", func() {
+				So(err, ShouldBeNil)
+				So(vertexKeys["labelV"].id, ShouldEqual, "v_labelV")
+			})
+		})
+	})
+	Convey("Given a property key added after the keys were found", t, func() {
+		q := newMockQuerier()
+		q.responses["g.V().properties().dedup().by(T.key)"] =
+			`{"@type":"g:VertexProperty","@value":{"id":{"@type":"g:Int64","@value":10},"value":"marko","label":"name"}}`
+		x := New(q)
+		Convey("When the graph is exported", func() {
+			var buf bytes.Buffer
+			err := x.Export(context.Background(), &buf, GraphML, WholeGraph())
+			Convey("Then only declared keys should be used", func() {
+				So(err, ShouldBeNil)
+				So(buf.String(), ShouldNotContainSubstring, `<data key="age">`)
+				So(buf.String(), ShouldContainSubstring, `<data key="name">marko</data>`)
+			})
+		})
+	})
+}
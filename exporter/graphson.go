@@ -0,0 +1,147 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package exporter
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+
+	"github.com/northwesternmutual/grammes/model"
+)
+
+// graphSONVertex is a line of a GraphSON adjacency list.
+type graphSONVertex struct {
+	ID         interface{}                   `json:"id"`
+	Label      string                        `json:"label"`
+	InE        map[string][]graphSONEdge     `json:"inE,omitempty"`
+	OutE       map[string][]graphSONEdge     `json:"outE,omitempty"`
+	Properties map[string][]graphSONProperty `json:"properties,omitempty"`
+}
+
+// graphSONEdge is an edge of a vertex. Only the ID of the vertex
+// at the other end is given since the line is the vertex itself.
+type graphSONEdge struct {
+	ID         interface{}            `json:"id"`
+	InV        interface{}            `json:"inV,omitempty"`
+	OutV       interface{}            `json:"outV,omitempty"`
+	Properties map[string]interface{} `json:"properties,omitempty"`
+}
+
+// graphSONProperty is a value of a vertex property.
+type graphSONProperty struct {
+	ID         interface{}            `json:"id"`
+	Value      interface{}            `json:"value"`
+	Properties map[string]interface{} `json:"properties,omitempty"`
+}
+
+// writeGraphSON writes the selection as a GraphSON adjacency list.
+// The edges of a page of vertices are read together, so a page
+// holds every edge of its vertices in memory.
+func (x *Exporter) writeGraphSON(ctx context.Context, w *bufio.Writer, sel Selection) error {
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+
+	return x.vertexPages(ctx, sel.vertices, func(vertices []model.Vertex) error {
+		ids := make([]interface{}, len(vertices))
+		for i, v := range vertices {
+			ids[i] = plainID(v.ID())
+		}
+
+		outE, err := x.edges(sel.adjacent(true, ids))
+		if err != nil {
+			return err
+		}
+		inE, err := x.edges(sel.adjacent(false, ids))
+		if err != nil {
+			return err
+		}
+
+		out := map[string]map[string][]graphSONEdge{}
+		for _, e := range outE {
+			addGraphSONEdge(out, idText(e.OutVertexID()), e, graphSONEdge{InV: e.Value.InV})
+		}
+		in := map[string]map[string][]graphSONEdge{}
+		for _, e := range inE {
+			addGraphSONEdge(in, idText(e.InVertexID()), e, graphSONEdge{OutV: e.Value.OutV})
+		}
+
+		for _, v := range vertices {
+			line := graphSONVertex{
+				ID:    v.ID(),
+				Label: v.Label(),
+				InE:   in[idText(v.ID())],
+				OutE:  out[idText(v.ID())],
+			}
+			for key, values := range v.PropertyMap() {
+				if line.Properties == nil {
+					line.Properties = map[string][]graphSONProperty{}
+				}
+				for _, p := range values {
+					line.Properties[key] = append(line.Properties[key], graphSONVertexProperty(p))
+				}
+			}
+
+			if err = enc.Encode(line); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// addGraphSONEdge adds the edge to the edges of the vertex by label.
+func addGraphSONEdge(edges map[string]map[string][]graphSONEdge, vertex string, e model.Edge, edge graphSONEdge) {
+	edge.ID = e.ID()
+	for key, p := range e.Value.Properties {
+		if edge.Properties == nil {
+			edge.Properties = map[string]interface{}{}
+		}
+		edge.Properties[key] = graphSONValue(p.Value.Value)
+	}
+
+	if edges[vertex] == nil {
+		edges[vertex] = map[string][]graphSONEdge{}
+	}
+	edges[vertex][e.Label()] = append(edges[vertex][e.Label()], edge)
+}
+
+// graphSONVertexProperty turns a property into its
+// GraphSON form along with its meta-properties.
+func graphSONVertexProperty(p model.Property) graphSONProperty {
+	res := graphSONProperty{
+		ID:    p.Value.ID.Value,
+		Value: graphSONValue(p.Value.Value),
+	}
+	if p.Value.ID.Type != "" {
+		res.ID = typedValue{Type: p.Value.ID.Type, Value: p.Value.ID.Value}
+	}
+
+	for key, meta := range p.Value.Properties {
+		if res.Properties == nil {
+			res.Properties = map[string]interface{}{}
+		}
+		res.Properties[key] = graphSONValue(meta)
+	}
+
+	return res
+}
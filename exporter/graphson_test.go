@@ -0,0 +1,51 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package exporter
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestExportGraphSON(t *testing.T) {
+	Convey("Given a graph with two vertices and an edge", t, func() {
+		x := New(newMockQuerier())
+		Convey("When it's exported as GraphSON", func() {
+			var buf bytes.Buffer
+			err := x.Export(context.Background(), &buf, GraphSON, WholeGraph())
+			Convey("Then every vertex should be a line with its edges", func() {
+				So(err, ShouldBeNil)
+				So(buf.String(), ShouldEqual,
+					`{"id":{"@type":"g:Int64","@value":1},"label":"person",`+
+						`"outE":{"knows":[{"id":{"@type":"g:Int64","@value":7},"inV":{"@type":"g:Int64","@value":2},"properties":{"weight":{"@type":"g:Double","@value":0.5}}}]},`+
+						`"properties":{"age":[{"id":{"@type":"g:Int64","@value":11},"value":{"@type":"g:Int32","@value":29}}],`+
+						`"name":[{"id":{"@type":"g:Int64","@value":10},"value":"marko"}]}}`+"\n"+
+						`{"id":{"@type":"g:Int64","@value":2},"label":"person",`+
+						`"inE":{"knows":[{"id":{"@type":"g:Int64","@value":7},"outV":{"@type":"g:Int64","@value":1},"properties":{"weight":{"@type":"g:Double","@value":0.5}}}]},`+
+						`"properties":{"name":[{"id":{"@type":"g:Int64","@value":12},"value":"vadas","properties":{"since":{"@type":"g:Int32","@value":2010}}},`+
+						`{"id":{"@type":"g:Int64","@value":13},"value":"v & \"v\""}]}}`+"\n")
+			})
+		})
+	})
+}
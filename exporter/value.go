@@ -0,0 +1,126 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package exporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/northwesternmutual/grammes/model"
+)
+
+// plainID turns an ID as it's unmarshalled from GraphSON into a
+// number or string that can be used in a traversal. JanusGraph
+// relation identifiers become their string form.
+func plainID(id interface{}) interface{} {
+	switch t := id.(type) {
+	case map[string]interface{}:
+		v, ok := t["@value"]
+		if !ok {
+			return fmt.Sprint(t)
+		}
+		if rel, ok := v.(map[string]interface{}); ok {
+			if relationID, ok := rel["relationId"].(string); ok {
+				return relationID
+			}
+		}
+		return plainID(v)
+	case float64:
+		if math.Trunc(t) == t && math.Abs(t) < 1<<63 {
+			return int64(t)
+		}
+	}
+	return id
+}
+
+// idText returns the ID as it's written in GraphML and DOT.
+func idText(id interface{}) string {
+	return fmt.Sprint(plainID(id))
+}
+
+// textValue returns a value as text along with the GraphML type it's
+// declared as. Dates are written in RFC 3339 and anything that isn't
+// a boolean, number or string is written as JSON.
+func textValue(w model.ValueWrapper) (attrType, text string) {
+	switch w.Type {
+	case "g:Int32", "g:Int16", "gx:Int16", "gx:Byte":
+		if i, err := w.AsInt64(); err == nil {
+			return "int", strconv.FormatInt(i, 10)
+		}
+	case "g:Int64":
+		if i, err := w.AsInt64(); err == nil {
+			return "long", strconv.FormatInt(i, 10)
+		}
+	case "g:Float":
+		if f, err := w.AsFloat64(); err == nil {
+			return "float", strconv.FormatFloat(f, 'g', -1, 32)
+		}
+	case "g:Double", "gx:BigDecimal":
+		if f, err := w.AsFloat64(); err == nil {
+			return "double", strconv.FormatFloat(f, 'g', -1, 64)
+		}
+	case "g:Date", "g:Timestamp":
+		if t, err := w.AsTime(); err == nil {
+			return "string", t.Format(time.RFC3339Nano)
+		}
+	}
+
+	switch t := w.Value.(type) {
+	case string:
+		return "string", t
+	case bool:
+		return "boolean", strconv.FormatBool(t)
+	case float64:
+		if i, err := w.AsInt64(); err == nil {
+			return "long", strconv.FormatInt(i, 10)
+		}
+		return "double", strconv.FormatFloat(t, 'g', -1, 64)
+	}
+
+	data, _ := json.Marshal(w.Value)
+	return "string", string(data)
+}
+
+// typedValue is a value with its GraphSON type.
+type typedValue struct {
+	Type  string      `json:"@type"`
+	Value interface{} `json:"@value"`
+}
+
+// graphSONValue returns the value in its typed GraphSON form. Numbers
+// are read again from the response so they keep their precision.
+func graphSONValue(w model.ValueWrapper) interface{} {
+	if w.Type == "" {
+		return w.Value
+	}
+
+	v := w.Value
+	if i, err := w.AsInt64(); err == nil {
+		v = i
+	} else if f, err := w.AsFloat64(); err == nil {
+		v = f
+	}
+
+	return typedValue{Type: w.Type, Value: v}
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package exporter
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/model"
+)
+
+func wrapper(data string) model.ValueWrapper {
+	var w model.ValueWrapper
+	json.Unmarshal([]byte(data), &w)
+	return w
+}
+
+func TestPlainID(t *testing.T) {
+	Convey("Given IDs as they're unmarshalled from GraphSON", t, func() {
+		Convey("Then typed numbers should become integers", func() {
+			So(plainID(map[string]interface{}{"@type": "g:Int64", "@value": float64(4104)}), ShouldEqual, int64(4104))
+			So(idText(map[string]interface{}{"@type": "g:Int64", "@value": float64(40960000)}), ShouldEqual, "40960000")
+		})
+		Convey("Then relation identifiers should become their string form", func() {
+			id := map[string]interface{}{
+				"@type":  "janusgraph:RelationIdentifier",
+				"@value": map[string]interface{}{"relationId": "4r-6e8-5jp-9kw"},
+			}
+			So(plainID(id), ShouldEqual, "4r-6e8-5jp-9kw")
+		})
+		Convey("Then strings should stay as they are", func() {
+			So(plainID("a"), ShouldEqual, "a")
+		})
+	})
+}
+
+func TestTextValue(t *testing.T) {
+	Convey("Given typed values", t, func() {
+		cases := []struct {
+			data, attrType, text string
+		}{
+			{`{"@type":"g:Int32","@value":29}`, "int", "29"},
+			{`{"@type":"g:Int64","@value":9007199254740993}`, "long", "9007199254740993"},
+			{`{"@type":"g:Float","@value":0.5}`, "float", "0.5"},
+			{`{"@type":"g:Double","@value":1.25}`, "double", "1.25"},
+			{`{"@type":"g:Date","@value":0}`, "string", "1970-01-01T00:00:00Z"},
+			{`"marko"`, "string", "marko"},
+			{`true`, "boolean", "true"},
+			{`3`, "long", "3"},
+			{`{"@type":"janusgraph:Geoshape","@value":{"coordinates":[1,2]}}`, "string", `{"coordinates":[1,2]}`},
+		}
+		Convey("Then they should have a GraphML type and text", func() {
+			for _, c := range cases {
+				attrType, text := textValue(wrapper(c.data))
+				So(attrType, ShouldEqual, c.attrType)
+				So(text, ShouldEqual, c.text)
+			}
+		})
+	})
+}
+
+func TestGraphSONValue(t *testing.T) {
+	Convey("Given typed values", t, func() {
+		Convey("Then they should keep their type and precision", func() {
+			data, _ := json.Marshal(graphSONValue(wrapper(`{"@type":"g:Int64","@value":9007199254740993}`)))
+			So(string(data), ShouldEqual, `{"@type":"g:Int64","@value":9007199254740993}`)
+			data, _ = json.Marshal(graphSONValue(wrapper(`{"@type":"g:UUID","@value":"41d2e28a-20a4-4ab0-b379-d810dede3786"}`)))
+			So(string(data), ShouldEqual, `{"@type":"g:UUID","@value":"41d2e28a-20a4-4ab0-b379-d810dede3786"}`)
+			So(graphSONValue(wrapper(`"marko"`)), ShouldEqual, "marko")
+		})
+	})
+}
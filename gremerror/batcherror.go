@@ -0,0 +1,62 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gremerror
+
+import "strconv"
+
+// BatchError is used when some of the
+// operations in a batch could not be applied.
+type BatchError struct {
+	function string
+	failed   int
+	total    int
+	err      error
+}
+
+// NewBatchError returns a new BatchError with specified parameters.
+// The err is the error of the first operation that failed.
+func NewBatchError(function string, failed, total int, err error) error {
+	return &BatchError{
+		function: function,
+		failed:   failed,
+		total:    total,
+		err:      err,
+	}
+}
+
+func (b *BatchError) Error() string {
+	return fmtComma(
+		fmtError("type", "BATCH_ERROR"),
+		fmtError("function", b.function),
+		fmtError("failed", strconv.Itoa(b.failed)+" of "+strconv.Itoa(b.total)),
+		fmtError("error", b.err.Error()),
+	)
+}
+
+// Failed returns how many operations failed.
+func (b *BatchError) Failed() int {
+	return b.failed
+}
+
+// Unwrap returns the error of the first operation that failed.
+func (b *BatchError) Unwrap() error {
+	return b.err
+}
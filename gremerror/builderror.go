@@ -0,0 +1,63 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gremerror
+
+// BuildError is used when a step of a traversal
+// is given arguments that it can't be built with.
+type BuildError struct {
+	step     string
+	argument string
+	err      error
+}
+
+// NewBuildError returns a new BuildError with specified parameters.
+func NewBuildError(step, argument string, err error) error {
+	return &BuildError{
+		step:     step,
+		argument: argument,
+		err:      err,
+	}
+}
+
+func (b *BuildError) Error() string {
+	return fmtComma(
+		fmtError("type", "BUILD_ERROR"),
+		fmtError("step", b.step),
+		fmtError("argument", b.argument),
+		fmtError("error", b.err.Error()),
+	)
+}
+
+// Step returns the name of the step, such as has.
+func (b *BuildError) Step() string {
+	return b.step
+}
+
+// Argument returns the name of the argument that was wrong.
+func (b *BuildError) Argument() string {
+	return b.argument
+}
+
+// Unwrap returns the underlying error so it can be
+// compared against ErrArgumentCount or ErrArgumentType.
+func (b *BuildError) Unwrap() error {
+	return b.err
+}
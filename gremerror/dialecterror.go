@@ -0,0 +1,63 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gremerror
+
+// DialectError is used when a query uses something
+// that the graph database it's sent to doesn't have.
+type DialectError struct {
+	dialect string
+	step    string
+	err     error
+}
+
+// NewDialectError returns a new DialectError with specified parameters.
+func NewDialectError(dialect, step string, err error) error {
+	return &DialectError{
+		dialect: dialect,
+		step:    step,
+		err:     err,
+	}
+}
+
+func (d *DialectError) Error() string {
+	return fmtComma(
+		fmtError("type", "DIALECT_ERROR"),
+		fmtError("dialect", d.dialect),
+		fmtError("step", d.step),
+		fmtError("error", d.err.Error()),
+	)
+}
+
+// Dialect returns the name of the dialect, such as Neptune.
+func (d *DialectError) Dialect() string {
+	return d.dialect
+}
+
+// Step returns the name of the step or call that isn't supported.
+func (d *DialectError) Step() string {
+	return d.step
+}
+
+// Unwrap returns the underlying error so it
+// can be compared against ErrNotInDialect.
+func (d *DialectError) Unwrap() error {
+	return d.err
+}
@@ -63,6 +63,73 @@ var (
 	// querying was empty. This is used on rare occasions when
 	// the Unmarshal process is successful, but returns something empty.
 	ErrEmptyResponse = errors.New("empty response received")
+	// ErrInvalidMetaKey is used when a meta-property key
+	// given alongside a property value is not a string.
+	ErrInvalidMetaKey = errors.New("meta-property key must be a string")
+	// ErrPropertyNotFound is used when the property being
+	// accessed does not exist on the vertex, edge, or property.
+	ErrPropertyNotFound = errors.New("property not found")
+	// ErrPropertyType is used when the value of a property
+	// cannot be converted to the requested type without loss.
+	ErrPropertyType = errors.New("property value is not of the requested type")
+	// ErrInvalidIndex is used when an index being
+	// added has no name or no keys to index.
+	ErrInvalidIndex = errors.New("index must have a name and at least one key")
+	// ErrSchemaConflict is used when the desired schema
+	// changes something that already exists in the graph
+	// in a way that cannot be changed after it's made.
+	ErrSchemaConflict = errors.New("desired schema conflicts with the graph")
+	// ErrInvalidSchema is used when a schema document
+	// holds something that cannot be applied to a graph.
+	ErrInvalidSchema = errors.New("invalid schema")
+	// ErrInvalidTag is used when a grammes struct
+	// tag has an option that cannot be understood.
+	ErrInvalidTag = errors.New("invalid grammes struct tag")
+	// ErrUnsupportedType is used when the Go type of a
+	// field has no matching data type in the graph.
+	ErrUnsupportedType = errors.New("go type has no matching data type")
+	// ErrBatchDependency is used when an operation in a batch
+	// uses an element from an operation that has failed.
+	ErrBatchDependency = errors.New("operation depends on a failed operation")
+	// ErrInvalidMapping is used when an import mapping
+	// cannot be used to turn records into elements.
+	ErrInvalidMapping = errors.New("invalid import mapping")
+	// ErrVertexNotFound is used when no vertex has
+	// the external key an edge is connected to.
+	ErrVertexNotFound = errors.New("vertex not found")
+	// ErrInvalidCheckpoint is used when a checkpoint file
+	// was written by an import with different settings.
+	ErrInvalidCheckpoint = errors.New("checkpoint does not match the import")
+	// ErrNoBytecode is used when a traversal holds a value that
+	// only exists as Groovy, such as a lambda or a predicate.
+	ErrNoBytecode = errors.New("value has no bytecode form")
+	// ErrArgumentCount is used when a step of a
+	// traversal is given too many or too few arguments.
+	ErrArgumentCount = errors.New("wrong number of arguments")
+	// ErrArgumentType is used when a step of a traversal
+	// is given an argument of a type it doesn't take.
+	ErrArgumentType = errors.New("argument has the wrong type")
+	// ErrNotInDialect is used when a query uses a step or value
+	// that the dialect of the graph database doesn't support.
+	ErrNotInDialect = errors.New("not supported by the dialect")
+	// ErrStepVersion is used when a traversal uses a step that
+	// is newer than the TinkerPop version of the server.
+	ErrStepVersion = errors.New("step is newer than the server")
+	// ErrSyntax is used when a Gremlin script isn't
+	// written the way traversals are built.
+	ErrSyntax = errors.New("invalid syntax")
+	// ErrUnknownStep is used when a Gremlin script
+	// uses a step that traversals aren't built with.
+	ErrUnknownStep = errors.New("unknown step")
+	// ErrLambda is used when a Gremlin script runs its own
+	// Groovy, with a lambda or an interpolated string.
+	ErrLambda = errors.New("lambdas are not allowed")
+	// ErrNotPrepared is used when a named query
+	// is executed before it's prepared.
+	ErrNotPrepared = errors.New("query is not prepared")
+	// ErrInvalidParameter is used when the parameters of a named
+	// query are missing, unknown or of the wrong type.
+	ErrInvalidParameter = errors.New("invalid query parameter")
 )
 
 // GrammesError is a generic error
@@ -89,3 +156,9 @@ func (g *GrammesError) Error() string {
 		fmtError("error", g.err.Error()),
 	)
 }
+
+// Unwrap returns the underlying error so it
+// can be compared against the errors above.
+func (g *GrammesError) Unwrap() error {
+	return g.err
+}
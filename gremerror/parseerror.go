@@ -0,0 +1,58 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gremerror
+
+import "strconv"
+
+// ParseError is used when a Gremlin script
+// can't be read as a traversal.
+type ParseError struct {
+	offset int
+	err    error
+}
+
+// NewParseError returns a new ParseError with specified parameters.
+func NewParseError(offset int, err error) error {
+	return &ParseError{
+		offset: offset,
+		err:    err,
+	}
+}
+
+func (p *ParseError) Error() string {
+	return fmtComma(
+		fmtError("type", "PARSE_ERROR"),
+		fmtError("offset", strconv.Itoa(p.offset)),
+		fmtError("error", p.err.Error()),
+	)
+}
+
+// Offset returns the byte offset of the script
+// where the error was found.
+func (p *ParseError) Offset() int {
+	return p.offset
+}
+
+// Unwrap returns the underlying error so it can be compared
+// against ErrSyntax, ErrUnknownStep or ErrLambda.
+func (p *ParseError) Unwrap() error {
+	return p.err
+}
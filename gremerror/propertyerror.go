@@ -0,0 +1,53 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gremerror
+
+// PropertyError is specific to accessing
+// a property on a vertex, edge, or property.
+type PropertyError struct {
+	function string
+	key      string
+	err      error
+}
+
+// NewPropertyError returns a new PropertyError with specified parameters.
+func NewPropertyError(function, key string, err error) error {
+	return &PropertyError{
+		function: function,
+		key:      key,
+		err:      err,
+	}
+}
+
+func (p *PropertyError) Error() string {
+	return fmtComma(
+		fmtError("type", "PROPERTY_ERROR"),
+		fmtError("function", p.function),
+		fmtError("key", p.key),
+		fmtError("error", p.err.Error()),
+	)
+}
+
+// Unwrap returns the underlying error so it can
+// be compared against ErrPropertyNotFound or ErrPropertyType.
+func (p *PropertyError) Unwrap() error {
+	return p.err
+}
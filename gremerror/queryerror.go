@@ -45,3 +45,8 @@ func (q *QueryError) Error() string {
 		fmtError("error", q.err.Error()),
 	)
 }
+
+// Unwrap returns the error that the query failed with.
+func (q *QueryError) Unwrap() error {
+	return q.err
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gremerror
+
+import "strings"
+
+// SchemaConflictError is used when a desired schema
+// cannot be applied because parts of it conflict
+// with what already exists in the graph.
+type SchemaConflictError struct {
+	function  string
+	conflicts []string
+}
+
+// NewSchemaConflictError returns a new SchemaConflictError with specified parameters.
+func NewSchemaConflictError(function string, conflicts []string) error {
+	return &SchemaConflictError{
+		function:  function,
+		conflicts: conflicts,
+	}
+}
+
+func (s *SchemaConflictError) Error() string {
+	return fmtComma(
+		fmtError("type", "SCHEMA_CONFLICT_ERROR"),
+		fmtError("function", s.function),
+		fmtError("conflicts", strings.Join(s.conflicts, "; ")),
+	)
+}
+
+// Conflicts returns a description of every conflict.
+func (s *SchemaConflictError) Conflicts() []string {
+	return s.conflicts
+}
+
+// Unwrap returns ErrSchemaConflict so the
+// error can be compared against it.
+func (s *SchemaConflictError) Unwrap() error {
+	return ErrSchemaConflict
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package importer
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"sort"
+)
+
+// checkpoint records which chunks of every source were imported
+// so an import that was stopped can continue where it left off.
+type checkpoint struct {
+	Sources map[string]*sourceCheckpoint `json:"sources"`
+}
+
+// sourceCheckpoint holds the chunks of a source that are done,
+// and the records that failed in the chunks that were partly
+// written, which are the only ones written again.
+type sourceCheckpoint struct {
+	BatchSize int           `json:"batchSize"`
+	Done      []int         `json:"done"`
+	Pending   map[int][]int `json:"pending,omitempty"`
+
+	done map[int]bool
+}
+
+// loadCheckpoint reads the checkpoint file. A
+// missing file is the same as an empty checkpoint.
+func loadCheckpoint(path string) (*checkpoint, error) {
+	cp := &checkpoint{Sources: make(map[string]*sourceCheckpoint)}
+	if path == "" {
+		return cp, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return cp, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err = json.Unmarshal(data, cp); err != nil {
+		return nil, err
+	}
+	if cp.Sources == nil {
+		cp.Sources = make(map[string]*sourceCheckpoint)
+	}
+	for _, s := range cp.Sources {
+		s.done = make(map[int]bool, len(s.Done))
+		for _, chunk := range s.Done {
+			s.done[chunk] = true
+		}
+	}
+
+	return cp, nil
+}
+
+// source returns the checkpoint of the source. It's nil
+// when the source was imported with another batch size.
+func (c *checkpoint) source(name string, batchSize int) *sourceCheckpoint {
+	s, ok := c.Sources[name]
+	if !ok {
+		s = &sourceCheckpoint{BatchSize: batchSize, done: make(map[int]bool)}
+		c.Sources[name] = s
+	}
+	if s.BatchSize != batchSize {
+		return nil
+	}
+	return s
+}
+
+// isDone returns whether the chunk was already imported.
+func (s *sourceCheckpoint) isDone(chunk int) bool {
+	return s.done[chunk]
+}
+
+// pending returns the records of the chunk that are left to
+// write, or nil when nothing in the chunk was written yet.
+func (s *sourceCheckpoint) pending(chunk int) map[int]bool {
+	records, ok := s.Pending[chunk]
+	if !ok {
+		return nil
+	}
+
+	pending := make(map[int]bool, len(records))
+	for _, r := range records {
+		pending[r] = true
+	}
+	return pending
+}
+
+// markPending records that the chunk was partly
+// imported and the records that are left to write.
+func (s *sourceCheckpoint) markPending(chunk int, records []int) {
+	if s.Pending == nil {
+		s.Pending = make(map[int][]int)
+	}
+	s.Pending[chunk] = append([]int(nil), records...)
+	sort.Ints(s.Pending[chunk])
+}
+
+// markDone records that the chunk was imported.
+func (s *sourceCheckpoint) markDone(chunk int) {
+	delete(s.Pending, chunk)
+	s.done[chunk] = true
+	s.Done = s.Done[:0]
+	for c := range s.done {
+		s.Done = append(s.Done, c)
+	}
+	sort.Ints(s.Done)
+}
+
+// save writes the checkpoint to a temporary file
+// and then moves it over the checkpoint file.
+func (c *checkpoint) save(path string) error {
+	if path == "" {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp := path + ".tmp"
+	if err = ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package importer
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCheckpoint(t *testing.T) {
+	Convey("Given a checkpoint file that doesn't exist", t, func() {
+		dir, err := ioutil.TempDir("", "checkpoint")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, "import.json")
+
+		cp, err := loadCheckpoint(path)
+		So(err, ShouldBeNil)
+		Convey("When chunks are marked done and saved", func() {
+			s := cp.source("people", 10)
+			s.markDone(2)
+			s.markDone(0)
+			So(cp.save(path), ShouldBeNil)
+			Convey("Then loading it again should keep the chunks that are done", func() {
+				loaded, err := loadCheckpoint(path)
+				So(err, ShouldBeNil)
+				ls := loaded.source("people", 10)
+				So(ls.Done, ShouldResemble, []int{0, 2})
+				So(ls.isDone(0), ShouldBeTrue)
+				So(ls.isDone(1), ShouldBeFalse)
+			})
+			Convey("Then a different batch size should not match", func() {
+				loaded, _ := loadCheckpoint(path)
+				So(loaded.source("people", 20), ShouldBeNil)
+			})
+		})
+	})
+	Convey("Given no checkpoint path", t, func() {
+		cp, err := loadCheckpoint("")
+		Convey("Then the checkpoint should be empty and never saved", func() {
+			So(err, ShouldBeNil)
+			So(cp.Sources, ShouldBeEmpty)
+			So(cp.save(""), ShouldBeNil)
+		})
+	})
+}
@@ -0,0 +1,474 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package importer
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/northwesternmutual/grammes/model"
+	"github.com/northwesternmutual/grammes/query/traversal"
+)
+
+const (
+	// elementField and errorField hold the element of a record
+	// read from a graph file, or why it couldn't be read.
+	elementField = "~element"
+	errorField   = "~error"
+
+	// graphMLVertexLabel and graphMLEdgeLabel are the keys
+	// TinkerPop uses for the labels of vertices and edges.
+	graphMLVertexLabel = "labelV"
+	graphMLEdgeLabel   = "labelE"
+)
+
+// graphMapping takes the elements read from a graph file out of
+// its records. The external key of a vertex is its ID in the file.
+type graphMapping struct {
+	edges bool
+}
+
+func (g graphMapping) IsEdge() bool {
+	return g.edges
+}
+
+func (graphMapping) endLabels() (string, string) {
+	return "", ""
+}
+
+func (graphMapping) mapRecord(rec record) (element, error) {
+	if err, ok := rec[errorField].(error); ok {
+		return element{}, err
+	}
+	e, _ := rec[elementField].(element)
+	return e, nil
+}
+
+// elementRecord wraps an element read from a graph file.
+func elementRecord(e element, err error) record {
+	if err != nil {
+		return record{errorField: err}
+	}
+	return record{elementField: e}
+}
+
+// graphReader reads the vertices of a graph file and then its edges.
+type graphReader interface {
+	vertices() recordReader
+	edges() recordReader
+}
+
+// newGraphReader returns the graph reader for the format.
+func newGraphReader(r io.Reader, format Format) (graphReader, error) {
+	switch format {
+	case GraphML:
+		return &graphMLReader{dec: xml.NewDecoder(r), keys: map[string]graphMLKey{}}, nil
+	case GraphSON:
+		return &graphSONReader{r: bufio.NewReader(r)}, nil
+	default:
+		return nil, fmt.Errorf("unknown format %q", format)
+	}
+}
+
+// readerFunc turns a function into a recordReader.
+type readerFunc func() (record, error)
+
+func (f readerFunc) Read() (record, error) {
+	return f()
+}
+
+// floatLiteral writes a float so it keeps its type in a script.
+func floatLiteral(f float64, bits int) traversal.Custom {
+	class, suffix := "Double", "d"
+	if bits == 32 {
+		class, suffix = "Float", "f"
+	}
+
+	switch {
+	case math.IsNaN(f):
+		return traversal.Custom(class + ".NaN")
+	case math.IsInf(f, 1):
+		return traversal.Custom(class + ".POSITIVE_INFINITY")
+	case math.IsInf(f, -1):
+		return traversal.Custom(class + ".NEGATIVE_INFINITY")
+	}
+
+	return traversal.Custom(strconv.FormatFloat(f, 'g', -1, bits) + suffix)
+}
+
+// graphMLKey is a key declared in a GraphML document.
+type graphMLKey struct {
+	name     string
+	attrType string
+}
+
+type graphMLData struct {
+	Key  string `xml:"key,attr"`
+	Text string `xml:",chardata"`
+}
+
+// graphMLElement is a node or an edge.
+type graphMLElement struct {
+	ID     string        `xml:"id,attr"`
+	Source string        `xml:"source,attr"`
+	Target string        `xml:"target,attr"`
+	Data   []graphMLData `xml:"data"`
+}
+
+// graphMLReader reads a GraphML document an element at a time.
+type graphMLReader struct {
+	dec  *xml.Decoder
+	keys map[string]graphMLKey
+	// pending is the first edge, which is read
+	// while looking for the end of the nodes.
+	pending record
+	done    bool
+}
+
+// next reads the next node or edge, keeping the keys declared before it.
+func (g *graphMLReader) next() (string, graphMLElement, error) {
+	var el graphMLElement
+	for {
+		tok, err := g.dec.Token()
+		if err != nil {
+			return "", el, err
+		}
+
+		start, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+
+		switch start.Name.Local {
+		case "key":
+			var key struct {
+				ID       string `xml:"id,attr"`
+				Name     string `xml:"attr.name,attr"`
+				AttrType string `xml:"attr.type,attr"`
+			}
+			if err = g.dec.DecodeElement(&key, &start); err != nil {
+				return "", el, err
+			}
+			if key.Name == "" {
+				key.Name = key.ID
+			}
+			g.keys[key.ID] = graphMLKey{name: key.Name, attrType: key.AttrType}
+		case "node", "edge":
+			err = g.dec.DecodeElement(&el, &start)
+			return start.Name.Local, el, err
+		}
+	}
+}
+
+func (g *graphMLReader) vertices() recordReader {
+	return readerFunc(func() (record, error) {
+		if g.done || g.pending != nil {
+			return nil, io.EOF
+		}
+
+		kind, el, err := g.next()
+		if err == io.EOF {
+			g.done = true
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if kind == "edge" {
+			g.pending = elementRecord(g.edge(el))
+			return nil, io.EOF
+		}
+		return elementRecord(g.vertex(el)), nil
+	})
+}
+
+func (g *graphMLReader) edges() recordReader {
+	return readerFunc(func() (record, error) {
+		if g.pending != nil {
+			rec := g.pending
+			g.pending = nil
+			return rec, nil
+		}
+		if g.done {
+			return nil, io.EOF
+		}
+
+		kind, el, err := g.next()
+		if err == io.EOF {
+			g.done = true
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if kind == "node" {
+			return nil, fmt.Errorf("node %s comes after the edges", el.ID)
+		}
+		return elementRecord(g.edge(el)), nil
+	})
+}
+
+// key returns the key with the ID, which is a string
+// named after the ID when it wasn't declared.
+func (g *graphMLReader) key(id string) graphMLKey {
+	if key, ok := g.keys[id]; ok {
+		return key
+	}
+	return graphMLKey{name: id}
+}
+
+// vertex turns a node into a vertex. Keys with more
+// than one value become multi-properties.
+func (g *graphMLReader) vertex(el graphMLElement) (element, error) {
+	v := model.NewVertex("vertex")
+	e := element{key: el.ID, vertex: &v}
+
+	for _, d := range el.Data {
+		key := g.key(d.Key)
+		if key.name == graphMLVertexLabel {
+			v.Value.Label = d.Text
+			continue
+		}
+
+		val, err := graphMLValue(key.attrType, d.Text)
+		if err != nil {
+			return e, fmt.Errorf("node %s key %s: %w", el.ID, key.name, err)
+		}
+		v.AddPropertyValue(key.name, val)
+	}
+
+	e.label = v.Label()
+	return e, nil
+}
+
+// edge turns a GraphML edge into an edge between the nodes.
+func (g *graphMLReader) edge(el graphMLElement) (element, error) {
+	e := element{label: "edge", out: el.Source, in: el.Target}
+
+	for _, d := range el.Data {
+		key := g.key(d.Key)
+		if key.name == graphMLEdgeLabel {
+			e.label = d.Text
+			continue
+		}
+
+		val, err := graphMLValue(key.attrType, d.Text)
+		if err != nil {
+			return e, fmt.Errorf("edge %s key %s: %w", el.ID, key.name, err)
+		}
+		e.properties = append(e.properties, key.name, val)
+	}
+
+	return e, nil
+}
+
+// graphMLValue reads the text of a value with the GraphML type.
+func graphMLValue(attrType, text string) (interface{}, error) {
+	switch attrType {
+	case "boolean":
+		return strconv.ParseBool(text)
+	case "int":
+		i, err := strconv.ParseInt(text, 10, 32)
+		return int32(i), err
+	case "long":
+		i, err := strconv.ParseInt(text, 10, 64)
+		return traversal.Custom(strconv.FormatInt(i, 10) + "L"), err
+	case "float":
+		f, err := strconv.ParseFloat(text, 32)
+		return floatLiteral(f, 32), err
+	case "double":
+		f, err := strconv.ParseFloat(text, 64)
+		return floatLiteral(f, 64), err
+	default:
+		return text, nil
+	}
+}
+
+// graphSONLine is a vertex with its edges in an adjacency list.
+// Only the edges going out of the vertex are read, since every
+// edge is also on the line of the vertex it goes into.
+type graphSONLine struct {
+	ID    interface{} `json:"id"`
+	Label string      `json:"label"`
+	OutE  map[string][]struct {
+		InV        interface{}                   `json:"inV"`
+		Properties map[string]model.ValueWrapper `json:"properties"`
+	} `json:"outE"`
+	Properties map[string][]struct {
+		Value      model.ValueWrapper            `json:"value"`
+		Properties map[string]model.ValueWrapper `json:"properties"`
+	} `json:"properties"`
+}
+
+// graphSONReader reads a GraphSON adjacency list.
+type graphSONReader struct {
+	r *bufio.Reader
+	// pending are the edges of the vertices read so far.
+	pending []record
+}
+
+func (g *graphSONReader) vertices() recordReader {
+	return readerFunc(func() (record, error) {
+		for {
+			line, err := g.r.ReadBytes('\n')
+			if len(bytes.TrimSpace(line)) == 0 {
+				if err != nil {
+					return nil, err
+				}
+				continue
+			}
+
+			var wrapped struct {
+				Type  string          `json:"@type"`
+				Value json.RawMessage `json:"@value"`
+			}
+			if json.Unmarshal(line, &wrapped) == nil && wrapped.Type == "g:Vertex" {
+				line = wrapped.Value
+			}
+
+			var v graphSONLine
+			dec := json.NewDecoder(bytes.NewReader(line))
+			dec.UseNumber()
+			if err = dec.Decode(&v); err != nil {
+				return nil, err
+			}
+
+			return elementRecord(g.vertex(v)), nil
+		}
+	})
+}
+
+func (g *graphSONReader) edges() recordReader {
+	return readerFunc(func() (record, error) {
+		if len(g.pending) == 0 {
+			return nil, io.EOF
+		}
+		rec := g.pending[0]
+		g.pending = g.pending[1:]
+		return rec, nil
+	})
+}
+
+// vertex turns the line into a vertex and
+// keeps its edges until the vertices are done.
+func (g *graphSONReader) vertex(line graphSONLine) (element, error) {
+	key := graphSONID(line.ID)
+	v := model.NewVertex(line.Label)
+	e := element{label: line.Label, key: key, vertex: &v}
+
+	for k, values := range line.Properties {
+		for _, p := range values {
+			var meta []interface{}
+			for metaKey, metaValue := range p.Properties {
+				meta = append(meta, metaKey, graphSONValue(metaValue))
+			}
+			v.AddPropertyValue(k, graphSONValue(p.Value), meta...)
+		}
+	}
+
+	labels := make([]string, 0, len(line.OutE))
+	for label := range line.OutE {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+
+	for _, label := range labels {
+		for _, edge := range line.OutE[label] {
+			out := element{label: label, out: key, in: graphSONID(edge.InV)}
+			keys := make([]string, 0, len(edge.Properties))
+			for k := range edge.Properties {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				out.properties = append(out.properties, k, graphSONValue(edge.Properties[k]))
+			}
+			g.pending = append(g.pending, elementRecord(out, nil))
+		}
+	}
+
+	return e, nil
+}
+
+// graphSONID returns an ID as the text used for the external key.
+func graphSONID(id interface{}) string {
+	if id == nil {
+		return ""
+	}
+	if m, ok := id.(map[string]interface{}); ok {
+		if rel, ok := m["@value"].(map[string]interface{}); ok {
+			if relationID, ok := rel["relationId"].(string); ok {
+				return relationID
+			}
+		}
+		if v, ok := m["@value"]; ok {
+			return graphSONID(v)
+		}
+	}
+	return fmt.Sprint(id)
+}
+
+// graphSONValue turns a typed value into
+// one that keeps its type in a script.
+func graphSONValue(w model.ValueWrapper) interface{} {
+	switch w.Type {
+	case "g:Int32", "g:Int16", "gx:Int16", "gx:Byte":
+		if i, err := w.AsInt64(); err == nil {
+			return int32(i)
+		}
+	case "g:Int64":
+		if i, err := w.AsInt64(); err == nil {
+			return traversal.Custom(strconv.FormatInt(i, 10) + "L")
+		}
+	case "g:Float":
+		if f, err := w.AsFloat64(); err == nil {
+			return floatLiteral(f, 32)
+		}
+	case "g:Double", "gx:BigDecimal":
+		if f, err := w.AsFloat64(); err == nil {
+			return floatLiteral(f, 64)
+		}
+	case "g:Date", "g:Timestamp":
+		if t, err := w.AsTime(); err == nil {
+			return traversal.Custom("new Date(" + strconv.FormatInt(t.UnixNano()/1e6, 10) + "L)")
+		}
+	}
+
+	switch t := w.Value.(type) {
+	case string, bool:
+		return t
+	case float64:
+		if i, err := w.AsInt64(); err == nil {
+			return i
+		}
+		return t
+	}
+
+	data, _ := json.Marshal(w.Value)
+	return string(data)
+}
@@ -0,0 +1,222 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package importer
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/gremconnect"
+	"github.com/northwesternmutual/grammes/logging"
+	"github.com/northwesternmutual/grammes/manager"
+	"github.com/northwesternmutual/grammes/query/traversal"
+)
+
+const (
+	testGraphML = `<?xml version="1.0" encoding="UTF-8"?>
+<graphml xmlns="http://graphml.graphdrawing.org/xmlns">
+  <key id="labelV" for="node" attr.name="labelV" attr.type="string"/>
+  <key id="age" for="node" attr.name="age" attr.type="int"/>
+  <key id="name" for="node" attr.name="name" attr.type="string"/>
+  <key id="labelE" for="edge" attr.name="labelE" attr.type="string"/>
+  <key id="weight" for="edge" attr.name="weight" attr.type="double"/>
+  <graph id="G" edgedefault="directed">
+    <node id="1"><data key="labelV">person</data><data key="age">29</data><data key="name">marko</data></node>
+    <node id="2"><data key="labelV">person</data><data key="name">vadas</data><data key="name">v &amp; &#34;v&#34;</data></node>
+    <edge id="7" source="1" target="2"><data key="labelE">knows</data><data key="weight">0.5</data></edge>
+  </graph>
+</graphml>
+`
+	testGraphSON = `{"id":{"@type":"g:Int64","@value":1},"label":"person",` +
+		`"outE":{"knows":[{"id":{"@type":"g:Int64","@value":7},"inV":{"@type":"g:Int64","@value":2},"properties":{"weight":{"@type":"g:Double","@value":0.5}}}]},` +
+		`"properties":{"age":[{"id":{"@type":"g:Int64","@value":11},"value":{"@type":"g:Int32","@value":29}}],` +
+		`"name":[{"id":{"@type":"g:Int64","@value":10},"value":"marko"}]}}` + "\n" +
+		`{"id":{"@type":"g:Int64","@value":2},"label":"person",` +
+		`"inE":{"knows":[{"id":{"@type":"g:Int64","@value":7},"outV":{"@type":"g:Int64","@value":1},"properties":{"weight":{"@type":"g:Double","@value":0.5}}}]},` +
+		`"properties":{"name":[{"id":{"@type":"g:Int64","@value":12},"value":"vadas","properties":{"since":{"@type":"g:Int32","@value":2010}}},` +
+		`{"id":{"@type":"g:Int64","@value":13},"value":"v & \"v\""}]}}` + "\n"
+)
+
+// readElements reads every element of a part of a graph file.
+func readElements(r recordReader, m mapper) ([]element, error) {
+	var elements []element
+	for {
+		rec, err := r.Read()
+		if err == io.EOF {
+			return elements, nil
+		}
+		if err != nil {
+			return elements, err
+		}
+		e, err := m.mapRecord(rec)
+		if err != nil {
+			return elements, err
+		}
+		elements = append(elements, e)
+	}
+}
+
+func TestGraphMLReader(t *testing.T) {
+	Convey("Given a GraphML document", t, func() {
+		g, err := newGraphReader(strings.NewReader(testGraphML), GraphML)
+		So(err, ShouldBeNil)
+		Convey("When the vertices and then the edges are read", func() {
+			vertices, vErr := readElements(g.vertices(), graphMapping{})
+			edges, eErr := readElements(g.edges(), graphMapping{edges: true})
+			Convey("Then the nodes should be vertices keyed by their ID", func() {
+				So(vErr, ShouldBeNil)
+				So(vertices, ShouldHaveLength, 2)
+				So(vertices[0].key, ShouldEqual, "1")
+				So(vertices[0].label, ShouldEqual, "person")
+				So(vertices[0].vertex.PropertyValue("age", 0), ShouldEqual, int32(29))
+				So(vertices[1].vertex.PropertyValue("name", 1), ShouldEqual, `v & "v"`)
+			})
+			Convey("Then the edges should connect the IDs of the nodes", func() {
+				So(eErr, ShouldBeNil)
+				So(edges, ShouldHaveLength, 1)
+				So(edges[0].label, ShouldEqual, "knows")
+				So(edges[0].out, ShouldEqual, "1")
+				So(edges[0].in, ShouldEqual, "2")
+				So(edges[0].properties, ShouldResemble, []interface{}{"weight", traversal.Custom("0.5d")})
+			})
+		})
+	})
+	Convey("Given a GraphML document with a node after an edge", t, func() {
+		doc := `<graphml><graph><node id="1"/><edge source="1" target="1"/><node id="2"/></graph></graphml>`
+		g, _ := newGraphReader(strings.NewReader(doc), GraphML)
+		Convey("When the edges are read", func() {
+			readElements(g.vertices(), graphMapping{})
+			_, err := readElements(g.edges(), graphMapping{edges: true})
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "node 2 comes after the edges")
+			})
+		})
+	})
+	Convey("Given a GraphML node with a value of the wrong type", t, func() {
+		doc := `<graphml><key id="age" for="node" attr.name="age" attr.type="int"/><graph><node id="1"><data key="age">old</data></node></graph></graphml>`
+		g, _ := newGraphReader(strings.NewReader(doc), GraphML)
+		Convey("When the vertices are read", func() {
+			_, err := readElements(g.vertices(), graphMapping{})
+			Convey("Then the record should have an error", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "node 1 key age")
+			})
+		})
+	})
+}
+
+func TestGraphMLValue(t *testing.T) {
+	Convey("Given GraphML values", t, func() {
+		Convey("Then they should keep their type in a script", func() {
+			v, _ := graphMLValue("long", "5")
+			So(v, ShouldEqual, traversal.Custom("5L"))
+			v, _ = graphMLValue("float", "0.25")
+			So(v, ShouldEqual, traversal.Custom("0.25f"))
+			v, _ = graphMLValue("double", "NaN")
+			So(v, ShouldEqual, traversal.Custom("Double.NaN"))
+			v, _ = graphMLValue("boolean", "true")
+			So(v, ShouldEqual, true)
+			v, _ = graphMLValue("", "x")
+			So(v, ShouldEqual, "x")
+		})
+	})
+}
+
+func TestGraphSONReader(t *testing.T) {
+	Convey("Given a GraphSON adjacency list", t, func() {
+		g, err := newGraphReader(strings.NewReader(testGraphSON), GraphSON)
+		So(err, ShouldBeNil)
+		Convey("When the vertices and then the edges are read", func() {
+			vertices, vErr := readElements(g.vertices(), graphMapping{})
+			edges, eErr := readElements(g.edges(), graphMapping{edges: true})
+			Convey("Then every line should be a vertex with its meta-properties", func() {
+				So(vErr, ShouldBeNil)
+				So(vertices, ShouldHaveLength, 2)
+				So(vertices[0].key, ShouldEqual, "1")
+				So(vertices[0].vertex.PropertyValue("age", 0), ShouldEqual, int32(29))
+				name := vertices[1].vertex.Value.Properties["name"][0]
+				So(name.GetMetaValue("since"), ShouldEqual, int32(2010))
+			})
+			Convey("Then every edge should be read once from its out vertex", func() {
+				So(eErr, ShouldBeNil)
+				So(edges, ShouldHaveLength, 1)
+				So(edges[0].out, ShouldEqual, "1")
+				So(edges[0].in, ShouldEqual, "2")
+				So(edges[0].properties, ShouldResemble, []interface{}{"weight", traversal.Custom("0.5d")})
+			})
+		})
+	})
+}
+
+func TestImportGraphWithoutIDs(t *testing.T) {
+	Convey("Given a GraphSON vertex without an ID", t, func() {
+		graph := newMockGraph()
+		var bindings []map[string]string
+		querier := manager.NewGraphManager(gremconnect.NewWebSocketDialer("testaddress"), logging.NewNilLogger(),
+			func(script string, b, r map[string]string) ([][]byte, error) {
+				bindings = append(bindings, b)
+				return graph.execute(script, b, r)
+			})
+		imp := New(querier, WithWorkers(1))
+		Convey("When Import is called", func() {
+			doc := `{"label":"person","properties":{"name":[{"value":"marko"}]}}`
+			report, err := imp.Import(context.Background(), Source{Name: "graph", Format: GraphSON, Reader: strings.NewReader(doc)})
+			Convey("Then the vertex should be written without a key", func() {
+				So(err, ShouldBeNil)
+				So(report.Sources[0].Imported, ShouldEqual, 1)
+				So(bindings, ShouldHaveLength, 1)
+				for _, v := range bindings[0] {
+					So(v, ShouldNotEqual, DefaultKeyProperty)
+				}
+			})
+		})
+	})
+}
+
+func TestImportGraph(t *testing.T) {
+	for _, format := range []Format{GraphML, GraphSON} {
+		doc := testGraphML
+		if format == GraphSON {
+			doc = testGraphSON
+		}
+		Convey("Given a "+string(format)+" file", t, func() {
+			graph := newMockGraph()
+			imp := New(graph.querier(), WithWorkers(1))
+			Convey("When Import is called", func() {
+				report, err := imp.Import(context.Background(), Source{Name: "graph", Format: format, Reader: strings.NewReader(doc)})
+				Convey("Then the vertices and then the edge should be written", func() {
+					So(err, ShouldBeNil)
+					So(report.Sources, ShouldHaveLength, 1)
+					So(report.Sources[0].Records, ShouldEqual, 3)
+					So(report.Sources[0].Imported, ShouldEqual, 3)
+					So(graph.scripts, ShouldHaveLength, 2)
+					So(graph.scripts[0], ShouldContainSubstring, "property(list,")
+					So(graph.scripts[1], ShouldContainSubstring, "g.V(1).addE(b0).to(__.V(2)).property(b1,0.5d)")
+				})
+			})
+		})
+	}
+}
@@ -0,0 +1,643 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+/*
+Package importer loads vertices and edges into the graph from
+CSV and JSON Lines files.
+
+Every source is read with a Mapping that says which columns hold
+the label, the external key of a vertex, the external keys of the
+vertices an edge connects, and the typed properties. Records are
+grouped into chunks that are written concurrently as batches, and
+the chunks that are done can be recorded in a checkpoint file so
+a stopped import can be started again without repeating them.
+
+GraphML and GraphSON files written by the exporter need no mapping.
+Their vertices are written first, keyed by their ID in the file,
+and then the edges between them.
+*/
+package importer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/manager"
+	"github.com/northwesternmutual/grammes/model"
+)
+
+const (
+	// DefaultKeyProperty is the property holding
+	// the external key of the imported vertices.
+	DefaultKeyProperty = "importKey"
+	// defaultBatchSize is how many records are in a chunk.
+	defaultBatchSize = 500
+	// defaultWorkers is how many chunks are written at once.
+	defaultWorkers = 4
+)
+
+// Querier is what is needed to write the batches and look up
+// the vertices of an edge. This is implemented by *grammes.Client.
+type Querier interface {
+	ExecuteBatch(batch *manager.Batch) (manager.BatchReport, error)
+	ExecuteBoundStringQuery(query string, bindings, rebindings map[string]string) ([][]byte, error)
+}
+
+// Source is a file to import.
+type Source struct {
+	// Name identifies the source in the checkpoint and reports.
+	Name    string
+	Format  Format
+	Reader  io.Reader
+	Mapping Mapping
+}
+
+// Progress is sent after every chunk of a source is written.
+type Progress struct {
+	Source string
+	// Records is how many records have been read.
+	Records int
+	// Imported is how many elements have been written.
+	Imported int
+	// Failed is how many records could not be imported.
+	Failed int
+}
+
+// Failure is a record that could not be imported.
+// Record counts from zero, leaving out CSV headers.
+type Failure struct {
+	Record int
+	Err    error
+}
+
+// SourceReport is the outcome of importing a source.
+type SourceReport struct {
+	Name     string
+	Records  int
+	Imported int
+	// Resumed is how many records were skipped because the
+	// checkpoint recorded their chunk as already imported.
+	Resumed  int
+	Failures []Failure
+}
+
+// Report is the outcome of an import.
+type Report struct {
+	Sources []SourceReport
+}
+
+// Failed returns how many records could not be imported.
+func (r Report) Failed() int {
+	var failed int
+	for _, s := range r.Sources {
+		failed += len(s.Failures)
+	}
+	return failed
+}
+
+// Option changes how the importer works.
+type Option func(*Importer)
+
+// WithBatchSize sets how many records are written in one batch.
+func WithBatchSize(n int) Option {
+	return func(i *Importer) {
+		i.batchSize = n
+	}
+}
+
+// WithWorkers sets how many batches are written at once.
+func WithWorkers(n int) Option {
+	return func(i *Importer) {
+		i.workers = n
+	}
+}
+
+// WithKeyProperty sets the property holding the external key.
+func WithKeyProperty(key string) Option {
+	return func(i *Importer) {
+		i.keyProperty = key
+	}
+}
+
+// WithCheckpoint sets the file recording which chunks are done.
+// When the file exists those chunks are skipped.
+func WithCheckpoint(path string) Option {
+	return func(i *Importer) {
+		i.checkpointPath = path
+	}
+}
+
+// WithProgress sets a function that's called after every chunk.
+func WithProgress(progress func(Progress)) Option {
+	return func(i *Importer) {
+		i.progress = progress
+	}
+}
+
+// Importer writes the records of sources to the graph.
+type Importer struct {
+	querier        Querier
+	batchSize      int
+	workers        int
+	keyProperty    string
+	checkpointPath string
+	progress       func(Progress)
+
+	// keys caches the IDs of vertices by their
+	// label and external key, as given by cacheKey.
+	keys sync.Map
+	// labels holds the label of the vertex with each external
+	// key, for the edges that don't give their end labels.
+	labelsMu sync.Mutex
+	labels   map[string]string
+}
+
+// New returns an importer that writes with the querier.
+func New(querier Querier, options ...Option) *Importer {
+	i := &Importer{
+		querier:     querier,
+		batchSize:   defaultBatchSize,
+		workers:     defaultWorkers,
+		keyProperty: DefaultKeyProperty,
+	}
+
+	for _, option := range options {
+		option(i)
+	}
+
+	return i
+}
+
+// chunk is a group of records written in one batch.
+type chunk struct {
+	index   int
+	first   int
+	records []record
+	// pending holds the only records to write when
+	// the chunk was partly written before.
+	pending map[int]bool
+}
+
+// chunkResult is the outcome of writing a chunk. A chunk is done
+// once every record in it was written. A chunk that was partly
+// written keeps its failed records, so resuming an import writes
+// them again without writing any element twice.
+type chunkResult struct {
+	imported int
+	failures []Failure
+	done     bool
+	err      error
+}
+
+// failed returns the records that failed.
+func (r chunkResult) failed() []int {
+	records := make([]int, len(r.failures))
+	for n, f := range r.failures {
+		records[n] = f.Record
+	}
+	return records
+}
+
+// cacheKey is the key of a vertex in the cache of IDs. External
+// keys are only unique within a label, so the label is part of it.
+func cacheKey(label, key string) string {
+	return label + "\x00" + key
+}
+
+// ambiguousLabel marks the external keys used by more than one label.
+const ambiguousLabel = "\x00"
+
+// storeKey caches the ID of the vertex. Edges without end labels
+// find the vertex by its key alone, unless vertices with other
+// labels have the same key.
+func (i *Importer) storeKey(label, key string, id interface{}) {
+	i.keys.Store(cacheKey(label, key), id)
+
+	i.labelsMu.Lock()
+	defer i.labelsMu.Unlock()
+
+	if i.labels == nil {
+		i.labels = map[string]string{}
+	}
+	switch l, ok := i.labels[key]; {
+	case !ok || l == label:
+		i.labels[key] = label
+		i.keys.Store(cacheKey("", key), id)
+	default:
+		i.labels[key] = ambiguousLabel
+		i.keys.Delete(cacheKey("", key))
+	}
+}
+
+// Import reads the sources in order and writes their records. A
+// source is finished before the next one starts, so vertices should
+// come before the edges between them. Records that cannot be mapped
+// or written are listed in the report. An error is returned when a
+// source cannot be read, the context is done, or a batch could not
+// be written. Starting the import again with the same checkpoint
+// only writes the records that weren't written yet.
+func (i *Importer) Import(ctx context.Context, sources ...Source) (Report, error) {
+	var report Report
+
+	cp, err := loadCheckpoint(i.checkpointPath)
+	if err != nil {
+		return report, gremerror.NewGrammesError("Import", err)
+	}
+
+	for _, src := range sources {
+		var sr SourceReport
+		if src.Format == GraphML || src.Format == GraphSON {
+			sr, err = i.importGraph(ctx, src, cp)
+		} else {
+			sr, err = i.importRecords(ctx, src, cp)
+		}
+		report.Sources = append(report.Sources, sr)
+		if err != nil {
+			return report, err
+		}
+	}
+
+	return report, nil
+}
+
+// sourceCheckpoint returns the part of the checkpoint
+// for the source, checking it has the same batch size.
+func (i *Importer) sourceCheckpoint(cp *checkpoint, name string) (*sourceCheckpoint, error) {
+	sourceCP := cp.source(name, i.batchSize)
+	if sourceCP == nil {
+		return nil, gremerror.NewGrammesError("Import",
+			fmt.Errorf("%w: batch size of %s changed", gremerror.ErrInvalidCheckpoint, name),
+		)
+	}
+	return sourceCP, nil
+}
+
+// importRecords imports a CSV or JSONL source with its mapping.
+func (i *Importer) importRecords(ctx context.Context, src Source, cp *checkpoint) (SourceReport, error) {
+	sr := SourceReport{Name: src.Name}
+
+	if err := src.Mapping.Validate(); err != nil {
+		return sr, err
+	}
+
+	sourceCP, err := i.sourceCheckpoint(cp, src.Name)
+	if err != nil {
+		return sr, err
+	}
+
+	reader, err := newRecordReader(src.Reader, src.Format)
+	if err != nil {
+		return sr, gremerror.NewGrammesError("Import", err)
+	}
+
+	err = i.importSource(ctx, &sr, reader, src.Mapping, cp, sourceCP)
+	return sr, err
+}
+
+// importGraph imports a GraphML or GraphSON source in two parts,
+// its vertices and then its edges, so that every vertex is written
+// before an edge needs it. The parts have their own checkpoints.
+func (i *Importer) importGraph(ctx context.Context, src Source, cp *checkpoint) (SourceReport, error) {
+	sr := SourceReport{Name: src.Name}
+
+	reader, err := newGraphReader(src.Reader, src.Format)
+	if err != nil {
+		return sr, gremerror.NewGrammesError("Import", err)
+	}
+
+	parts := []struct {
+		name   string
+		reader recordReader
+	}{
+		{src.Name + "#vertices", reader.vertices()},
+		{src.Name + "#edges", reader.edges()},
+	}
+	for n, part := range parts {
+		sourceCP, err := i.sourceCheckpoint(cp, part.name)
+		if err != nil {
+			return sr, err
+		}
+
+		if err = i.importSource(ctx, &sr, part.reader, graphMapping{edges: n == 1}, cp, sourceCP); err != nil {
+			return sr, err
+		}
+	}
+
+	return sr, nil
+}
+
+// importSource reads the chunks of the records and hands them
+// to the workers, skipping the chunks that are done. The records
+// are counted on from the ones already in the source report.
+func (i *Importer) importSource(ctx context.Context, sr *SourceReport, reader recordReader, m mapper, cp *checkpoint, sourceCP *sourceCheckpoint) error {
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		firstErr error
+		chunks   = make(chan chunk)
+	)
+
+	for w := 0; w < i.workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for c := range chunks {
+				res := i.writeChunk(m, c)
+
+				mu.Lock()
+				sr.Imported += res.imported
+				sr.Failures = append(sr.Failures, res.failures...)
+				if res.err != nil && firstErr == nil {
+					firstErr = res.err
+				}
+				if res.done || res.imported > 0 {
+					if res.done {
+						sourceCP.markDone(c.index)
+					} else {
+						sourceCP.markPending(c.index, res.failed())
+					}
+					if err := cp.save(i.checkpointPath); err != nil && firstErr == nil {
+						firstErr = gremerror.NewGrammesError("Import", err)
+					}
+				}
+				if i.progress != nil {
+					i.progress(Progress{Source: sr.Name, Records: sr.Records, Imported: sr.Imported, Failed: len(sr.Failures)})
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	readErr := i.readChunks(ctx, reader, sourceCP, chunks, sr, &mu)
+	close(chunks)
+	wg.Wait()
+
+	if readErr != nil {
+		return readErr
+	}
+	if firstErr != nil {
+		return gremerror.NewGrammesError("Import", firstErr)
+	}
+
+	return nil
+}
+
+// readChunks reads the records of the source into
+// chunks and sends the ones that aren't done yet.
+func (i *Importer) readChunks(ctx context.Context, reader recordReader, sourceCP *sourceCheckpoint, chunks chan<- chunk, sr *SourceReport, mu *sync.Mutex) error {
+	c := chunk{first: sr.Records}
+	send := func() error {
+		n := len(c.records)
+		mu.Lock()
+		sr.Records += n
+		if sourceCP.isDone(c.index) {
+			sr.Resumed += n
+			c.records = nil
+		} else if c.pending = sourceCP.pending(c.index); c.pending != nil {
+			sr.Resumed += n - len(c.pending)
+		}
+		mu.Unlock()
+
+		if len(c.records) > 0 {
+			select {
+			case chunks <- c:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		c = chunk{index: c.index + 1, first: c.first + n}
+		return nil
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		rec, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return gremerror.NewGrammesError("Import",
+				fmt.Errorf("record %d: %w", c.first+len(c.records), err),
+			)
+		}
+
+		c.records = append(c.records, rec)
+		if len(c.records) == i.batchSize {
+			if err = send(); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(c.records) > 0 {
+		return send()
+	}
+	return nil
+}
+
+// writeChunk maps the records of the chunk and writes them in one
+// batch. Records that cannot be mapped or written are failures.
+func (i *Importer) writeChunk(m mapper, c chunk) chunkResult {
+	var res chunkResult
+
+	elements := make([]element, 0, len(c.records))
+	indexes := make([]int, 0, len(c.records))
+	for n, rec := range c.records {
+		if c.pending != nil && !c.pending[c.first+n] {
+			continue
+		}
+		e, err := m.mapRecord(rec)
+		if err != nil {
+			res.failures = append(res.failures, Failure{Record: c.first + n, Err: err})
+			continue
+		}
+		elements = append(elements, e)
+		indexes = append(indexes, c.first+n)
+	}
+
+	outLabel, inLabel := m.endLabels()
+	if m.IsEdge() {
+		if err := i.resolve(outLabel, inLabel, elements); err != nil {
+			res.err = err
+			return res
+		}
+	}
+
+	batch := manager.NewBatch(manager.WithMaxOperations(len(elements) + 1))
+	refs := make([]manager.Ref, len(elements))
+	written := make([]bool, len(elements))
+
+	for n, e := range elements {
+		if e.vertex != nil {
+			v := *e.vertex
+			if e.key != "" {
+				v.AddPropertyValue(i.keyProperty, e.key)
+			}
+			refs[n] = batch.AddVertexByStruct(v)
+			written[n] = true
+			continue
+		}
+		if !m.IsEdge() {
+			properties := e.properties
+			if e.key != "" {
+				properties = append([]interface{}{i.keyProperty, e.key}, properties...)
+			}
+			refs[n] = batch.AddVertex(e.label, properties...)
+			written[n] = true
+			continue
+		}
+
+		outID, outOK := i.keys.Load(cacheKey(outLabel, e.out))
+		inID, inOK := i.keys.Load(cacheKey(inLabel, e.in))
+		if !outOK || !inOK {
+			missing := e.out
+			if outOK {
+				missing = e.in
+			}
+			res.failures = append(res.failures, Failure{
+				Record: indexes[n],
+				Err:    fmt.Errorf("%w: %s %s", gremerror.ErrVertexNotFound, i.keyProperty, missing),
+			})
+			continue
+		}
+		refs[n] = batch.AddEdge(outID, inID, e.label, e.properties...)
+		written[n] = true
+	}
+
+	if batch.Len() == 0 {
+		res.done = len(res.failures) == 0
+		return res
+	}
+
+	report, err := i.querier.ExecuteBatch(batch)
+	for n, e := range elements {
+		if !written[n] {
+			continue
+		}
+		if opErr := report.Results[refs[n]].Err; opErr != nil {
+			res.failures = append(res.failures, Failure{Record: indexes[n], Err: opErr})
+			continue
+		}
+		res.imported++
+		if e.key != "" && !m.IsEdge() {
+			i.storeKey(e.label, e.key, report.ID(refs[n]))
+		}
+	}
+	res.done = err == nil && len(res.failures) == 0
+	res.err = err
+
+	return res
+}
+
+// resolve looks up the IDs of the vertices with the external
+// keys of the edges that aren't in the cache yet.
+func (i *Importer) resolve(outLabel, inLabel string, elements []element) error {
+	lookups := map[string][]string{}
+	seen := map[string]bool{}
+	for _, e := range elements {
+		for _, k := range []struct{ key, label string }{{e.out, outLabel}, {e.in, inLabel}} {
+			if _, ok := i.keys.Load(cacheKey(k.label, k.key)); ok || seen[cacheKey(k.label, k.key)] {
+				continue
+			}
+			seen[cacheKey(k.label, k.key)] = true
+			lookups[k.label] = append(lookups[k.label], k.key)
+		}
+	}
+
+	labels := make([]string, 0, len(lookups))
+	for label := range lookups {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+
+	for _, label := range labels {
+		if err := i.lookup(label, lookups[label]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// lookup finds the vertices with the external keys
+// and stores their IDs in the cache. Every key
+// is sent as a binding.
+func (i *Importer) lookup(label string, keys []string) error {
+	bindings := map[string]string{"k": i.keyProperty}
+	names := make([]string, len(keys))
+	for n, key := range keys {
+		names[n] = "b" + strconv.Itoa(n)
+		bindings[names[n]] = key
+	}
+
+	query := "g.V()"
+	if label != "" {
+		bindings["l"] = label
+		query += ".hasLabel(l)"
+	}
+	query += ".has(k,within(" + strings.Join(names, ",") + ")).project(\"key\",\"id\").by(values(k)).by(id())"
+
+	responses, err := i.querier.ExecuteBoundStringQuery(query, bindings, map[string]string{})
+	if err != nil {
+		return gremerror.NewQueryError("Import", query, err)
+	}
+
+	// a key found on more than one vertex, which can happen
+	// when the label isn't known, isn't stored.
+	ids := map[string]interface{}{}
+	ambiguous := map[string]bool{}
+	for _, res := range responses {
+		decoded, err := model.UnmarshalGraphSON(res)
+		if err != nil {
+			return err
+		}
+
+		items, _ := decoded.([]interface{})
+		for _, item := range items {
+			found, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			key := fmt.Sprint(found["key"])
+			if _, ok := ids[key]; ok {
+				ambiguous[key] = true
+			}
+			ids[key] = found["id"]
+		}
+	}
+
+	for key, id := range ids {
+		if !ambiguous[key] {
+			i.keys.Store(cacheKey(label, key), id)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,318 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package importer
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/gremconnect"
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/logging"
+	"github.com/northwesternmutual/grammes/manager"
+)
+
+// mockGraph answers batch scripts with new IDs and key
+// lookups with the vertices that were added before.
+type mockGraph struct {
+	mu      sync.Mutex
+	next    int
+	keys    map[string]int
+	scripts []string
+	fail    bool
+}
+
+// propertyStep matches a property step with a bound key and value.
+var propertyStep = regexp.MustCompile(`property\((b\d+),(b\d+)\)`)
+
+func newMockGraph() *mockGraph {
+	return &mockGraph{next: 1, keys: make(map[string]int)}
+}
+
+func (m *mockGraph) execute(script string, bindings, _ map[string]string) ([][]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.scripts = append(m.scripts, script)
+	if m.fail {
+		return nil, errors.New("ERROR")
+	}
+
+	var items []string
+	if strings.HasPrefix(script, "g.V()") {
+		for name, key := range bindings {
+			if id, ok := m.keys[key]; ok && strings.HasPrefix(name, "b") {
+				items = append(items, `{"key":"`+key+`","id":`+strconv.Itoa(id)+`}`)
+			}
+		}
+		return [][]byte{[]byte("[" + strings.Join(items, ",") + "]")}, nil
+	}
+
+	lines := strings.Split(script, "\n")
+	for _, line := range lines[:len(lines)-1] {
+		for _, p := range propertyStep.FindAllStringSubmatch(line, -1) {
+			if bindings[p[1]] == DefaultKeyProperty {
+				m.keys[bindings[p[2]]] = m.next
+			}
+		}
+		items = append(items, strconv.Itoa(m.next))
+		m.next++
+	}
+	return [][]byte{[]byte("[" + strings.Join(items, ",") + "]")}, nil
+}
+
+func (m *mockGraph) querier() Querier {
+	dialer := gremconnect.NewWebSocketDialer("testaddress")
+	return manager.NewGraphManager(dialer, logging.NewNilLogger(), m.execute)
+}
+
+var (
+	peopleMapping = Mapping{
+		Label:      "person",
+		KeyColumn:  "id",
+		Properties: []PropertyMapping{{Column: "name"}},
+	}
+	knowsMapping = Mapping{
+		Label:      "knows",
+		OutColumn:  "from",
+		InColumn:   "to",
+		Properties: []PropertyMapping{{Column: "since", DataType: "Integer.class"}},
+	}
+)
+
+func TestImport(t *testing.T) {
+	Convey("Given vertex and edge sources", t, func() {
+		graph := newMockGraph()
+		var progress []Progress
+		imp := New(graph.querier(), WithBatchSize(2), WithWorkers(1),
+			WithProgress(func(p Progress) { progress = append(progress, p) }),
+		)
+		people := Source{
+			Name:    "people.csv",
+			Format:  CSV,
+			Reader:  strings.NewReader("id,name\np1,damien\np2,ada\np3,\n"),
+			Mapping: peopleMapping,
+		}
+		knows := Source{
+			Name:    "knows.jsonl",
+			Format:  JSONL,
+			Reader:  strings.NewReader(`{"from":"p1","to":"p2","since":2018}` + "\n" + `{"from":"p1","to":"p9"}`),
+			Mapping: knowsMapping,
+		}
+		Convey("When Import is called", func() {
+			report, err := imp.Import(context.Background(), people, knows)
+			Convey("Then the vertices should be imported in chunks", func() {
+				So(err, ShouldBeNil)
+				So(report.Sources[0].Records, ShouldEqual, 3)
+				So(report.Sources[0].Imported, ShouldEqual, 3)
+				So(progress[0], ShouldResemble, Progress{Source: "people.csv", Records: 2, Imported: 2})
+			})
+			Convey("Then the edges should connect the vertices by their keys", func() {
+				So(report.Sources[1].Imported, ShouldEqual, 1)
+				So(graph.scripts[len(graph.scripts)-1], ShouldContainSubstring, "g.V(1).addE(b0).to(__.V(2))")
+			})
+			Convey("Then edges with a missing vertex should be failures", func() {
+				So(report.Failed(), ShouldEqual, 1)
+				So(report.Sources[1].Failures[0].Record, ShouldEqual, 1)
+				So(errors.Is(report.Sources[1].Failures[0].Err, gremerror.ErrVertexNotFound), ShouldBeTrue)
+			})
+		})
+	})
+}
+
+func TestImportLookup(t *testing.T) {
+	Convey("Given an edge source whose vertices were imported earlier", t, func() {
+		graph := newMockGraph()
+		graph.keys["p1"] = 10
+		graph.keys["p2"] = 11
+		imp := New(graph.querier())
+		m := knowsMapping
+		m.OutLabel = "person"
+		knows := Source{
+			Name:    "knows.csv",
+			Format:  CSV,
+			Reader:  strings.NewReader("from,to\np1,p2\n"),
+			Mapping: m,
+		}
+		Convey("When Import is called", func() {
+			report, err := imp.Import(context.Background(), knows)
+			Convey("Then the vertices should be looked up by their keys", func() {
+				So(err, ShouldBeNil)
+				So(report.Sources[0].Imported, ShouldEqual, 1)
+				So(graph.scripts[0], ShouldStartWith, "g.V().has(k,within(b0))")
+				So(graph.scripts[1], ShouldStartWith, "g.V().hasLabel(l).has(k,within(b0)).project(\"key\",\"id\")")
+				So(graph.scripts[len(graph.scripts)-1], ShouldContainSubstring, "g.V(10).addE(b0).to(__.V(11))")
+			})
+		})
+	})
+}
+
+func TestImportLabels(t *testing.T) {
+	Convey("Given vertices with the same key under two labels", t, func() {
+		graph := newMockGraph()
+		imp := New(graph.querier(), WithWorkers(1))
+		people := Source{Name: "people.csv", Format: CSV, Reader: strings.NewReader("id\nx1\n"), Mapping: peopleMapping}
+		companies := Source{Name: "companies.csv", Format: CSV, Reader: strings.NewReader("id\nx1\n"),
+			Mapping: Mapping{Label: "company", KeyColumn: "id"}}
+		worksAt := Source{Name: "worksat.csv", Format: CSV, Reader: strings.NewReader("from,to\nx1,x1\n"),
+			Mapping: Mapping{Label: "worksAt", OutColumn: "from", InColumn: "to", OutLabel: "person", InLabel: "company"}}
+		Convey("When an edge goes from one to the other", func() {
+			report, err := imp.Import(context.Background(), people, companies, worksAt)
+			Convey("Then each end should be the vertex with its label", func() {
+				So(err, ShouldBeNil)
+				So(report.Sources[2].Imported, ShouldEqual, 1)
+				So(graph.scripts[len(graph.scripts)-1], ShouldContainSubstring, "g.V(1).addE(b0).to(__.V(2))")
+			})
+		})
+		Convey("When an edge without end labels uses the key", func() {
+			knows := Source{Name: "knows.csv", Format: CSV, Reader: strings.NewReader("from,to\nx1,x1\n"), Mapping: knowsMapping}
+			_, err := imp.Import(context.Background(), people, companies, knows)
+			Convey("Then the ends should be looked up instead of taken from the cache", func() {
+				So(err, ShouldBeNil)
+				So(graph.scripts[len(graph.scripts)-2], ShouldStartWith, "g.V().has(k,within(b0))")
+			})
+		})
+	})
+}
+
+func TestImportCheckpoint(t *testing.T) {
+	Convey("Given a checkpoint file and a source", t, func() {
+		dir, err := ioutil.TempDir("", "import")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, "checkpoint.json")
+		data := "id,name\np1,damien\np2,ada\np3,grace\n"
+
+		Convey("When the first import fails", func() {
+			graph := newMockGraph()
+			graph.fail = true
+			imp := New(graph.querier(), WithBatchSize(2), WithCheckpoint(path))
+			_, err := imp.Import(context.Background(), Source{Name: "people", Format: CSV, Reader: strings.NewReader(data), Mapping: peopleMapping})
+			So(err, ShouldNotBeNil)
+
+			Convey("Then the import should do every chunk when it's started again", func() {
+				graph.fail = false
+				graph.scripts = nil
+				imp = New(graph.querier(), WithBatchSize(2), WithCheckpoint(path))
+				report, err := imp.Import(context.Background(), Source{Name: "people", Format: CSV, Reader: strings.NewReader(data), Mapping: peopleMapping})
+				So(err, ShouldBeNil)
+				So(report.Sources[0].Resumed, ShouldEqual, 0)
+			})
+		})
+
+		Convey("When some records of a chunk fail", func() {
+			graph := newMockGraph()
+			graph.keys["p1"] = 10
+			graph.keys["p2"] = 11
+			knows := func() Source {
+				return Source{Name: "knows", Format: CSV, Reader: strings.NewReader("from,to\np1,p2\np1,p9\n"), Mapping: knowsMapping}
+			}
+			imp := New(graph.querier(), WithBatchSize(2), WithCheckpoint(path))
+			report, err := imp.Import(context.Background(), knows())
+			So(err, ShouldBeNil)
+			So(report.Sources[0].Imported, ShouldEqual, 1)
+			So(report.Failed(), ShouldEqual, 1)
+
+			Convey("Then starting it again should only write the failed records", func() {
+				graph.keys["p9"] = 12
+				graph.scripts = nil
+				imp = New(graph.querier(), WithBatchSize(2), WithCheckpoint(path))
+				report, err := imp.Import(context.Background(), knows())
+				So(err, ShouldBeNil)
+				So(report.Sources[0].Resumed, ShouldEqual, 1)
+				So(report.Sources[0].Imported, ShouldEqual, 1)
+				So(graph.scripts[len(graph.scripts)-1], ShouldContainSubstring, "g.V(10).addE(b0).to(__.V(12))")
+				So(graph.scripts[len(graph.scripts)-1], ShouldNotContainSubstring, "__.V(11)")
+
+				Convey("And the chunk should be done afterwards", func() {
+					graph.scripts = nil
+					report, err := imp.Import(context.Background(), knows())
+					So(err, ShouldBeNil)
+					So(report.Sources[0].Resumed, ShouldEqual, 2)
+					So(graph.scripts, ShouldBeEmpty)
+				})
+			})
+		})
+
+		Convey("When an import finished", func() {
+			graph := newMockGraph()
+			imp := New(graph.querier(), WithBatchSize(2), WithCheckpoint(path))
+			_, err := imp.Import(context.Background(), Source{Name: "people", Format: CSV, Reader: strings.NewReader(data), Mapping: peopleMapping})
+			So(err, ShouldBeNil)
+
+			Convey("Then starting it again should skip every chunk", func() {
+				graph.scripts = nil
+				report, err := imp.Import(context.Background(), Source{Name: "people", Format: CSV, Reader: strings.NewReader(data), Mapping: peopleMapping})
+				So(err, ShouldBeNil)
+				So(report.Sources[0].Resumed, ShouldEqual, 3)
+				So(graph.scripts, ShouldBeEmpty)
+			})
+			Convey("Then starting it with another batch size should fail", func() {
+				imp = New(graph.querier(), WithBatchSize(5), WithCheckpoint(path))
+				_, err := imp.Import(context.Background(), Source{Name: "people", Format: CSV, Reader: strings.NewReader(data), Mapping: peopleMapping})
+				So(errors.Is(err, gremerror.ErrInvalidCheckpoint), ShouldBeTrue)
+			})
+		})
+	})
+}
+
+func TestImportErrors(t *testing.T) {
+	Convey("Given a source with an invalid mapping", t, func() {
+		imp := New(newMockGraph().querier())
+		Convey("When Import is called", func() {
+			_, err := imp.Import(context.Background(), Source{Name: "x", Format: CSV, Reader: strings.NewReader("")})
+			Convey("Then the mapping error should be returned", func() {
+				So(errors.Is(err, gremerror.ErrInvalidMapping), ShouldBeTrue)
+			})
+		})
+	})
+	Convey("Given a source with a record that cannot be read", t, func() {
+		imp := New(newMockGraph().querier())
+		Convey("When Import is called", func() {
+			_, err := imp.Import(context.Background(), Source{Name: "x", Format: JSONL, Reader: strings.NewReader("{"), Mapping: peopleMapping})
+			Convey("Then the read error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "record 0")
+			})
+		})
+	})
+	Convey("Given a context that is done", t, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		imp := New(newMockGraph().querier())
+		Convey("When Import is called", func() {
+			_, err := imp.Import(ctx, Source{Name: "x", Format: CSV, Reader: strings.NewReader("id\np1\n"), Mapping: peopleMapping})
+			Convey("Then the context error should be returned", func() {
+				So(err, ShouldEqual, context.Canceled)
+			})
+		})
+	})
+}
@@ -0,0 +1,241 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package importer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/model"
+	"github.com/northwesternmutual/grammes/query/datatype"
+)
+
+// Mapping describes how the columns of a file, or the fields of
+// a JSON line, become a vertex or an edge. It's an edge mapping
+// when OutColumn and InColumn are set.
+type Mapping struct {
+	// Label is the label of every element unless LabelColumn is set.
+	Label       string `json:"label,omitempty" yaml:"label,omitempty"`
+	LabelColumn string `json:"labelColumn,omitempty" yaml:"labelColumn,omitempty"`
+	// KeyColumn holds the external key of a vertex, which
+	// is stored as the importer's key property so that
+	// edges can find the vertex later.
+	KeyColumn string `json:"keyColumn,omitempty" yaml:"keyColumn,omitempty"`
+	// OutColumn and InColumn hold the external
+	// keys of the vertices an edge connects.
+	OutColumn string `json:"outColumn,omitempty" yaml:"outColumn,omitempty"`
+	InColumn  string `json:"inColumn,omitempty" yaml:"inColumn,omitempty"`
+	// OutLabel and InLabel narrow down the
+	// vertices searched for the external keys.
+	OutLabel   string            `json:"outLabel,omitempty" yaml:"outLabel,omitempty"`
+	InLabel    string            `json:"inLabel,omitempty" yaml:"inLabel,omitempty"`
+	Properties []PropertyMapping `json:"properties,omitempty" yaml:"properties,omitempty"`
+}
+
+// PropertyMapping turns a column into a property. The key
+// defaults to the column and the data type to a string.
+type PropertyMapping struct {
+	Column   string            `json:"column" yaml:"column"`
+	Key      string            `json:"key,omitempty" yaml:"key,omitempty"`
+	DataType datatype.DataType `json:"dataType,omitempty" yaml:"dataType,omitempty"`
+}
+
+// mapper turns records into elements.
+type mapper interface {
+	IsEdge() bool
+	// endLabels returns the labels of the vertices
+	// an edge is connected to, when they're known.
+	endLabels() (out, in string)
+	mapRecord(rec record) (element, error)
+}
+
+// IsEdge returns whether the mapping makes edges.
+func (m Mapping) IsEdge() bool {
+	return m.OutColumn != "" || m.InColumn != ""
+}
+
+// DecodeMapping reads a mapping from a JSON or YAML document.
+func DecodeMapping(r io.Reader) (Mapping, error) {
+	var m Mapping
+
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return m, gremerror.NewGrammesError("DecodeMapping", err)
+	}
+
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		err = dec.Decode(&m)
+	} else {
+		err = yaml.UnmarshalStrict(data, &m)
+	}
+	if err != nil {
+		return m, gremerror.NewUnmarshalError("DecodeMapping", data, err)
+	}
+
+	return m, m.Validate()
+}
+
+// Validate checks the mapping can be used to import elements.
+func (m Mapping) Validate() error {
+	var problems []string
+
+	if m.Label == "" && m.LabelColumn == "" {
+		problems = append(problems, "a label or label column is required")
+	}
+	if m.IsEdge() && (m.OutColumn == "" || m.InColumn == "") {
+		problems = append(problems, "edges need both an out column and an in column")
+	}
+	if m.IsEdge() && m.KeyColumn != "" {
+		problems = append(problems, "edges cannot have a key column")
+	}
+	for _, p := range m.Properties {
+		if p.Column == "" {
+			problems = append(problems, "property has no column")
+		}
+		if _, ok := converters[p.dataType()]; !ok {
+			problems = append(problems, fmt.Sprintf("column %s: %v", p.Column, gremerror.ErrUnsupportedType))
+		}
+	}
+
+	if len(problems) > 0 {
+		return gremerror.NewGrammesError("Validate",
+			fmt.Errorf("%w: %s", gremerror.ErrInvalidMapping, strings.Join(problems, "; ")),
+		)
+	}
+	return nil
+}
+
+func (m Mapping) endLabels() (string, string) {
+	return m.OutLabel, m.InLabel
+}
+
+func (p PropertyMapping) key() string {
+	if p.Key != "" {
+		return p.Key
+	}
+	return p.Column
+}
+
+func (p PropertyMapping) dataType() datatype.DataType {
+	if p.DataType != "" {
+		return p.DataType
+	}
+	return datatype.String
+}
+
+// converters turn the value of a column into a value of
+// the data type. Strings come from CSV files and JSON
+// numbers come from JSON lines as a json.Number.
+var converters = map[datatype.DataType]func(interface{}) (interface{}, error){
+	datatype.String:    toString,
+	datatype.Character: toString,
+	datatype.Boolean:   toBool,
+	datatype.Byte:      toInt,
+	datatype.Short:     toInt,
+	datatype.Integer:   toInt,
+	datatype.Long:      toInt,
+	datatype.Float:     toFloat,
+	datatype.Double:    toFloat,
+	datatype.Object:    func(v interface{}) (interface{}, error) { return v, nil },
+}
+
+func toString(v interface{}) (interface{}, error) {
+	return fmt.Sprint(v), nil
+}
+
+func toBool(v interface{}) (interface{}, error) {
+	if b, ok := v.(bool); ok {
+		return b, nil
+	}
+	return strconv.ParseBool(fmt.Sprint(v))
+}
+
+func toInt(v interface{}) (interface{}, error) {
+	return strconv.ParseInt(fmt.Sprint(v), 10, 64)
+}
+
+func toFloat(v interface{}) (interface{}, error) {
+	return strconv.ParseFloat(fmt.Sprint(v), 64)
+}
+
+// element is a record after it has been mapped. Vertices
+// from graph files are kept as a model.Vertex so their
+// multi-properties and meta-properties are written.
+type element struct {
+	label      string
+	key        string
+	out, in    string
+	properties []interface{}
+	vertex     *model.Vertex
+}
+
+// mapRecord turns a record into an element. Properties
+// with a missing or empty column are left out.
+func (m Mapping) mapRecord(rec record) (element, error) {
+	var e element
+
+	e.label = m.Label
+	if m.LabelColumn != "" {
+		e.label = rec.text(m.LabelColumn)
+	}
+	if e.label == "" {
+		return e, fmt.Errorf("missing label")
+	}
+
+	if m.KeyColumn != "" {
+		if e.key = rec.text(m.KeyColumn); e.key == "" {
+			return e, fmt.Errorf("missing key column %s", m.KeyColumn)
+		}
+	}
+
+	if m.IsEdge() {
+		e.out, e.in = rec.text(m.OutColumn), rec.text(m.InColumn)
+		if e.out == "" || e.in == "" {
+			return e, fmt.Errorf("missing out or in column")
+		}
+	}
+
+	for _, p := range m.Properties {
+		v, ok := rec[p.Column]
+		if !ok || v == nil || v == "" {
+			continue
+		}
+
+		converted, err := converters[p.dataType()](v)
+		if err != nil {
+			return e, fmt.Errorf("column %s: %w", p.Column, err)
+		}
+
+		e.properties = append(e.properties, p.key(), converted)
+	}
+
+	return e, nil
+}
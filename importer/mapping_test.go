@@ -0,0 +1,140 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package importer
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/query/datatype"
+)
+
+func TestDecodeMapping(t *testing.T) {
+	Convey("Given a YAML mapping", t, func() {
+		doc := `
+label: person
+keyColumn: id
+properties:
+- column: name
+- column: age
+  dataType: Integer.class
+`
+		Convey("When DecodeMapping is called", func() {
+			m, err := DecodeMapping(strings.NewReader(doc))
+			Convey("Then the mapping should be read", func() {
+				So(err, ShouldBeNil)
+				So(m.Label, ShouldEqual, "person")
+				So(m.KeyColumn, ShouldEqual, "id")
+				So(m.Properties, ShouldResemble, []PropertyMapping{
+					{Column: "name"},
+					{Column: "age", DataType: datatype.Integer},
+				})
+				So(m.IsEdge(), ShouldBeFalse)
+			})
+		})
+	})
+	Convey("Given a JSON edge mapping", t, func() {
+		doc := `{"label": "knows", "outColumn": "from", "inColumn": "to"}`
+		Convey("When DecodeMapping is called", func() {
+			m, err := DecodeMapping(strings.NewReader(doc))
+			Convey("Then the mapping should be for edges", func() {
+				So(err, ShouldBeNil)
+				So(m.IsEdge(), ShouldBeTrue)
+			})
+		})
+	})
+	Convey("Given a mapping with an unknown field", t, func() {
+		doc := `{"label": "person", "labels": "x"}`
+		Convey("When DecodeMapping is called", func() {
+			_, err := DecodeMapping(strings.NewReader(doc))
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestValidateMapping(t *testing.T) {
+	Convey("Given an invalid mapping", t, func() {
+		m := Mapping{
+			OutColumn:  "from",
+			KeyColumn:  "id",
+			Properties: []PropertyMapping{{Column: "at", DataType: datatype.Date}},
+		}
+		Convey("When Validate is called", func() {
+			err := m.Validate()
+			Convey("Then every problem should be reported", func() {
+				So(errors.Is(err, gremerror.ErrInvalidMapping), ShouldBeTrue)
+				So(err.Error(), ShouldContainSubstring, "label")
+				So(err.Error(), ShouldContainSubstring, "in column")
+				So(err.Error(), ShouldContainSubstring, "key column")
+				So(err.Error(), ShouldContainSubstring, "column at")
+			})
+		})
+	})
+}
+
+func TestMapRecord(t *testing.T) {
+	Convey("Given a mapping with typed properties", t, func() {
+		m := Mapping{
+			LabelColumn: "type",
+			KeyColumn:   "id",
+			Properties: []PropertyMapping{
+				{Column: "name"},
+				{Column: "age", DataType: datatype.Long},
+				{Column: "score", Key: "rating", DataType: datatype.Double},
+				{Column: "active", DataType: datatype.Boolean},
+				{Column: "nickname"},
+			},
+		}
+		Convey("When a record is mapped", func() {
+			e, err := m.mapRecord(record{
+				"type": "person", "id": "p1", "name": "damien",
+				"age": "30", "score": "4.5", "active": "true", "nickname": "",
+			})
+			Convey("Then the values should be converted", func() {
+				So(err, ShouldBeNil)
+				So(e.label, ShouldEqual, "person")
+				So(e.key, ShouldEqual, "p1")
+				So(e.properties, ShouldResemble, []interface{}{
+					"name", "damien", "age", int64(30), "rating", 4.5, "active", true,
+				})
+			})
+		})
+		Convey("When a record has a value of the wrong type", func() {
+			_, err := m.mapRecord(record{"type": "person", "id": "p1", "age": "old"})
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "column age")
+			})
+		})
+		Convey("When a record has no key", func() {
+			_, err := m.mapRecord(record{"type": "person"})
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package importer
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Format is the format of a file being imported.
+type Format string
+
+const (
+	// CSV is a comma separated file with a header row.
+	CSV Format = "csv"
+	// JSONL is a file with a JSON object on every line.
+	JSONL Format = "jsonl"
+	// GraphML is a GraphML document such as the ones written
+	// by the exporter. It needs no mapping, and its nodes
+	// must come before its edges.
+	GraphML Format = "graphml"
+	// GraphSON is a GraphSON adjacency list such as the ones
+	// written by the exporter. It needs no mapping, and its
+	// edges are held in memory until every vertex is written.
+	GraphSON Format = "graphson"
+)
+
+// record is a row of a CSV file or a line of a JSONL file
+// with the values keyed by their column or field name.
+type record map[string]interface{}
+
+// text returns the value of the column as a string.
+func (r record) text(column string) string {
+	v, ok := r[column]
+	if !ok || v == nil {
+		return ""
+	}
+	return fmt.Sprint(v)
+}
+
+// recordReader reads records until it returns io.EOF.
+type recordReader interface {
+	Read() (record, error)
+}
+
+// newRecordReader returns the reader for the format.
+func newRecordReader(r io.Reader, format Format) (recordReader, error) {
+	switch format {
+	case CSV:
+		return newCSVReader(r)
+	case JSONL:
+		return &jsonlReader{r: bufio.NewReader(r)}, nil
+	default:
+		return nil, fmt.Errorf("unknown format %q", format)
+	}
+}
+
+type csvReader struct {
+	r      *csv.Reader
+	header []string
+}
+
+func newCSVReader(r io.Reader) (*csvReader, error) {
+	c := &csvReader{r: csv.NewReader(r)}
+	c.r.ReuseRecord = true
+
+	header, err := c.r.Read()
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	c.header = append(c.header, header...)
+
+	return c, nil
+}
+
+func (c *csvReader) Read() (record, error) {
+	if c.header == nil {
+		return nil, io.EOF
+	}
+
+	row, err := c.r.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	rec := make(record, len(c.header))
+	for i, column := range c.header {
+		if i < len(row) {
+			rec[column] = row[i]
+		}
+	}
+
+	return rec, nil
+}
+
+type jsonlReader struct {
+	r *bufio.Reader
+}
+
+func (j *jsonlReader) Read() (record, error) {
+	for {
+		line, err := j.r.ReadBytes('\n')
+		if len(bytes.TrimSpace(line)) == 0 {
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		dec := json.NewDecoder(bytes.NewReader(line))
+		dec.UseNumber()
+
+		var rec record
+		if decodeErr := dec.Decode(&rec); decodeErr != nil {
+			return nil, decodeErr
+		}
+
+		return rec, nil
+	}
+}
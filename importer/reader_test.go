@@ -0,0 +1,108 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package importer
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func readAll(r recordReader) ([]record, error) {
+	var records []record
+	for {
+		rec, err := r.Read()
+		if err == io.EOF {
+			return records, nil
+		}
+		if err != nil {
+			return records, err
+		}
+		records = append(records, rec)
+	}
+}
+
+func TestCSVReader(t *testing.T) {
+	Convey("Given a CSV file with a header", t, func() {
+		data := "id,name\np1,damien\np2,\n"
+		Convey("When the records are read", func() {
+			r, err := newRecordReader(strings.NewReader(data), CSV)
+			So(err, ShouldBeNil)
+			records, err := readAll(r)
+			Convey("Then every row should be keyed by its header", func() {
+				So(err, ShouldBeNil)
+				So(records, ShouldResemble, []record{
+					{"id": "p1", "name": "damien"},
+					{"id": "p2", "name": ""},
+				})
+			})
+		})
+	})
+	Convey("Given an empty CSV file", t, func() {
+		Convey("When the records are read", func() {
+			r, err := newRecordReader(strings.NewReader(""), CSV)
+			So(err, ShouldBeNil)
+			records, err := readAll(r)
+			Convey("Then there should be no records", func() {
+				So(err, ShouldBeNil)
+				So(records, ShouldBeEmpty)
+			})
+		})
+	})
+}
+
+func TestJSONLReader(t *testing.T) {
+	Convey("Given a JSON Lines file", t, func() {
+		data := "{\"id\": \"p1\", \"age\": 30}\n\n{\"id\": \"p2\"}"
+		Convey("When the records are read", func() {
+			r, err := newRecordReader(strings.NewReader(data), JSONL)
+			So(err, ShouldBeNil)
+			records, err := readAll(r)
+			Convey("Then every line should be a record", func() {
+				So(err, ShouldBeNil)
+				So(records, ShouldResemble, []record{
+					{"id": "p1", "age": json.Number("30")},
+					{"id": "p2"},
+				})
+			})
+		})
+	})
+	Convey("Given a JSON Lines file with an invalid line", t, func() {
+		Convey("When the records are read", func() {
+			r, _ := newRecordReader(strings.NewReader("{\"id\": \n"), JSONL)
+			_, err := readAll(r)
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+	Convey("Given an unknown format", t, func() {
+		Convey("When a reader is made", func() {
+			_, err := newRecordReader(strings.NewReader(""), Format("xml"))
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
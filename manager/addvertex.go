@@ -21,24 +21,26 @@
 package manager
 
 import (
+	"sort"
 	"strconv"
 
 	"github.com/northwesternmutual/grammes/gremerror"
 	"github.com/northwesternmutual/grammes/logging"
 	"github.com/northwesternmutual/grammes/model"
 	"github.com/northwesternmutual/grammes/query"
+	"github.com/northwesternmutual/grammes/query/cardinality"
 	"github.com/northwesternmutual/grammes/query/traversal"
 )
 
 type addVertexQueryManager struct {
-	logger             logging.Logger
-	executeStringQuery stringExecutor
+	logger       logging.Logger
+	executeQuery queryExecutor
 }
 
-func newAddVertexQueryManager(logger logging.Logger, executeString stringExecutor) *addVertexQueryManager {
+func newAddVertexQueryManager(logger logging.Logger, executor queryExecutor) *addVertexQueryManager {
 	return &addVertexQueryManager{
-		logger:             logger,
-		executeStringQuery: executeString,
+		logger:       logger,
+		executeQuery: executor,
 	}
 }
 
@@ -51,7 +53,7 @@ func (v *addVertexQueryManager) AddAPIVertex(data model.APIData) (model.Vertex,
 		query.AddStep("property", k, v)
 	}
 
-	addedVertex, err := v.AddVertexByString(query.String())
+	addedVertex, err := v.AddVertexByQuery(query)
 	if err != nil {
 		v.logger.Error("AddAPIVertex: invalid query adding vertex", err)
 		return addedVertex, err
@@ -63,17 +65,32 @@ func (v *addVertexQueryManager) AddAPIVertex(data model.APIData) (model.Vertex,
 // AddVertexByStruct will take a Vertex struct and create
 // a new vertex out of it in the Gremlin server. The only
 // exception is that you cannot manually set the ID.
+// Multi-properties are written with their cardinality
+// and every meta-property is written alongside its value.
 func (v *addVertexQueryManager) AddVertexByStruct(vertex model.Vertex) (model.Vertex, error) {
-	var properties []interface{}
+	query := traversal.NewTraversal().AddV(vertex.Label())
 
-	for key, vals := range vertex.Value.Properties {
-		properties = append(properties, key)
-		for _, val := range vals {
-			properties = append(properties, val.Value.Value.Value)
+	keys := make([]string, 0, len(vertex.Value.Properties))
+	for key := range vertex.Value.Properties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		card := vertex.PropertyCardinality(key)
+		for _, val := range vertex.Value.Properties[key] {
+			var params []interface{}
+			if card != cardinality.Single {
+				params = append(params, card)
+			}
+			params = append(params, key, val.GetValue())
+			params = append(params, val.MetaKeyValues()...)
+
+			query.AddStep("property", params...)
 		}
 	}
 
-	addedVertex, err := v.AddVertex(vertex.Label(), properties...)
+	addedVertex, err := v.AddVertexByQuery(query)
 	if err != nil {
 		v.logger.Error("AddVertexByStruct: invalid query adding vertex", err)
 		return addedVertex, err
@@ -105,7 +122,7 @@ func (v *addVertexQueryManager) AddVertex(label string, properties ...interface{
 		query.AddStep("property", properties[i], properties[i+1])
 	}
 
-	return v.AddVertexByString(query.String())
+	return v.AddVertexByQuery(query)
 }
 
 // AddVertexLabels will do the same as AddVertexLabel, but with
@@ -125,19 +142,18 @@ func (v *addVertexQueryManager) AddVertexLabels(labels ...string) ([]model.Verte
 	return vertices, nil
 }
 
-// AddVertexByQuery takes a query and returns an added Vertex
-// by turning it into a string.
-func (v *addVertexQueryManager) AddVertexByQuery(q query.Query) (model.Vertex, error) {
-	return v.AddVertexByString(q.String())
-}
-
 // AddVertexByString will take a query that's intended to add a vertex
 // and return it as a Vertex struct.
 func (v *addVertexQueryManager) AddVertexByString(query string) (model.Vertex, error) {
-	responses, err := v.executeStringQuery(query)
+	return v.AddVertexByQuery(script(query))
+}
+
+// AddVertexByQuery takes a query and returns the added Vertex.
+func (v *addVertexQueryManager) AddVertexByQuery(q query.Query) (model.Vertex, error) {
+	responses, err := v.executeQuery(q)
 	if err != nil {
 		v.logger.Error("invalid query",
-			gremerror.NewQueryError("AddVertexByString", query, err),
+			gremerror.NewQueryError("AddVertexByQuery", q.String(), err),
 		)
 		return nilVertex, err
 	}
@@ -150,7 +166,7 @@ func (v *addVertexQueryManager) AddVertexByString(query string) (model.Vertex, e
 		err = jsonUnmarshal(res, &vertPart)
 		if err != nil {
 			v.logger.Error("vertices unmarshal",
-				gremerror.NewUnmarshalError("AddVertexByString", res, err),
+				gremerror.NewUnmarshalError("AddVertexByQuery", res, err),
 			)
 			return nilVertex, err
 		}
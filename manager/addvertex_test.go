@@ -29,6 +29,8 @@ import (
 
 	"github.com/northwesternmutual/grammes/logging"
 	"github.com/northwesternmutual/grammes/model"
+	"github.com/northwesternmutual/grammes/query"
+	"github.com/northwesternmutual/grammes/query/cardinality"
 )
 
 var testVertex = model.Vertex{
@@ -64,7 +66,7 @@ func (mockQuery) String() string { return "TEST" }
 
 func TestAddAPIVertex(t *testing.T) {
 	Convey("Given a string executor and vertex query manager", t, func() {
-		execute := func(string) ([][]byte, error) { return [][]byte{[]byte(vertexResponse)}, nil }
+		execute := func(query.Query) ([][]byte, error) { return [][]byte{[]byte(vertexResponse)}, nil }
 		qm := newAddVertexQueryManager(logging.NewNilLogger(), execute)
 		Convey("When AddAPIVertex is called", func() {
 			var data model.APIData
@@ -79,7 +81,7 @@ func TestAddAPIVertex(t *testing.T) {
 
 func TestAddAPIVertexError(t *testing.T) {
 	Convey("Given a string executor and vertex query manager", t, func() {
-		execute := func(string) ([][]byte, error) { return nil, errors.New("ERROR") }
+		execute := func(query.Query) ([][]byte, error) { return nil, errors.New("ERROR") }
 		qm := newAddVertexQueryManager(logging.NewNilLogger(), execute)
 		Convey("When AddAPIVertex is called and an error occurs", func() {
 			var data model.APIData
@@ -93,7 +95,7 @@ func TestAddAPIVertexError(t *testing.T) {
 
 func TestAddVertexByStruct(t *testing.T) {
 	Convey("Given a string executor and vertex query manager", t, func() {
-		execute := func(string) ([][]byte, error) { return [][]byte{[]byte(vertexResponse)}, nil }
+		execute := func(query.Query) ([][]byte, error) { return [][]byte{[]byte(vertexResponse)}, nil }
 		qm := newAddVertexQueryManager(logging.NewNilLogger(), execute)
 		Convey("When AddVertexByStruct is called", func() {
 			res, _ := qm.AddVertexByStruct(testVertex)
@@ -106,7 +108,7 @@ func TestAddVertexByStruct(t *testing.T) {
 
 func TestAddVertexByStructError(t *testing.T) {
 	Convey("Given a string executor and vertex query manager", t, func() {
-		execute := func(string) ([][]byte, error) { return nil, errors.New("ERROR") }
+		execute := func(query.Query) ([][]byte, error) { return nil, errors.New("ERROR") }
 		qm := newAddVertexQueryManager(logging.NewNilLogger(), execute)
 		Convey("When AddVertexByStruct is called and an error is thrown", func() {
 			_, err := qm.AddVertexByStruct(testVertex)
@@ -119,7 +121,7 @@ func TestAddVertexByStructError(t *testing.T) {
 
 func TestAddVertexError(t *testing.T) {
 	Convey("Given a string executor and vertex query manager", t, func() {
-		execute := func(string) ([][]byte, error) { return [][]byte{[]byte(vertexResponse)}, nil }
+		execute := func(query.Query) ([][]byte, error) { return [][]byte{[]byte(vertexResponse)}, nil }
 		qm := newAddVertexQueryManager(logging.NewNilLogger(), execute)
 		Convey("When AddVertex is called with an odd number of parameters", func() {
 			_, err := qm.AddVertex("testLabel", "prop1")
@@ -132,7 +134,7 @@ func TestAddVertexError(t *testing.T) {
 
 func TestAddVertexLabels(t *testing.T) {
 	Convey("Given a string executor and vertex query manager", t, func() {
-		execute := func(string) ([][]byte, error) { return [][]byte{[]byte(vertexResponse)}, nil }
+		execute := func(query.Query) ([][]byte, error) { return [][]byte{[]byte(vertexResponse)}, nil }
 		qm := newAddVertexQueryManager(logging.NewNilLogger(), execute)
 		Convey("When AddVertexLabels is called", func() {
 			_, err := qm.AddVertexLabels("testlabel")
@@ -145,7 +147,7 @@ func TestAddVertexLabels(t *testing.T) {
 
 func TestAddVertexLabelsQueryError(t *testing.T) {
 	Convey("Given a string executor and vertex query manager", t, func() {
-		execute := func(string) ([][]byte, error) { return nil, errors.New("ERROR") }
+		execute := func(query.Query) ([][]byte, error) { return nil, errors.New("ERROR") }
 		qm := newAddVertexQueryManager(logging.NewNilLogger(), execute)
 		Convey("When AddVertexLabels is called and encounters a querying error", func() {
 			_, err := qm.AddVertexLabels("testlabel")
@@ -158,7 +160,7 @@ func TestAddVertexLabelsQueryError(t *testing.T) {
 
 func TestAddVertexByQuery(t *testing.T) {
 	Convey("Given a string executor and vertex query manager", t, func() {
-		execute := func(string) ([][]byte, error) { return [][]byte{[]byte(vertexResponse)}, nil }
+		execute := func(query.Query) ([][]byte, error) { return [][]byte{[]byte(vertexResponse)}, nil }
 		qm := newAddVertexQueryManager(logging.NewNilLogger(), execute)
 		Convey("When AddVertexByQuery is called", func() {
 			var q mockQuery
@@ -176,7 +178,7 @@ func TestAddVertexByStringJsonUnmarshalError(t *testing.T) {
 	}()
 	jsonUnmarshal = func([]byte, interface{}) error { return errors.New("ERROR") }
 	Convey("Given a string executor and vertex query manager", t, func() {
-		execute := func(string) ([][]byte, error) { return [][]byte{[]byte(vertexResponse)}, nil }
+		execute := func(query.Query) ([][]byte, error) { return [][]byte{[]byte(vertexResponse)}, nil }
 		qm := newAddVertexQueryManager(logging.NewNilLogger(), execute)
 		Convey("When AddVertexByString throws an error while unmarshalling", func() {
 			_, err := qm.AddVertexByString("testquery")
@@ -193,7 +195,7 @@ func TestAddVertexByStringReturnnilVertex(t *testing.T) {
 	}()
 	jsonUnmarshal = func([]byte, interface{}) error { return nil }
 	Convey("Given a string executor and vertex query manager", t, func() {
-		execute := func(string) ([][]byte, error) { return [][]byte{[]byte(vertexResponse)}, nil }
+		execute := func(query.Query) ([][]byte, error) { return [][]byte{[]byte(vertexResponse)}, nil }
 		qm := newAddVertexQueryManager(logging.NewNilLogger(), execute)
 		Convey("When AddVertexByString is called and no vertices are added", func() {
 			res, _ := qm.AddVertexByString("testquery")
@@ -203,3 +205,34 @@ func TestAddVertexByStringReturnnilVertex(t *testing.T) {
 		})
 	})
 }
+
+func TestAddVertexByStructMultiProperties(t *testing.T) {
+	Convey("Given a string executor and vertex query manager", t, func() {
+		var sent string
+		execute := func(q query.Query) ([][]byte, error) {
+			sent = q.String()
+			return [][]byte{[]byte(vertexResponse)}, nil
+		}
+		qm := newAddVertexQueryManager(logging.NewNilLogger(), execute)
+		Convey("When AddVertexByStruct is called with multi-properties and meta-properties", func() {
+			vertex := model.NewVertex("person", "name", "damien")
+			vertex.AddPropertyValue("location", "chicago", "startTime", 1997)
+			vertex.AddPropertyValue("location", "milwaukee")
+			_, err := qm.AddVertexByStruct(vertex)
+			Convey("Then the properties should be written with their cardinality", func() {
+				So(err, ShouldBeNil)
+				So(sent, ShouldEqual, `g.addV("person").property(list,"location","chicago","startTime",1997).property(list,"location","milwaukee").property("name","damien")`)
+			})
+		})
+
+		Convey("When AddVertexByStruct is called with a set cardinality", func() {
+			vertex := model.NewVertex("person", "nickname", "jim")
+			vertex.SetPropertyCardinality("nickname", cardinality.Set)
+			_, err := qm.AddVertexByStruct(vertex)
+			Convey("Then the property should be written with set", func() {
+				So(err, ShouldBeNil)
+				So(sent, ShouldEqual, `g.addV("person").property(set,"nickname","jim")`)
+			})
+		})
+	})
+}
@@ -0,0 +1,548 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package manager
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/northwesternmutual/grammes/dialect"
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/logging"
+	"github.com/northwesternmutual/grammes/model"
+	"github.com/northwesternmutual/grammes/query"
+	__ "github.com/northwesternmutual/grammes/query/anonymous"
+	"github.com/northwesternmutual/grammes/query/cardinality"
+	"github.com/northwesternmutual/grammes/query/traversal"
+)
+
+const (
+	// defaultBatchOperations is how many operations
+	// are sent to the server in one request by default.
+	defaultBatchOperations = 100
+	// defaultBatchBytes keeps a request under the default
+	// 64KB maxContentLength of the Gremlin Server.
+	defaultBatchBytes = 60 * 1024
+)
+
+// Ref refers to the element made by an earlier operation in
+// the same batch, so it can be used before it has an ID. It's
+// also the index of the operation's result in the BatchReport.
+type Ref int
+
+type batchOpKind int
+
+const (
+	opAddVertex batchOpKind = iota
+	opAddEdge
+	opSetVertexProperty
+	opSetEdgeProperty
+	opDropVertex
+	opDropEdge
+)
+
+// batchOp is a single operation waiting in a batch.
+type batchOp struct {
+	kind       batchOpKind
+	label      string
+	ids        []interface{}
+	properties []interface{}
+	// propertySteps are the parameters of property steps
+	// that need more than a key and a value, such as the
+	// ones holding a cardinality or meta-properties.
+	propertySteps [][]interface{}
+	err           error
+}
+
+// BatchOption is used to change the size of the chunks
+// a batch is split into when it's executed.
+type BatchOption func(*Batch)
+
+// WithMaxOperations sets how many operations
+// can be sent to the server in one request.
+func WithMaxOperations(n int) BatchOption {
+	return func(b *Batch) {
+		b.maxOperations = n
+	}
+}
+
+// WithMaxBytes sets how large the script and bindings
+// of one request can be. An operation that's larger
+// than this on its own is sent in a request by itself.
+func WithMaxBytes(n int) BatchOption {
+	return func(b *Batch) {
+		b.maxBytes = n
+	}
+}
+
+// Batch accumulates operations that change the graph so they
+// can be sent to the server in a few large requests rather than
+// one request each. The operations are compiled into scripts
+// where every string is sent as a binding.
+type Batch struct {
+	ops           []batchOp
+	maxOperations int
+	maxBytes      int
+}
+
+// NewBatch returns an empty batch.
+func NewBatch(options ...BatchOption) *Batch {
+	b := &Batch{
+		maxOperations: defaultBatchOperations,
+		maxBytes:      defaultBatchBytes,
+	}
+
+	for _, option := range options {
+		option(b)
+	}
+
+	return b
+}
+
+// Len returns how many operations are in the batch.
+func (b *Batch) Len() int {
+	return len(b.ops)
+}
+
+// add appends the operation after checking the
+// properties are paired and the refs are earlier.
+func (b *Batch) add(function string, op batchOp) Ref {
+	ref := Ref(len(b.ops))
+
+	if len(op.properties)%2 != 0 {
+		op.err = gremerror.NewGrammesError(function, gremerror.ErrOddNumberOfParameters)
+	}
+	for _, id := range op.ids {
+		if r, ok := id.(Ref); ok && (r < 0 || r >= ref) {
+			op.err = gremerror.NewGrammesError(function, gremerror.ErrBatchDependency)
+		}
+	}
+
+	b.ops = append(b.ops, op)
+
+	return ref
+}
+
+// AddVertex adds a vertex with the label and properties provided.
+func (b *Batch) AddVertex(label string, properties ...interface{}) Ref {
+	return b.add("AddVertex", batchOp{kind: opAddVertex, label: label, properties: properties})
+}
+
+// AddVertexByStruct adds a vertex made from the struct. As with
+// the AddVertexByStruct of the graph, multi-properties are written
+// with their cardinality alongside their meta-properties.
+func (b *Batch) AddVertexByStruct(vertex model.Vertex) Ref {
+	op := batchOp{kind: opAddVertex, label: vertex.Label()}
+
+	keys := make([]string, 0, len(vertex.Value.Properties))
+	for key := range vertex.Value.Properties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		card := vertex.PropertyCardinality(key)
+		for _, val := range vertex.Value.Properties[key] {
+			var params []interface{}
+			if card != cardinality.Single {
+				params = append(params, card)
+			}
+			params = append(params, key, val.GetValue())
+			params = append(params, val.MetaKeyValues()...)
+
+			op.propertySteps = append(op.propertySteps, params)
+		}
+	}
+
+	return b.add("AddVertexByStruct", op)
+}
+
+// AddEdge adds an edge from the out vertex to the in vertex,
+// which can each be either an ID or a Ref to a new vertex.
+func (b *Batch) AddEdge(outV, inV interface{}, label string, properties ...interface{}) Ref {
+	return b.add("AddEdge", batchOp{kind: opAddEdge, label: label, ids: []interface{}{outV, inV}, properties: properties})
+}
+
+// SetVertexProperty adds or sets the properties of a vertex.
+func (b *Batch) SetVertexProperty(id interface{}, keyAndVals ...interface{}) Ref {
+	return b.add("SetVertexProperty", batchOp{kind: opSetVertexProperty, ids: []interface{}{id}, properties: keyAndVals})
+}
+
+// SetEdgeProperty adds or sets the properties of an edge.
+func (b *Batch) SetEdgeProperty(id interface{}, keyAndVals ...interface{}) Ref {
+	return b.add("SetEdgeProperty", batchOp{kind: opSetEdgeProperty, ids: []interface{}{edgeID(id)}, properties: keyAndVals})
+}
+
+// DropVertex drops a vertex.
+func (b *Batch) DropVertex(id interface{}) Ref {
+	return b.add("DropVertex", batchOp{kind: opDropVertex, ids: []interface{}{id}})
+}
+
+// DropEdge drops an edge.
+func (b *Batch) DropEdge(id interface{}) Ref {
+	return b.add("DropEdge", batchOp{kind: opDropEdge, ids: []interface{}{edgeID(id)}})
+}
+
+// BatchResult is the outcome of a single operation in a batch.
+// ID is the ID of the element that was added or changed.
+type BatchResult struct {
+	ID  interface{}
+	Err error
+}
+
+// BatchReport holds the result of every operation
+// in a batch in the order they were added.
+type BatchReport struct {
+	Results []BatchResult
+	// Requests is how many requests were sent to the server.
+	Requests int
+}
+
+// ID returns the ID of the element from the operation.
+func (r BatchReport) ID(ref Ref) interface{} {
+	if int(ref) < 0 || int(ref) >= len(r.Results) {
+		return nil
+	}
+	return r.Results[ref].ID
+}
+
+// Failed returns the refs of the operations that failed.
+func (r BatchReport) Failed() []Ref {
+	var failed []Ref
+	for i, res := range r.Results {
+		if res.Err != nil {
+			failed = append(failed, Ref(i))
+		}
+	}
+	return failed
+}
+
+// Err returns a BatchError when any operation failed.
+func (r BatchReport) Err() error {
+	failed := r.Failed()
+	if len(failed) == 0 {
+		return nil
+	}
+	return gremerror.NewBatchError("ExecuteBatch", len(failed), len(r.Results), r.Results[failed[0]].Err)
+}
+
+// batchChunk is the script of the operations
+// being sent to the server in one request.
+type batchChunk struct {
+	refs     []Ref
+	lines    []string
+	bindings map[string]string
+	size     int
+}
+
+func newBatchChunk() *batchChunk {
+	return &batchChunk{bindings: make(map[string]string)}
+}
+
+// batchStatement is a compiled operation that
+// hasn't been added to a chunk yet.
+type batchStatement struct {
+	line     string
+	bindings []string
+}
+
+// size estimates how many bytes the statement adds to the request.
+func (s batchStatement) size() int {
+	size := len(s.line) + 1
+	for _, b := range s.bindings {
+		size += len(b) + 4
+	}
+	return size
+}
+
+// compiler turns operations into statements
+// for the chunk that's being built.
+type compiler struct {
+	chunk    *batchChunk
+	results  []BatchResult
+	bindings []string
+	dialect  *dialect.Dialect
+}
+
+// refName is the variable holding the ID made by an operation.
+func refName(ref Ref) string {
+	return "r" + strconv.Itoa(int(ref))
+}
+
+// bind adds a string as a binding and returns its name.
+func (c *compiler) bind(s string) traversal.Custom {
+	name := "b" + strconv.Itoa(len(c.chunk.bindings)+len(c.bindings)/2)
+	c.bindings = append(c.bindings, name, s)
+	return traversal.Custom(name)
+}
+
+// resolve returns the ID made by the operation the ref points
+// to, or the variable holding it when it's in the same chunk.
+// Any other value is returned as it is.
+func (c *compiler) resolve(v interface{}) (interface{}, error) {
+	ref, ok := v.(Ref)
+	if !ok {
+		return v, nil
+	}
+
+	for _, r := range c.chunk.refs {
+		if r == ref {
+			return traversal.Custom(refName(ref)), nil
+		}
+	}
+	if c.results[ref].Err != nil {
+		return nil, gremerror.ErrBatchDependency
+	}
+	return c.results[ref].ID, nil
+}
+
+// value renders a value used by the operation.
+func (c *compiler) value(v interface{}) (traversal.Custom, error) {
+	v, err := c.resolve(v)
+	if err != nil {
+		return "", err
+	}
+	return c.render(v), nil
+}
+
+// id renders the ID of an element the way the dialect represents it.
+func (c *compiler) id(v interface{}) (traversal.Custom, error) {
+	v, err := c.resolve(v)
+	if err != nil {
+		return "", err
+	}
+	if _, ok := v.(traversal.Custom); !ok {
+		v = c.dialect.ID(v)
+	}
+	return c.render(v), nil
+}
+
+// render writes the value into the statement. Strings are
+// added as bindings and other values are written the way
+// traversals write their arguments.
+func (c *compiler) render(v interface{}) traversal.Custom {
+	switch t := v.(type) {
+	case traversal.Custom:
+		return t
+	case string:
+		return c.bind(t)
+	}
+	return traversal.Custom(query.Literal(v))
+}
+
+// compile turns the operation into a statement that assigns the
+// ID of the element to a variable, or null when it's dropped.
+func (c *compiler) compile(ref Ref, op batchOp) (batchStatement, error) {
+	c.bindings = nil
+
+	ids := make([]traversal.Custom, len(op.ids))
+	for i, id := range op.ids {
+		v, err := c.id(id)
+		if err != nil {
+			return batchStatement{}, err
+		}
+		ids[i] = v
+	}
+
+	g := traversal.NewTraversal()
+	switch op.kind {
+	case opAddVertex:
+		g = g.AddV(c.bind(op.label))
+	case opAddEdge:
+		g = g.V(ids[0])
+		g.AddStep("addE", c.bind(op.label))
+		g = g.To(__.V(ids[1]))
+	case opSetVertexProperty, opDropVertex:
+		g = g.V(ids[0])
+	case opSetEdgeProperty, opDropEdge:
+		g.AddStep("E", ids[0])
+	}
+
+	for i := 0; i+1 < len(op.properties); i += 2 {
+		key, err := c.value(op.properties[i])
+		if err != nil {
+			return batchStatement{}, err
+		}
+		val, err := c.value(op.properties[i+1])
+		if err != nil {
+			return batchStatement{}, err
+		}
+		g = g.Property(key, val)
+	}
+	for _, step := range op.propertySteps {
+		params := make([]interface{}, len(step))
+		for i, p := range step {
+			v, err := c.value(p)
+			if err != nil {
+				return batchStatement{}, err
+			}
+			params[i] = v
+		}
+		g.AddStep("property", params...)
+	}
+
+	var line string
+	switch op.kind {
+	case opDropVertex, opDropEdge:
+		line = g.Drop().String() + ".iterate(); " + refName(ref) + " = null"
+	default:
+		line = refName(ref) + " = " + g.ID().String() + ".next()"
+	}
+
+	return batchStatement{line: line, bindings: c.bindings}, nil
+}
+
+// add puts the statement of the operation into the chunk.
+func (c *batchChunk) add(ref Ref, s batchStatement) {
+	c.refs = append(c.refs, ref)
+	c.lines = append(c.lines, s.line)
+	for i := 0; i+1 < len(s.bindings); i += 2 {
+		c.bindings[s.bindings[i]] = s.bindings[i+1]
+	}
+	c.size += s.size()
+}
+
+// script returns the chunk's script, which ends
+// with the list of IDs made by its operations.
+func (c *batchChunk) script() string {
+	names := make([]string, len(c.refs))
+	for i, ref := range c.refs {
+		names[i] = refName(ref)
+	}
+	return strings.Join(c.lines, "\n") + "\n[" + strings.Join(names, ",") + "]"
+}
+
+type batchQueryManager struct {
+	logger                  logging.Logger
+	executeBoundStringQuery executor
+	// dialect gives the IDs the representation of the database.
+	dialect *dialect.Dialect
+}
+
+func newBatchQueryManager(logger logging.Logger, executor executor) *batchQueryManager {
+	return &batchQueryManager{
+		logger:                  logger,
+		executeBoundStringQuery: executor,
+	}
+}
+
+// ExecuteBatch sends the operations in the batch to the server in
+// chunks. When a chunk fails every operation in it fails, and any
+// later operation using an element from them fails as well without
+// being sent. The report is returned alongside the report's Err.
+func (m *batchQueryManager) ExecuteBatch(b *Batch) (BatchReport, error) {
+	report := BatchReport{Results: make([]BatchResult, len(b.ops))}
+	c := &compiler{chunk: newBatchChunk(), results: report.Results, dialect: m.dialect}
+
+	for i, op := range b.ops {
+		ref := Ref(i)
+		if op.err != nil {
+			report.Results[ref].Err = op.err
+			continue
+		}
+
+		s, err := c.compile(ref, op)
+		if err == nil && len(c.chunk.refs) > 0 &&
+			(len(c.chunk.refs)+1 > b.maxOperations || c.chunk.size+s.size() > b.maxBytes) {
+			m.executeChunk(c.chunk, &report)
+			c.chunk = newBatchChunk()
+			s, err = c.compile(ref, op)
+		}
+		if err != nil {
+			report.Results[ref].Err = gremerror.NewGrammesError("ExecuteBatch", err)
+			continue
+		}
+
+		c.chunk.add(ref, s)
+	}
+
+	if len(c.chunk.refs) > 0 {
+		m.executeChunk(c.chunk, &report)
+	}
+
+	return report, report.Err()
+}
+
+// executeChunk sends the chunk to the server and
+// records the IDs or the error in the report.
+func (m *batchQueryManager) executeChunk(chunk *batchChunk, report *BatchReport) {
+	report.Requests++
+
+	script := chunk.script()
+	responses, err := m.executeBoundStringQuery(script, chunk.bindings, map[string]string{})
+	if err != nil {
+		m.logger.Error("invalid query",
+			gremerror.NewQueryError("ExecuteBatch", script, err),
+		)
+	}
+
+	var ids []interface{}
+	if err == nil {
+		ids, err = unmarshalBatchIDs(responses)
+		if err == nil && len(ids) != len(chunk.refs) {
+			err = gremerror.NewGrammesError("ExecuteBatch",
+				fmt.Errorf("expected %d IDs but received %d", len(chunk.refs), len(ids)),
+			)
+		}
+		if err != nil {
+			m.logger.Error("unmarshal", err)
+		}
+	}
+
+	for i, ref := range chunk.refs {
+		if err != nil {
+			report.Results[ref].Err = err
+			continue
+		}
+		report.Results[ref].ID = ids[i]
+	}
+}
+
+// unmarshalBatchIDs gathers the IDs from every response. Relation
+// identifiers are turned into strings so they can be used again.
+func unmarshalBatchIDs(data [][]byte) ([]interface{}, error) {
+	var ids []interface{}
+
+	for _, res := range data {
+		decoded, err := model.UnmarshalGraphSON(res)
+		if err != nil {
+			return nil, err
+		}
+
+		items, ok := decoded.([]interface{})
+		if !ok {
+			items = []interface{}{decoded}
+		}
+
+		for _, id := range items {
+			if m, ok := id.(map[string]interface{}); ok {
+				if relationID, ok := m["relationId"].(string); ok {
+					id = relationID
+				}
+			}
+			ids = append(ids, id)
+		}
+	}
+
+	return ids, nil
+}
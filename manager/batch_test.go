@@ -0,0 +1,290 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package manager
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/dialect"
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/logging"
+	"github.com/northwesternmutual/grammes/model"
+)
+
+// batchRequest is a request made while executing a batch.
+type batchRequest struct {
+	script   string
+	bindings map[string]string
+}
+
+// batchExecutor records each request and answers with
+// as many IDs as the script returns, counting up from 1.
+func batchExecutor(requests *[]batchRequest, fail int) executor {
+	next := 1
+	return func(script string, bindings, _ map[string]string) ([][]byte, error) {
+		*requests = append(*requests, batchRequest{script: script, bindings: bindings})
+		if len(*requests) == fail {
+			return nil, errors.New("ERROR")
+		}
+
+		lines := strings.Split(script, "\n")
+		var ids []string
+		for range strings.Split(lines[len(lines)-1], ",") {
+			ids = append(ids, `{"@type":"g:Int64","@value":`+strconv.Itoa(next)+`}`)
+			next++
+		}
+		list := "[" + strings.Join(ids, ",")
+		return [][]byte{[]byte(`{"@type":"g:List","@value":` + list + `]}`)}, nil
+	}
+}
+
+func TestExecuteBatch(t *testing.T) {
+	Convey("Given a batch with vertices and an edge between them", t, func() {
+		b := NewBatch()
+		a := b.AddVertex("person", "name", "damien", "age", 30)
+		c := b.AddVertex("person")
+		e := b.AddEdge(a, c, "knows")
+		Convey("When ExecuteBatch is called", func() {
+			var requests []batchRequest
+			bm := newBatchQueryManager(logging.NewNilLogger(), batchExecutor(&requests, 0))
+			report, err := bm.ExecuteBatch(b)
+			Convey("Then the operations should be sent in one script with bindings", func() {
+				So(err, ShouldBeNil)
+				So(requests, ShouldHaveLength, 1)
+				So(requests[0].script, ShouldEqual,
+					"r0 = g.addV(b0).property(b1,b2).property(b3,30).id().next()\n"+
+						"r1 = g.addV(b4).id().next()\n"+
+						"r2 = g.V(r0).addE(b5).to(__.V(r1)).id().next()\n"+
+						"[r0,r1,r2]")
+				So(requests[0].bindings, ShouldResemble, map[string]string{
+					"b0": "person", "b1": "name", "b2": "damien", "b3": "age", "b4": "person", "b5": "knows",
+				})
+			})
+			Convey("Then the report should hold the ID of every operation", func() {
+				So(report.Requests, ShouldEqual, 1)
+				So(report.ID(a), ShouldEqual, 1)
+				So(report.ID(c), ShouldEqual, 2)
+				So(report.ID(e), ShouldEqual, 3)
+				So(report.Failed(), ShouldBeEmpty)
+			})
+		})
+	})
+}
+
+func TestExecuteBatchDialect(t *testing.T) {
+	Convey("Given a batch with an edge between existing vertices and a new one", t, func() {
+		b := NewBatch()
+		a := b.AddVertex("person")
+		b.AddEdge(1, 2, "knows")
+		b.AddEdge(a, 2, "knows")
+		Convey("When ExecuteBatch is called with a dialect with Long IDs", func() {
+			var requests []batchRequest
+			bm := newBatchQueryManager(logging.NewNilLogger(), batchExecutor(&requests, 0))
+			bm.dialect = dialect.TinkerGraph
+			_, err := bm.ExecuteBatch(b)
+			Convey("Then the IDs should be Longs", func() {
+				So(err, ShouldBeNil)
+				So(requests[0].script, ShouldEqual,
+					"r0 = g.addV(b0).id().next()\n"+
+						"r1 = g.V(1L).addE(b1).to(__.V(2L)).id().next()\n"+
+						"r2 = g.V(r0).addE(b2).to(__.V(2L)).id().next()\n"+
+						"[r0,r1,r2]")
+			})
+		})
+		Convey("When ExecuteBatch is called with a dialect with string IDs", func() {
+			var requests []batchRequest
+			bm := newBatchQueryManager(logging.NewNilLogger(), batchExecutor(&requests, 0))
+			bm.dialect = dialect.Neptune
+			_, err := bm.ExecuteBatch(b)
+			Convey("Then the IDs should be bound as strings", func() {
+				So(err, ShouldBeNil)
+				So(requests[0].script, ShouldStartWith, "r0 = g.addV(b0).id().next()\n"+
+					"r1 = g.V(b1).addE(b3).to(__.V(b2)).id().next()\n")
+				So(requests[0].bindings["b1"], ShouldEqual, "1")
+				So(requests[0].bindings["b2"], ShouldEqual, "2")
+			})
+		})
+	})
+}
+
+func TestExecuteBatchValues(t *testing.T) {
+	Convey("Given a batch with a time, a nil and a slice as values", t, func() {
+		b := NewBatch()
+		b.AddVertex("person", "born", time.Unix(1, 0), "nickname", nil, "tags", []string{"a", "b"})
+		Convey("When ExecuteBatch is called", func() {
+			var requests []batchRequest
+			bm := newBatchQueryManager(logging.NewNilLogger(), batchExecutor(&requests, 0))
+			_, err := bm.ExecuteBatch(b)
+			Convey("Then the values should be Groovy literals", func() {
+				So(err, ShouldBeNil)
+				So(requests[0].script, ShouldEqual,
+					"r0 = g.addV(b0).property(b1,new Date(1000L)).property(b2,null)"+
+						`.property(b3,["a","b"]).id().next()`+"\n[r0]")
+			})
+		})
+	})
+}
+
+func TestBatchAddVertexByStruct(t *testing.T) {
+	Convey("Given a batch with a vertex that has multi-properties and meta-properties", t, func() {
+		v := model.NewVertex("person", "name", "damien")
+		v.AddPropertyValue("nickname", "dami", "since", 2010)
+		v.AddPropertyValue("nickname", "d")
+		b := NewBatch()
+		b.AddVertexByStruct(v)
+		Convey("When ExecuteBatch is called", func() {
+			var requests []batchRequest
+			bm := newBatchQueryManager(logging.NewNilLogger(), batchExecutor(&requests, 0))
+			_, err := bm.ExecuteBatch(b)
+			Convey("Then every value should be written with its cardinality and meta-properties", func() {
+				So(err, ShouldBeNil)
+				So(requests[0].script, ShouldEqual,
+					"r0 = g.addV(b0).property(b1,b2).property(list,b3,b4,b5,2010).property(list,b6,b7).id().next()\n"+
+						"[r0]")
+				So(requests[0].bindings, ShouldResemble, map[string]string{
+					"b0": "person", "b1": "name", "b2": "damien", "b3": "nickname", "b4": "dami",
+					"b5": "since", "b6": "nickname", "b7": "d",
+				})
+			})
+		})
+	})
+}
+
+func TestExecuteBatchChunks(t *testing.T) {
+	Convey("Given a batch with more operations than fit in one request", t, func() {
+		b := NewBatch(WithMaxOperations(2))
+		a := b.AddVertex("person")
+		b.AddVertex("person")
+		b.SetVertexProperty(a, "name", "damien")
+		b.DropEdge("zz0-yxs-25rf9-1548")
+		Convey("When ExecuteBatch is called", func() {
+			var requests []batchRequest
+			bm := newBatchQueryManager(logging.NewNilLogger(), batchExecutor(&requests, 0))
+			report, err := bm.ExecuteBatch(b)
+			Convey("Then the batch should be split into chunks", func() {
+				So(err, ShouldBeNil)
+				So(report.Requests, ShouldEqual, 2)
+				So(requests, ShouldHaveLength, 2)
+			})
+			Convey("Then the later chunk should use the IDs from the earlier one", func() {
+				So(requests[1].script, ShouldEqual,
+					"r2 = g.V(1).property(b0,b1).id().next()\n"+
+						"g.E(b2).drop().iterate(); r3 = null\n"+
+						"[r2,r3]")
+				So(requests[1].bindings["b2"], ShouldEqual, "zz0-yxs-25rf9-1548")
+			})
+		})
+	})
+	Convey("Given a batch with more bytes than fit in one request", t, func() {
+		b := NewBatch(WithMaxBytes(50))
+		b.AddVertex("person", "name", "damien")
+		b.AddVertex("person", "name", "damien")
+		Convey("When ExecuteBatch is called", func() {
+			var requests []batchRequest
+			bm := newBatchQueryManager(logging.NewNilLogger(), batchExecutor(&requests, 0))
+			report, err := bm.ExecuteBatch(b)
+			Convey("Then every operation should be sent by itself", func() {
+				So(err, ShouldBeNil)
+				So(report.Requests, ShouldEqual, 2)
+			})
+		})
+	})
+}
+
+func TestExecuteBatchPartialFailure(t *testing.T) {
+	Convey("Given a batch where the first chunk fails", t, func() {
+		b := NewBatch(WithMaxOperations(1))
+		a := b.AddVertex("person")
+		c := b.AddVertex("person")
+		e := b.AddEdge(a, c, "knows")
+		odd := b.AddVertex("person", "name")
+		Convey("When ExecuteBatch is called", func() {
+			var requests []batchRequest
+			bm := newBatchQueryManager(logging.NewNilLogger(), batchExecutor(&requests, 1))
+			report, err := bm.ExecuteBatch(b)
+			Convey("Then a batch error should be returned", func() {
+				var batchErr *gremerror.BatchError
+				So(errors.As(err, &batchErr), ShouldBeTrue)
+				So(batchErr.Failed(), ShouldEqual, 3)
+			})
+			Convey("Then the operations that could be applied should still be applied", func() {
+				So(requests, ShouldHaveLength, 2)
+				So(report.ID(c), ShouldNotBeNil)
+			})
+			Convey("Then the report should hold why the other operations failed", func() {
+				So(report.Failed(), ShouldResemble, []Ref{a, e, odd})
+				So(errors.Is(report.Results[e].Err, gremerror.ErrBatchDependency), ShouldBeTrue)
+				So(errors.Is(report.Results[odd].Err, gremerror.ErrOddNumberOfParameters), ShouldBeTrue)
+			})
+		})
+	})
+}
+
+func TestExecuteBatchInvalidResponse(t *testing.T) {
+	Convey("Given a batch and an executor that returns too few IDs", t, func() {
+		b := NewBatch()
+		b.AddVertex("person")
+		b.AddVertex("person")
+		execute := func(string, map[string]string, map[string]string) ([][]byte, error) {
+			return [][]byte{[]byte(`[1]`)}, nil
+		}
+		bm := newBatchQueryManager(logging.NewNilLogger(), execute)
+		Convey("When ExecuteBatch is called", func() {
+			report, err := bm.ExecuteBatch(b)
+			Convey("Then every operation in the chunk should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(report.Failed(), ShouldHaveLength, 2)
+			})
+		})
+	})
+}
+
+func TestBatchInvalidRef(t *testing.T) {
+	Convey("Given a batch", t, func() {
+		b := NewBatch()
+		Convey("When an operation uses a ref that doesn't exist yet", func() {
+			ref := b.AddEdge(Ref(5), 1, "knows")
+			Convey("Then the operation should fail", func() {
+				So(b.Len(), ShouldEqual, 1)
+				So(b.ops[ref].err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestUnmarshalBatchIDs(t *testing.T) {
+	Convey("Given a response with vertex and edge IDs", t, func() {
+		data := [][]byte{[]byte(`[1,null,{"@type":"janusgraph:RelationIdentifier","@value":{"relationId":"zz0-yxs-25rf9-1548"}}]`)}
+		Convey("When unmarshalBatchIDs is called", func() {
+			ids, err := unmarshalBatchIDs(data)
+			Convey("Then the relation identifier should become a string", func() {
+				So(err, ShouldBeNil)
+				So(ids, ShouldResemble, []interface{}{int64(1), nil, "zz0-yxs-25rf9-1548"})
+			})
+		})
+	})
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package manager
+
+import (
+	"time"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/query/consistency"
+	"github.com/northwesternmutual/grammes/query/graph"
+	"github.com/northwesternmutual/grammes/schema"
+)
+
+// AddConnection allows edges with the edge label to connect
+// vertices with the out vertex label to vertices with the in
+// vertex label. When 'schema.constraints' is enabled on the
+// graph, edges between labels that aren't connected are refused.
+func (s *schemaManager) AddConnection(edgeLabel, outVertexLabel, inVertexLabel string) error {
+	return s.executeManagement("AddConnection",
+		graph.NewManagement().AddConnection(edgeLabel, outVertexLabel, inVertexLabel).String())
+}
+
+// AddProperties allows vertices with the label to have
+// properties with the given keys. When 'schema.constraints'
+// is enabled on the graph, other properties are refused.
+func (s *schemaManager) AddProperties(vertexLabel string, keys ...string) error {
+	return s.executeManagement("AddProperties",
+		graph.NewManagement().AddProperties(vertexLabel, keys...).String())
+}
+
+// AddEdgeProperties allows edges with the label
+// to have properties with the given keys.
+func (s *schemaManager) AddEdgeProperties(edgeLabel string, keys ...string) error {
+	return s.executeManagement("AddEdgeProperties",
+		graph.NewManagement().AddEdgeProperties(edgeLabel, keys...).String())
+}
+
+// SetTTL sets how long elements with the schema element live.
+// The element is referenced through the management system such
+// as graph.NewManagement().GetEdgeLabel("visits"). Vertex labels
+// must be static for a TTL to be set on them.
+func (s *schemaManager) SetTTL(element graph.String, ttl time.Duration) error {
+	return s.executeManagement("SetTTL",
+		graph.NewManagement().SetTTL(element, ttl).String())
+}
+
+// SetConsistency sets the consistency of the schema element
+// or graph index, such as locking a unique property key.
+func (s *schemaManager) SetConsistency(element graph.String, modifier consistency.ConsistencyModifier) error {
+	return s.executeManagement("SetConsistency",
+		graph.NewManagement().SetConsistency(element, modifier).String())
+}
+
+// executeManagement runs the statements in a
+// single management transaction and commits it.
+func (s *schemaManager) executeManagement(function string, statements ...string) error {
+	query := schema.ManagementScript(statements...)
+
+	if _, err := s.executeStringQuery(query); err != nil {
+		s.logger.Error("invalid query",
+			gremerror.NewQueryError(function, query, err),
+		)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package manager
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/logging"
+	"github.com/northwesternmutual/grammes/query/consistency"
+	"github.com/northwesternmutual/grammes/query/graph"
+)
+
+func TestSchemaConstraints(t *testing.T) {
+	Convey("Given a string executor and schema manager", t, func() {
+		var query string
+		execute := func(q string) ([][]byte, error) {
+			query = q
+			return nil, nil
+		}
+		sm := newSchemaManager(logging.NewNilLogger(), execute)
+		Convey("When AddConnection is called", func() {
+			err := sm.AddConnection("worksAt", "person", "company")
+			Convey("Then the connection should be added and committed", func() {
+				So(err, ShouldBeNil)
+				So(query, ShouldEqual, "mgmt = graph.openManagement()\n"+
+					"mgmt.addConnection(mgmt.getEdgeLabel(\"worksAt\"),mgmt.getVertexLabel(\"person\"),mgmt.getVertexLabel(\"company\"))\n"+
+					"mgmt.commit()")
+			})
+		})
+		Convey("When AddProperties is called", func() {
+			err := sm.AddProperties("person", "name", "age")
+			Convey("Then the keys should be added to the vertex label", func() {
+				So(err, ShouldBeNil)
+				So(query, ShouldContainSubstring, "mgmt.addProperties(mgmt.getVertexLabel(\"person\"),"+
+					"mgmt.getPropertyKey(\"name\"),mgmt.getPropertyKey(\"age\"))")
+			})
+		})
+		Convey("When AddEdgeProperties is called", func() {
+			err := sm.AddEdgeProperties("knows", "since")
+			Convey("Then the keys should be added to the edge label", func() {
+				So(err, ShouldBeNil)
+				So(query, ShouldContainSubstring, "mgmt.addProperties(mgmt.getEdgeLabel(\"knows\"),mgmt.getPropertyKey(\"since\"))")
+			})
+		})
+		Convey("When SetTTL is called", func() {
+			err := sm.SetTTL(graph.NewManagement().GetEdgeLabel("visits"), time.Hour)
+			Convey("Then the TTL should be set in seconds", func() {
+				So(err, ShouldBeNil)
+				So(query, ShouldContainSubstring, "mgmt.setTTL(mgmt.getEdgeLabel(\"visits\"),java.time.Duration.ofSeconds(3600))")
+			})
+		})
+		Convey("When SetConsistency is called", func() {
+			err := sm.SetConsistency(graph.NewManagement().GetPropertyKey("email"), consistency.Lock)
+			Convey("Then the consistency should be set", func() {
+				So(err, ShouldBeNil)
+				So(query, ShouldContainSubstring, "mgmt.setConsistency(mgmt.getPropertyKey(\"email\"),ConsistencyModifier.LOCK)")
+			})
+		})
+	})
+}
+
+func TestSchemaConstraintsQueryError(t *testing.T) {
+	Convey("Given a string executor and schema manager", t, func() {
+		execute := func(string) ([][]byte, error) { return nil, errors.New("ERROR") }
+		sm := newSchemaManager(logging.NewNilLogger(), execute)
+		Convey("When AddConnection is called and encounters a querying error", func() {
+			err := sm.AddConnection("worksAt", "person", "company")
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+		Convey("When SetConsistency is called and encounters a querying error", func() {
+			err := sm.SetConsistency(graph.NewManagement().GetPropertyKey("email"), consistency.Lock)
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -23,27 +23,30 @@ package manager
 import (
 	"strings"
 
-	"github.com/northwesternmutual/grammes/query/traversal"
+	"github.com/northwesternmutual/grammes/dialect"
 	"github.com/northwesternmutual/grammes/gremerror"
 	"github.com/northwesternmutual/grammes/logging"
 	"github.com/northwesternmutual/grammes/query"
+	"github.com/northwesternmutual/grammes/query/traversal"
 )
 
 type dropQueryManager struct {
-	logger             logging.Logger
-	executeStringQuery stringExecutor
+	logger       logging.Logger
+	executeQuery queryExecutor
+	// dialect gives the IDs the representation of the database.
+	dialect *dialect.Dialect
 }
 
-func newDropQueryManager(logger logging.Logger, executor stringExecutor) *dropQueryManager {
+func newDropQueryManager(logger logging.Logger, executor queryExecutor) *dropQueryManager {
 	return &dropQueryManager{
-		logger:             logger,
-		executeStringQuery: executor,
+		logger:       logger,
+		executeQuery: executor,
 	}
 }
 
 func (v *dropQueryManager) DropVertexLabel(label string) error {
 	query := traversal.NewTraversal().V().HasLabel(label).Drop()
-	if _, err := v.executeStringQuery(query.String()); err != nil {
+	if _, err := v.executeQuery(query); err != nil {
 		v.logger.Error("invalid query",
 			gremerror.NewQueryError("DropVertexLabel", query.String(), err),
 		)
@@ -56,8 +59,8 @@ func (v *dropQueryManager) DropVertexLabel(label string) error {
 func (v *dropQueryManager) DropVertexByID(ids ...interface{}) error {
 	var err error
 	for _, id := range ids {
-		query := traversal.NewTraversal().V().HasID(id).Drop()
-		if _, err = v.executeStringQuery(query.String()); err != nil {
+		query := traversal.NewTraversal().V().HasID(v.dialect.ID(id)).Drop()
+		if _, err = v.executeQuery(query); err != nil {
 			v.logger.Error("invalid query",
 				gremerror.NewQueryError("DropVerticesByID", query.String(), err),
 			)
@@ -72,8 +75,8 @@ func (v *dropQueryManager) DropVerticesByString(q string) error {
 	if !strings.HasSuffix(q, "drop()") {
 		q += ".drop()"
 	}
-	
-	_, err := v.executeStringQuery(q)
+
+	_, err := v.executeQuery(script(q))
 	if err != nil {
 		v.logger.Error("invalid query",
 			gremerror.NewQueryError("DropVerticesByString", q, err),
@@ -83,11 +86,19 @@ func (v *dropQueryManager) DropVerticesByString(q string) error {
 }
 
 func (v *dropQueryManager) DropVerticesByQuery(q query.Query) error {
-	err := v.DropVerticesByString(q.String())
+	t, ok := q.(traversal.String)
+	if !ok {
+		return v.DropVerticesByString(q.String())
+	}
+	if last, _ := t.LastStep(); last.Name != "drop" {
+		t = t.Drop()
+	}
+
+	_, err := v.executeQuery(t)
 	if err != nil {
 		v.logger.Error("invalid query",
 			gremerror.NewQueryError("DropVerticesByQuery", q.String(), err),
 		)
 	}
 	return err
-}
\ No newline at end of file
+}
@@ -27,11 +27,12 @@ import (
 	. "github.com/smartystreets/goconvey/convey"
 
 	"github.com/northwesternmutual/grammes/logging"
+	"github.com/northwesternmutual/grammes/query"
 )
 
 func TestDropVertexLabel(t *testing.T) {
 	Convey("Given a string executor and drop query manager", t, func() {
-		execute := func(string) ([][]byte, error) { return nil, nil }
+		execute := func(query.Query) ([][]byte, error) { return nil, nil }
 		dm := newDropQueryManager(logging.NewNilLogger(), execute)
 		Convey("When DropVertexLabel is called", func() {
 			err := dm.DropVertexLabel("testlabel")
@@ -44,7 +45,7 @@ func TestDropVertexLabel(t *testing.T) {
 
 func TestDropVertexLabelError(t *testing.T) {
 	Convey("Given a string executor and drop query manager", t, func() {
-		execute := func(string) ([][]byte, error) { return nil, errors.New("ERROR") }
+		execute := func(query.Query) ([][]byte, error) { return nil, errors.New("ERROR") }
 		dm := newDropQueryManager(logging.NewNilLogger(), execute)
 		Convey("When DropVertexLabel is called and encounters an error", func() {
 			err := dm.DropVertexLabel("testlabel")
@@ -57,7 +58,7 @@ func TestDropVertexLabelError(t *testing.T) {
 
 func TestDropVertexByID(t *testing.T) {
 	Convey("Given a string executor and drop query manager", t, func() {
-		execute := func(string) ([][]byte, error) { return nil, nil }
+		execute := func(query.Query) ([][]byte, error) { return nil, nil }
 		dm := newDropQueryManager(logging.NewNilLogger(), execute)
 		Convey("When DropVertexByID is called", func() {
 			err := dm.DropVertexByID(1234)
@@ -70,7 +71,7 @@ func TestDropVertexByID(t *testing.T) {
 
 func TestDropVertexByIDError(t *testing.T) {
 	Convey("Given a string executor and drop query manager", t, func() {
-		execute := func(string) ([][]byte, error) { return nil, errors.New("ERROR") }
+		execute := func(query.Query) ([][]byte, error) { return nil, errors.New("ERROR") }
 		dm := newDropQueryManager(logging.NewNilLogger(), execute)
 		Convey("When DropVertexByID is called and encounters an error", func() {
 			err := dm.DropVertexByID(1234)
@@ -83,7 +84,7 @@ func TestDropVertexByIDError(t *testing.T) {
 
 func TestDropVerticesByString(t *testing.T) {
 	Convey("Given a string executor and drop query manager", t, func() {
-		execute := func(string) ([][]byte, error) { return nil, nil }
+		execute := func(query.Query) ([][]byte, error) { return nil, nil }
 		dm := newDropQueryManager(logging.NewNilLogger(), execute)
 		Convey("When DropVertexByString is called", func() {
 			err := dm.DropVerticesByString("testquery")
@@ -96,7 +97,7 @@ func TestDropVerticesByString(t *testing.T) {
 
 func TestDropVerticesByStringError(t *testing.T) {
 	Convey("Given a string executor and drop query manager", t, func() {
-		execute := func(string) ([][]byte, error) { return nil, errors.New("ERROR") }
+		execute := func(query.Query) ([][]byte, error) { return nil, errors.New("ERROR") }
 		dm := newDropQueryManager(logging.NewNilLogger(), execute)
 		Convey("When DropVertexByString is called and encounters an error", func() {
 			err := dm.DropVerticesByString("testquery")
@@ -109,7 +110,7 @@ func TestDropVerticesByStringError(t *testing.T) {
 
 func TestDropVerticesByQuery(t *testing.T) {
 	Convey("Given a string executor and drop query manager", t, func() {
-		execute := func(string) ([][]byte, error) { return nil, nil }
+		execute := func(query.Query) ([][]byte, error) { return nil, nil }
 		dm := newDropQueryManager(logging.NewNilLogger(), execute)
 		Convey("When DropVertexByQuery is called", func() {
 			var q mockQuery
@@ -123,7 +124,7 @@ func TestDropVerticesByQuery(t *testing.T) {
 
 func TestDropVerticesByQueryError(t *testing.T) {
 	Convey("Given a string executor and drop query manager", t, func() {
-		execute := func(string) ([][]byte, error) { return nil, errors.New("ERROR") }
+		execute := func(query.Query) ([][]byte, error) { return nil, errors.New("ERROR") }
 		dm := newDropQueryManager(logging.NewNilLogger(), execute)
 		Convey("When DropVertexByQuery is called and encounters an error", func() {
 			var q mockQuery
@@ -0,0 +1,248 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package manager
+
+import (
+	"strconv"
+
+	"github.com/northwesternmutual/grammes/dialect"
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/logging"
+	"github.com/northwesternmutual/grammes/model"
+	"github.com/northwesternmutual/grammes/query"
+	__ "github.com/northwesternmutual/grammes/query/anonymous"
+	"github.com/northwesternmutual/grammes/query/traversal"
+)
+
+type edgeQueryManager struct {
+	logger       logging.Logger
+	executeQuery queryExecutor
+	// dialect gives the IDs the representation of the database.
+	dialect *dialect.Dialect
+}
+
+func newEdgeQueryManager(logger logging.Logger, executor queryExecutor) *edgeQueryManager {
+	return &edgeQueryManager{
+		logger:       logger,
+		executeQuery: executor,
+	}
+}
+
+// edgeID will unwrap a JanusGraph relation identifier, such
+// as the one returned by Edge.ID, into the string form that
+// can be used in a traversal. Any other ID is returned as is.
+func edgeID(id interface{}) interface{} {
+	m, ok := id.(map[string]interface{})
+	if !ok {
+		return id
+	}
+	v, ok := m["@value"].(map[string]interface{})
+	if !ok {
+		return id
+	}
+	if relationID, ok := v["relationId"].(string); ok {
+		return relationID
+	}
+	return id
+}
+
+// edges will query the graph and unmarshal the edges in the response.
+func (e *edgeQueryManager) edges(function string, query query.Query) ([]model.Edge, error) {
+	responses, err := e.executeQuery(query)
+	if err != nil {
+		e.logger.Error("invalid query",
+			gremerror.NewQueryError(function, query.String(), err),
+		)
+		return nil, err
+	}
+
+	var edges model.EdgeList
+
+	for _, res := range responses {
+		var edgePart model.EdgeList
+		err = jsonUnmarshal(res, &edgePart)
+		if err != nil {
+			e.logger.Error("edges unmarshal",
+				gremerror.NewUnmarshalError(function, res, err),
+			)
+			return nil, err
+		}
+
+		edges.Edges = append(edges.Edges, edgePart.Edges...)
+	}
+
+	return edges.Edges, nil
+}
+
+// AddEdge will add an edge from the vertex with the
+// outID to the vertex with the inID and return it.
+func (e *edgeQueryManager) AddEdge(outID, inID interface{}, label string, properties ...interface{}) (model.Edge, error) {
+	if len(properties)%2 != 0 {
+		e.logger.Error("number of parameters ["+strconv.Itoa(len(properties))+"]",
+			gremerror.NewGrammesError("AddEdge", gremerror.ErrOddNumberOfParameters),
+		)
+		return model.Edge{}, gremerror.ErrOddNumberOfParameters
+	}
+
+	query := traversal.NewTraversal().V().HasID(e.dialect.ID(outID)).AddE(label).To(__.V().HasID(e.dialect.ID(inID)))
+	for i := 0; i < len(properties); i += 2 {
+		query.AddStep("property", properties[i], properties[i+1])
+	}
+
+	edges, err := e.edges("AddEdge", query)
+	if err != nil {
+		return model.Edge{}, err
+	}
+
+	if len(edges) == 0 {
+		return model.Edge{}, gremerror.NewGrammesError("AddEdge", gremerror.ErrEmptyResponse)
+	}
+
+	return edges[0], nil
+}
+
+// EdgeByID will get the edge with the given ID. Both
+// plain IDs and the IDs returned by Edge.ID are accepted.
+func (e *edgeQueryManager) EdgeByID(id interface{}) (model.Edge, error) {
+	query := traversal.NewTraversal().E().HasID(e.dialect.ID(edgeID(id)))
+
+	edges, err := e.edges("EdgeByID", query)
+	if err != nil {
+		return model.Edge{}, err
+	}
+
+	if len(edges) == 0 {
+		return model.Edge{}, gremerror.NewGrammesError("EdgeByID", gremerror.ErrEmptyResponse)
+	}
+
+	return edges[0], nil
+}
+
+// EdgesByLabel will return every edge with the given label.
+func (e *edgeQueryManager) EdgesByLabel(label string) ([]model.Edge, error) {
+	query := traversal.NewTraversal().E().HasLabel(label)
+	return e.edges("EdgesByLabel", query)
+}
+
+// Edges will return the edges with the given
+// label that also have the properties given.
+func (e *edgeQueryManager) Edges(label string, properties ...interface{}) ([]model.Edge, error) {
+	if len(properties)%2 != 0 {
+		e.logger.Error("number of parameters ["+strconv.Itoa(len(properties))+"]",
+			gremerror.NewGrammesError("Edges", gremerror.ErrOddNumberOfParameters),
+		)
+		return nil, gremerror.ErrOddNumberOfParameters
+	}
+
+	query := traversal.NewTraversal().E().HasLabel(label)
+	for i := 0; i < len(properties); i += 2 {
+		query = query.Has(properties[i], properties[i+1])
+	}
+
+	return e.edges("Edges", query)
+}
+
+// EdgesBetween will return the edges going in either
+// direction between the vertices with the IDs a and b.
+// When labels are given only edges with them are returned.
+func (e *edgeQueryManager) EdgesBetween(a, b interface{}, labels ...string) ([]model.Edge, error) {
+	query := traversal.NewTraversal().V().HasID(e.dialect.ID(a)).BothE(labels...).
+		Where(__.OtherV().HasID(e.dialect.ID(b)))
+
+	return e.edges("EdgesBetween", query)
+}
+
+// EdgeCount retrieves the number of edges
+// that are currently on the graph as an int64.
+func (e *edgeQueryManager) EdgeCount() (int64, error) {
+	query := traversal.NewTraversal().E().Count()
+
+	responses, err := e.executeQuery(query)
+	if err != nil {
+		e.logger.Error("EdgeCount",
+			gremerror.NewQueryError("EdgeCount", query.String(), err),
+		)
+		return 0, err
+	}
+
+	count, err := unmarshalCount(responses)
+	if err != nil {
+		e.logger.Error("unmarshal",
+			gremerror.NewGrammesError("EdgeCount", err),
+		)
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// SetEdgeProperty will either add or set the
+// properties of the edge with the given ID.
+func (e *edgeQueryManager) SetEdgeProperty(id interface{}, keyAndVals ...interface{}) error {
+	if len(keyAndVals)%2 != 0 {
+		e.logger.Error("number of parameters ["+strconv.Itoa(len(keyAndVals))+"]",
+			gremerror.NewGrammesError("SetEdgeProperty", gremerror.ErrOddNumberOfParameters),
+		)
+		return gremerror.ErrOddNumberOfParameters
+	}
+
+	query := traversal.NewTraversal().E().HasID(e.dialect.ID(edgeID(id)))
+	for i := 0; i < len(keyAndVals); i += 2 {
+		query.AddStep("property", keyAndVals[i], keyAndVals[i+1])
+	}
+
+	if _, err := e.executeQuery(query); err != nil {
+		e.logger.Error("invalid query",
+			gremerror.NewQueryError("SetEdgeProperty", query.String(), err),
+		)
+		return err
+	}
+
+	return nil
+}
+
+// DropEdgeByID drops the edges with the given IDs.
+func (e *edgeQueryManager) DropEdgeByID(ids ...interface{}) error {
+	for _, id := range ids {
+		query := traversal.NewTraversal().E().HasID(e.dialect.ID(edgeID(id))).Drop()
+		if _, err := e.executeQuery(query); err != nil {
+			e.logger.Error("invalid query",
+				gremerror.NewQueryError("DropEdgeByID", query.String(), err),
+			)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DropEdgeLabel drops every edge with the given label.
+func (e *edgeQueryManager) DropEdgeLabel(label string) error {
+	query := traversal.NewTraversal().E().HasLabel(label).Drop()
+	if _, err := e.executeQuery(query); err != nil {
+		e.logger.Error("invalid query",
+			gremerror.NewQueryError("DropEdgeLabel", query.String(), err),
+		)
+		return err
+	}
+
+	return nil
+}
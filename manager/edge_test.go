@@ -0,0 +1,297 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package manager
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/logging"
+	"github.com/northwesternmutual/grammes/query"
+)
+
+var edgeListResponse = `
+[
+	{
+		"@type": "g:Edge",
+		"@value": {
+			"id": {
+				"@type": "janusgraph:RelationIdentifier",
+				"@value": {
+					"relationId": "zz0-yxs-25rf9-1548"
+				}
+			},
+			"label": "friendsWith",
+			"inV": 53288,
+			"outV": 45280
+		}
+	}
+]
+`
+
+func TestEdgeID(t *testing.T) {
+	Convey("Given a JanusGraph relation identifier", t, func() {
+		id := map[string]interface{}{
+			"@type":  "janusgraph:RelationIdentifier",
+			"@value": map[string]interface{}{"relationId": "zz0-yxs-25rf9-1548"},
+		}
+		Convey("When edgeID is called", func() {
+			Convey("Then the relation ID should be unwrapped", func() {
+				So(edgeID(id), ShouldEqual, "zz0-yxs-25rf9-1548")
+			})
+		})
+		Convey("When edgeID is called with a plain ID", func() {
+			Convey("Then the ID should be returned as is", func() {
+				So(edgeID(1234), ShouldEqual, 1234)
+			})
+		})
+	})
+}
+
+func TestAddEdge(t *testing.T) {
+	Convey("Given a string executor and edge query manager", t, func() {
+		var sent string
+		execute := func(q query.Query) ([][]byte, error) {
+			sent = q.String()
+			return [][]byte{[]byte(edgeListResponse)}, nil
+		}
+		em := newEdgeQueryManager(logging.NewNilLogger(), execute)
+		Convey("When AddEdge is called", func() {
+			e, err := em.AddEdge(1, 2, "friendsWith", "since", 2018)
+			Convey("Then the edge should be added between the vertices", func() {
+				So(err, ShouldBeNil)
+				So(sent, ShouldEqual, `g.V().hasId(1).addE("friendsWith").to(__.V().hasId(2)).property("since",2018)`)
+				So(e.Label(), ShouldEqual, "friendsWith")
+			})
+		})
+		Convey("When AddEdge is called with an odd number of properties", func() {
+			_, err := em.AddEdge(1, 2, "friendsWith", "since")
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldEqual, gremerror.ErrOddNumberOfParameters)
+			})
+		})
+	})
+}
+
+func TestAddEdgeEmptyResponse(t *testing.T) {
+	Convey("Given a string executor and edge query manager", t, func() {
+		execute := func(query.Query) ([][]byte, error) { return [][]byte{[]byte("[]")}, nil }
+		em := newEdgeQueryManager(logging.NewNilLogger(), execute)
+		Convey("When AddEdge is called and nothing is returned", func() {
+			_, err := em.AddEdge(1, 2, "friendsWith")
+			Convey("Then the empty response error should be returned", func() {
+				So(errors.Is(err, gremerror.ErrEmptyResponse), ShouldBeTrue)
+			})
+		})
+	})
+}
+
+func TestEdgeByID(t *testing.T) {
+	Convey("Given a string executor and edge query manager", t, func() {
+		var sent string
+		execute := func(q query.Query) ([][]byte, error) {
+			sent = q.String()
+			return [][]byte{[]byte(edgeListResponse)}, nil
+		}
+		em := newEdgeQueryManager(logging.NewNilLogger(), execute)
+		Convey("When EdgeByID is called with the ID of an edge", func() {
+			e, err := em.EdgeByID("zz0-yxs-25rf9-1548")
+			Convey("Then the edge should be returned", func() {
+				So(err, ShouldBeNil)
+				So(sent, ShouldEqual, `g.E().hasId("zz0-yxs-25rf9-1548")`)
+				So(edgeID(e.ID()), ShouldEqual, "zz0-yxs-25rf9-1548")
+			})
+		})
+	})
+}
+
+func TestEdgeByIDEmptyResponse(t *testing.T) {
+	Convey("Given a string executor and edge query manager", t, func() {
+		execute := func(query.Query) ([][]byte, error) { return nil, nil }
+		em := newEdgeQueryManager(logging.NewNilLogger(), execute)
+		Convey("When EdgeByID is called and nothing is returned", func() {
+			_, err := em.EdgeByID(1)
+			Convey("Then the empty response error should be returned", func() {
+				So(errors.Is(err, gremerror.ErrEmptyResponse), ShouldBeTrue)
+			})
+		})
+	})
+}
+
+func TestEdges(t *testing.T) {
+	Convey("Given a string executor and edge query manager", t, func() {
+		var sent string
+		execute := func(q query.Query) ([][]byte, error) {
+			sent = q.String()
+			return [][]byte{[]byte(edgeListResponse)}, nil
+		}
+		em := newEdgeQueryManager(logging.NewNilLogger(), execute)
+		Convey("When EdgesByLabel is called", func() {
+			edges, err := em.EdgesByLabel("friendsWith")
+			Convey("Then the edges with the label should be returned", func() {
+				So(err, ShouldBeNil)
+				So(sent, ShouldEqual, `g.E().hasLabel("friendsWith")`)
+				So(edges, ShouldHaveLength, 1)
+			})
+		})
+		Convey("When Edges is called with properties", func() {
+			edges, err := em.Edges("friendsWith", "since", 2018)
+			Convey("Then the edges with the label and properties should be returned", func() {
+				So(err, ShouldBeNil)
+				So(sent, ShouldEqual, `g.E().hasLabel("friendsWith").has("since",2018)`)
+				So(edges, ShouldHaveLength, 1)
+			})
+		})
+		Convey("When Edges is called with an odd number of properties", func() {
+			_, err := em.Edges("friendsWith", "since")
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldEqual, gremerror.ErrOddNumberOfParameters)
+			})
+		})
+		Convey("When EdgesBetween is called", func() {
+			edges, err := em.EdgesBetween(1, 2, "friendsWith")
+			Convey("Then the edges between both vertices should be returned", func() {
+				So(err, ShouldBeNil)
+				So(sent, ShouldEqual, `g.V().hasId(1).bothE("friendsWith").where(__.otherV().hasId(2))`)
+				So(edges, ShouldHaveLength, 1)
+			})
+		})
+	})
+}
+
+func TestEdgesQueryError(t *testing.T) {
+	Convey("Given a string executor and edge query manager", t, func() {
+		execute := func(query.Query) ([][]byte, error) { return nil, errors.New("ERROR") }
+		em := newEdgeQueryManager(logging.NewNilLogger(), execute)
+		Convey("When the edge queries encounter a querying error", func() {
+			_, addErr := em.AddEdge(1, 2, "friendsWith")
+			_, idErr := em.EdgeByID(1)
+			_, labelErr := em.EdgesByLabel("friendsWith")
+			_, betweenErr := em.EdgesBetween(1, 2)
+			_, countErr := em.EdgeCount()
+			Convey("Then the errors should be returned", func() {
+				So(addErr, ShouldNotBeNil)
+				So(idErr, ShouldNotBeNil)
+				So(labelErr, ShouldNotBeNil)
+				So(betweenErr, ShouldNotBeNil)
+				So(countErr, ShouldNotBeNil)
+			})
+		})
+		Convey("When the edge mutations encounter a querying error", func() {
+			Convey("Then the errors should be returned", func() {
+				So(em.SetEdgeProperty(1, "since", 2018), ShouldNotBeNil)
+				So(em.DropEdgeByID(1), ShouldNotBeNil)
+				So(em.DropEdgeLabel("friendsWith"), ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestEdgesUnmarshalError(t *testing.T) {
+	defer func() {
+		jsonUnmarshal = json.Unmarshal
+	}()
+	jsonUnmarshal = func([]byte, interface{}) error { return errors.New("ERROR") }
+	Convey("Given a string executor and edge query manager", t, func() {
+		execute := func(query.Query) ([][]byte, error) { return [][]byte{[]byte(edgeListResponse)}, nil }
+		em := newEdgeQueryManager(logging.NewNilLogger(), execute)
+		Convey("When EdgesByLabel is called and encounters an unmarshalling error", func() {
+			_, err := em.EdgesByLabel("friendsWith")
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestEdgeCount(t *testing.T) {
+	Convey("Given a string executor and edge query manager", t, func() {
+		var sent string
+		execute := func(q query.Query) ([][]byte, error) {
+			sent = q.String()
+			return [][]byte{[]byte(idResponse)}, nil
+		}
+		em := newEdgeQueryManager(logging.NewNilLogger(), execute)
+		Convey("When EdgeCount is called", func() {
+			c, err := em.EdgeCount()
+			Convey("Then the count should equal 255", func() {
+				So(err, ShouldBeNil)
+				So(sent, ShouldEqual, "g.E().count()")
+				So(c, ShouldEqual, 255)
+			})
+		})
+	})
+}
+
+func TestEdgeCountEmptyResponse(t *testing.T) {
+	Convey("Given a string executor and edge query manager", t, func() {
+		execute := func(query.Query) ([][]byte, error) { return nil, nil }
+		em := newEdgeQueryManager(logging.NewNilLogger(), execute)
+		Convey("When EdgeCount is called and nothing is returned", func() {
+			_, err := em.EdgeCount()
+			Convey("Then the empty response error should be returned", func() {
+				So(err, ShouldEqual, gremerror.ErrEmptyResponse)
+			})
+		})
+	})
+}
+
+func TestEdgeMutations(t *testing.T) {
+	Convey("Given a string executor and edge query manager", t, func() {
+		var queries []string
+		execute := func(q query.Query) ([][]byte, error) {
+			queries = append(queries, q.String())
+			return nil, nil
+		}
+		em := newEdgeQueryManager(logging.NewNilLogger(), execute)
+		Convey("When SetEdgeProperty is called", func() {
+			err := em.SetEdgeProperty("zz0-yxs-25rf9-1548", "since", 2018)
+			Convey("Then the property should be set on the edge", func() {
+				So(err, ShouldBeNil)
+				So(queries, ShouldResemble, []string{`g.E().hasId("zz0-yxs-25rf9-1548").property("since",2018)`})
+			})
+		})
+		Convey("When SetEdgeProperty is called with an odd number of properties", func() {
+			err := em.SetEdgeProperty(1, "since")
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldEqual, gremerror.ErrOddNumberOfParameters)
+			})
+		})
+		Convey("When DropEdgeByID is called", func() {
+			err := em.DropEdgeByID("a", "b")
+			Convey("Then every edge should be dropped", func() {
+				So(err, ShouldBeNil)
+				So(queries, ShouldResemble, []string{`g.E().hasId("a").drop()`, `g.E().hasId("b").drop()`})
+			})
+		})
+		Convey("When DropEdgeLabel is called", func() {
+			err := em.DropEdgeLabel("friendsWith")
+			Convey("Then the edges with the label should be dropped", func() {
+				So(err, ShouldBeNil)
+				So(queries, ShouldResemble, []string{`g.E().hasLabel("friendsWith").drop()`})
+			})
+		})
+	})
+}
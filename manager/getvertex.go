@@ -21,9 +21,9 @@
 package manager
 
 import (
-	"fmt"
 	"strconv"
 
+	"github.com/northwesternmutual/grammes/dialect"
 	"github.com/northwesternmutual/grammes/gremerror"
 	"github.com/northwesternmutual/grammes/logging"
 	"github.com/northwesternmutual/grammes/model"
@@ -32,23 +32,30 @@ import (
 )
 
 type getVertexQueryManager struct {
-	logger             logging.Logger
-	executeStringQuery stringExecutor
+	logger       logging.Logger
+	executeQuery queryExecutor
+	// dialect gives the IDs the representation of the database.
+	dialect *dialect.Dialect
 }
 
-func newGetVertexQueryManager(logger logging.Logger, executor stringExecutor) *getVertexQueryManager {
+func newGetVertexQueryManager(logger logging.Logger, executor queryExecutor) *getVertexQueryManager {
 	return &getVertexQueryManager{
-		logger:             logger,
-		executeStringQuery: executor,
+		logger:       logger,
+		executeQuery: executor,
 	}
 }
 
 func (c *getVertexQueryManager) VerticesByString(query string) ([]model.Vertex, error) {
+	return c.vertices(script(query))
+}
+
+// vertices executes the query and unmarshals the vertices it returns.
+func (c *getVertexQueryManager) vertices(query query.Query) ([]model.Vertex, error) {
 	// Query the gremlin server with the given traversal.
-	responses, err := c.executeStringQuery(query)
+	responses, err := c.executeQuery(query)
 	if err != nil {
 		c.logger.Error("invalid query",
-			gremerror.NewQueryError("Vertices", query, err),
+			gremerror.NewQueryError("Vertices", query.String(), err),
 		)
 		return nil, err
 	}
@@ -77,7 +84,7 @@ func (c *getVertexQueryManager) VerticesByString(query string) ([]model.Vertex,
 // Vertices will gather any vertices and return them
 // based on the fed in traversal query.
 func (c *getVertexQueryManager) VerticesByQuery(query query.Query) ([]model.Vertex, error) {
-	vertices, err := c.VerticesByString(query.String())
+	vertices, err := c.vertices(query)
 	if err != nil {
 		c.logger.Error("error gathering vertices",
 			gremerror.NewGrammesError("VerticesByQuery", err),
@@ -90,7 +97,7 @@ func (c *getVertexQueryManager) VerticesByQuery(query query.Query) ([]model.Vert
 // and return them in a structured format.
 func (c *getVertexQueryManager) AllVertices() ([]model.Vertex, error) {
 	// Query the graph database for all vertices.
-	vertices, err := c.VerticesByString("g.V()")
+	vertices, err := c.vertices(traversal.NewTraversal().V())
 	if err != nil {
 		c.logger.Error("error gathering vertices",
 			gremerror.NewGrammesError("AllVertices", err),
@@ -107,7 +114,8 @@ func (c *getVertexQueryManager) AllVertices() ([]model.Vertex, error) {
 // vertices without any conflicting labels or properties.
 func (c *getVertexQueryManager) VertexByID(id interface{}) (model.Vertex, error) {
 	// Query the graph for a vertex with this ID.
-	vertices, err := c.VerticesByString("g.V().hasId(" + fmt.Sprint(id) + ")")
+	query := traversal.NewTraversal().V().HasID(c.dialect.ID(id))
+	vertices, err := c.vertices(query)
 	if err != nil {
 		c.logger.Error("error gathering vertices",
 			gremerror.NewGrammesError("VerticesByID", err),
@@ -135,7 +143,7 @@ func (c *getVertexQueryManager) Vertices(label string, properties ...interface{}
 		query = query.Has(properties[i], properties[i+1])
 	}
 
-	vertices, err := c.VerticesByString(query.String())
+	vertices, err := c.vertices(query)
 	if err != nil {
 		c.logger.Error("error gathering vertices",
 			gremerror.NewGrammesError("Vertices", err),
@@ -28,11 +28,12 @@ import (
 	. "github.com/smartystreets/goconvey/convey"
 
 	"github.com/northwesternmutual/grammes/logging"
+	"github.com/northwesternmutual/grammes/query"
 )
 
 func TestVerticesByString(t *testing.T) {
 	Convey("Given a string executor and vertex query manager", t, func() {
-		execute := func(string) ([][]byte, error) { return [][]byte{[]byte(vertexResponse)}, nil }
+		execute := func(query.Query) ([][]byte, error) { return [][]byte{[]byte(vertexResponse)}, nil }
 		qm := newGetVertexQueryManager(logging.NewNilLogger(), execute)
 		Convey("When VerticesByString is called", func() {
 			_, err := qm.VerticesByString("testquery")
@@ -45,7 +46,7 @@ func TestVerticesByString(t *testing.T) {
 
 func TestVerticesByStringQueryError(t *testing.T) {
 	Convey("Given a string executor and vertex query manager", t, func() {
-		execute := func(string) ([][]byte, error) { return nil, errors.New("ERROR") }
+		execute := func(query.Query) ([][]byte, error) { return nil, errors.New("ERROR") }
 		qm := newGetVertexQueryManager(logging.NewNilLogger(), execute)
 		Convey("When VerticesByString is called and encounters an error", func() {
 			_, err := qm.VerticesByString("testquery")
@@ -62,7 +63,7 @@ func TestVerticesByStringUnmarshalError(t *testing.T) {
 	}()
 	jsonUnmarshal = func([]byte, interface{}) error { return errors.New("ERROR") }
 	Convey("Given a string executor and vertex query manager", t, func() {
-		execute := func(string) ([][]byte, error) { return [][]byte{[]byte(vertexResponse)}, nil }
+		execute := func(query.Query) ([][]byte, error) { return [][]byte{[]byte(vertexResponse)}, nil }
 		qm := newGetVertexQueryManager(logging.NewNilLogger(), execute)
 		Convey("When VerticesByString is called and there is an error unmarshalling", func() {
 			_, err := qm.VerticesByString("testquery")
@@ -75,7 +76,7 @@ func TestVerticesByStringUnmarshalError(t *testing.T) {
 
 func TestVerticesByQuery(t *testing.T) {
 	Convey("Given a string executor and vertex query manager", t, func() {
-		execute := func(string) ([][]byte, error) { return [][]byte{[]byte(vertexResponse)}, nil }
+		execute := func(query.Query) ([][]byte, error) { return [][]byte{[]byte(vertexResponse)}, nil }
 		qm := newGetVertexQueryManager(logging.NewNilLogger(), execute)
 		Convey("When VerticesByString is called", func() {
 			var q mockQuery
@@ -89,7 +90,7 @@ func TestVerticesByQuery(t *testing.T) {
 
 func TestVerticesByQueryError(t *testing.T) {
 	Convey("Given a string executor and vertex query manager", t, func() {
-		execute := func(string) ([][]byte, error) { return nil, errors.New("ERROR") }
+		execute := func(query.Query) ([][]byte, error) { return nil, errors.New("ERROR") }
 		qm := newGetVertexQueryManager(logging.NewNilLogger(), execute)
 		Convey("When VerticesByString is called and encounters an error", func() {
 			var q mockQuery
@@ -103,7 +104,7 @@ func TestVerticesByQueryError(t *testing.T) {
 
 func TestAllVertices(t *testing.T) {
 	Convey("Given a string executor and vertex query manager", t, func() {
-		execute := func(string) ([][]byte, error) { return [][]byte{[]byte(vertexResponse)}, nil }
+		execute := func(query.Query) ([][]byte, error) { return [][]byte{[]byte(vertexResponse)}, nil }
 		qm := newGetVertexQueryManager(logging.NewNilLogger(), execute)
 		Convey("When AllVertices is called", func() {
 			_, err := qm.AllVertices()
@@ -116,7 +117,7 @@ func TestAllVertices(t *testing.T) {
 
 func TestAllVerticesError(t *testing.T) {
 	Convey("Given a string executor and vertex query manager", t, func() {
-		execute := func(string) ([][]byte, error) { return nil, errors.New("ERROR") }
+		execute := func(query.Query) ([][]byte, error) { return nil, errors.New("ERROR") }
 		qm := newGetVertexQueryManager(logging.NewNilLogger(), execute)
 		Convey("When AllVertices is called and encounters an error", func() {
 			_, err := qm.AllVertices()
@@ -129,7 +130,7 @@ func TestAllVerticesError(t *testing.T) {
 
 func TestVertexByID(t *testing.T) {
 	Convey("Given a string executor and vertex query manager", t, func() {
-		execute := func(string) ([][]byte, error) { return [][]byte{[]byte(vertexResponse)}, nil }
+		execute := func(query.Query) ([][]byte, error) { return [][]byte{[]byte(vertexResponse)}, nil }
 		qm := newGetVertexQueryManager(logging.NewNilLogger(), execute)
 		Convey("When VertexByID is called with valid ID", func() {
 			_, err := qm.VertexByID(1234)
@@ -149,7 +150,7 @@ func TestVertexByID(t *testing.T) {
 
 func TestVertexByIDError(t *testing.T) {
 	Convey("Given a string executor and vertex query manager", t, func() {
-		execute := func(string) ([][]byte, error) { return nil, errors.New("ERROR") }
+		execute := func(query.Query) ([][]byte, error) { return nil, errors.New("ERROR") }
 		qm := newGetVertexQueryManager(logging.NewNilLogger(), execute)
 		Convey("When VertexByID is called and encounters an error", func() {
 			_, err := qm.VertexByID(1234)
@@ -162,7 +163,7 @@ func TestVertexByIDError(t *testing.T) {
 
 func TestVertices(t *testing.T) {
 	Convey("Given a string executor and vertex query manager", t, func() {
-		execute := func(string) ([][]byte, error) { return [][]byte{[]byte(vertexResponse)}, nil }
+		execute := func(query.Query) ([][]byte, error) { return [][]byte{[]byte(vertexResponse)}, nil }
 		qm := newGetVertexQueryManager(logging.NewNilLogger(), execute)
 		Convey("When Vertices is called", func() {
 			_, err := qm.Vertices("testlabel", "prop1", "prop2")
@@ -175,7 +176,7 @@ func TestVertices(t *testing.T) {
 
 func TestVerticesPropertyError(t *testing.T) {
 	Convey("Given a string executor and vertex query manager", t, func() {
-		execute := func(string) ([][]byte, error) { return [][]byte{[]byte(vertexResponse)}, nil }
+		execute := func(query.Query) ([][]byte, error) { return [][]byte{[]byte(vertexResponse)}, nil }
 		qm := newGetVertexQueryManager(logging.NewNilLogger(), execute)
 		Convey("When Vertices is called with an odd number of properties", func() {
 			_, err := qm.Vertices("testlabel", "prop1")
@@ -188,7 +189,7 @@ func TestVerticesPropertyError(t *testing.T) {
 
 func TestVerticesQueryError(t *testing.T) {
 	Convey("Given a string executor and vertex query manager", t, func() {
-		execute := func(string) ([][]byte, error) { return nil, errors.New("ERROR") }
+		execute := func(query.Query) ([][]byte, error) { return nil, errors.New("ERROR") }
 		qm := newGetVertexQueryManager(logging.NewNilLogger(), execute)
 		Convey("When Vertices is called and encounters a querying error", func() {
 			_, err := qm.Vertices("testlabel", "prop1", "prop2")
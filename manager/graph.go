@@ -21,6 +21,7 @@
 package manager
 
 import (
+	"github.com/northwesternmutual/grammes/dialect"
 	"github.com/northwesternmutual/grammes/gremconnect"
 	"github.com/northwesternmutual/grammes/logging"
 )
@@ -29,6 +30,11 @@ import (
 type GraphQueryManager struct {
 	*queryManager
 	*vertexQueryManager
+	*edgeQueryManager
+	*upsertQueryManager
+	*batchQueryManager
+	*paginateQueryManager
+	*namedQueryManager
 	*miscQueryManager
 	*schemaManager
 
@@ -43,9 +49,14 @@ func NewGraphManager(dialer gremconnect.Dialer, logger logging.Logger, executeRe
 		queryManager: newQueryManager(dialer, logger, executeRequest),
 	}
 
-	g.vertexQueryManager = newVertexQueryManager(logger, g.ExecuteStringQuery)
-	g.miscQueryManager = newMiscQueryManager(logger, g.ExecuteStringQuery)
-	g.schemaManager = newSchemaManager(logger, g.ExecuteStringQuery)
+	g.vertexQueryManager = newVertexQueryManager(logger, g.ExecuteQuery)
+	g.edgeQueryManager = newEdgeQueryManager(logger, g.ExecuteQuery)
+	g.upsertQueryManager = newUpsertQueryManager(logger, g.ExecuteQuery)
+	g.batchQueryManager = newBatchQueryManager(logger, g.ExecuteBoundStringQuery)
+	g.paginateQueryManager = newPaginateQueryManager(logger, g.ExecuteQuery)
+	g.namedQueryManager = newNamedQueryManager(logger, g.ExecuteBoundQuery)
+	g.miscQueryManager = newMiscQueryManager(logger, g.ExecuteQuery)
+	g.schemaManager = newSchemaManager(logger, g.executeManagementQuery)
 
 	return g
 }
@@ -56,10 +67,40 @@ func (g *GraphQueryManager) SetLogger(newLogger logging.Logger) {
 	g.queryManager.logger = newLogger
 	g.schemaManager.logger = newLogger
 	g.miscQueryManager.logger = newLogger
+	g.edgeQueryManager.logger = newLogger
+	g.upsertQueryManager.logger = newLogger
+	g.batchQueryManager.logger = newLogger
+	g.paginateQueryManager.logger = newLogger
+	g.namedQueryManager.logger = newLogger
 	g.vertexQueryManager.addVertexQueryManager.logger = newLogger
 	g.vertexQueryManager.getVertexQueryManager.logger = newLogger
 }
 
+// SetTinkerPopVersion will set the TinkerPop version of the server
+// so the queries only use steps that the server supports.
+func (g *GraphQueryManager) SetTinkerPopVersion(version string) {
+	g.upsertQueryManager.version = version
+	g.queryManager.version = version
+}
+
+// SetInlineValues will keep the string values of the traversals in
+// the scripts instead of sending them as bindings.
+func (g *GraphQueryManager) SetInlineValues(inline bool) {
+	g.queryManager.inline = inline
+}
+
+// SetDialect will set the dialect of the graph database so that
+// queries are checked against it and use its representation of IDs.
+func (g *GraphQueryManager) SetDialect(d *dialect.Dialect) {
+	g.queryManager.dialect = d
+	g.edgeQueryManager.dialect = d
+	g.upsertQueryManager.dialect = d
+	g.miscQueryManager.dialect = d
+	g.batchQueryManager.dialect = d
+	g.vertexQueryManager.getVertexQueryManager.dialect = d
+	g.vertexQueryManager.dropQueryManager.dialect = d
+}
+
 // MiscQuerier returns the manager for miscellaneous queries.
 func (g *GraphQueryManager) MiscQuerier() MiscQuerier {
 	return g.miscQueryManager
@@ -90,6 +131,31 @@ func (g *GraphQueryManager) VertexQuerier() VertexQuerier {
 	return g.vertexQueryManager
 }
 
+// EdgeQuerier returns the manager for all edge related queries.
+func (g *GraphQueryManager) EdgeQuerier() EdgeQuerier {
+	return g.edgeQueryManager
+}
+
+// UpsertQuerier returns the manager for getting or creating elements.
+func (g *GraphQueryManager) UpsertQuerier() UpsertQuerier {
+	return g.upsertQueryManager
+}
+
+// BatchQuerier returns the manager for executing batches.
+func (g *GraphQueryManager) BatchQuerier() BatchQuerier {
+	return g.batchQueryManager
+}
+
+// PaginateQuerier returns the manager for reading results in pages.
+func (g *GraphQueryManager) PaginateQuerier() PaginateQuerier {
+	return g.paginateQueryManager
+}
+
+// NamedQuerier returns the manager for prepared named queries.
+func (g *GraphQueryManager) NamedQuerier() NamedQuerier {
+	return g.namedQueryManager
+}
+
 // ExecuteQuerier returns the manager for executing the raw queries.
 func (g *GraphQueryManager) ExecuteQuerier() ExecuteQuerier {
 	return g.queryManager
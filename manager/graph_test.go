@@ -21,12 +21,17 @@
 package manager
 
 import (
+	"context"
+	"errors"
 	"testing"
 
 	. "github.com/smartystreets/goconvey/convey"
 
+	"github.com/northwesternmutual/grammes/dialect"
 	"github.com/northwesternmutual/grammes/gremconnect"
+	"github.com/northwesternmutual/grammes/gremerror"
 	"github.com/northwesternmutual/grammes/logging"
+	"github.com/northwesternmutual/grammes/query/traversal"
 )
 
 func TestSetLogger(t *testing.T) {
@@ -151,3 +156,129 @@ func TestSchemaQuerier(t *testing.T) {
 		})
 	})
 }
+
+func TestEdgeQuerier(t *testing.T) {
+	Convey("Given a dialer, string executor and graph query manager", t, func() {
+		dialer := gremconnect.NewWebSocketDialer("testaddress")
+		execute := func(string, map[string]string, map[string]string) ([][]byte, error) { return nil, nil }
+		gm := NewGraphManager(dialer, logging.NewNilLogger(), execute)
+		Convey("When EdgeQuerier is called", func() {
+			eq := gm.EdgeQuerier()
+			Convey("Then we should return the edge querier", func() {
+				So(eq, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestUpsertQuerier(t *testing.T) {
+	Convey("Given a dialer, string executor and graph query manager", t, func() {
+		dialer := gremconnect.NewWebSocketDialer("testaddress")
+		execute := func(string, map[string]string, map[string]string) ([][]byte, error) { return nil, nil }
+		gm := NewGraphManager(dialer, logging.NewNilLogger(), execute)
+		Convey("When SetTinkerPopVersion is called", func() {
+			gm.SetTinkerPopVersion("3.5.4")
+			Convey("Then the upsert querier should use the version", func() {
+				So(gm.upsertQueryManager.version, ShouldEqual, "3.5.4")
+				So(gm.queryManager.version, ShouldEqual, "3.5.4")
+			})
+		})
+		Convey("When SetInlineValues is called", func() {
+			gm.SetInlineValues(true)
+			Convey("Then the query manager should keep values inline", func() {
+				So(gm.queryManager.inline, ShouldBeTrue)
+			})
+		})
+		Convey("When SetDialect is called", func() {
+			gm.SetDialect(dialect.CosmosDB)
+			Convey("Then the managers should use the dialect", func() {
+				So(gm.queryManager.dialect, ShouldEqual, dialect.CosmosDB)
+				So(gm.edgeQueryManager.dialect, ShouldEqual, dialect.CosmosDB)
+				So(gm.vertexQueryManager.getVertexQueryManager.dialect, ShouldEqual, dialect.CosmosDB)
+			})
+		})
+		Convey("When UpsertQuerier is called", func() {
+			uq := gm.UpsertQuerier()
+			Convey("Then we should return the upsert querier", func() {
+				So(uq, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestPaginateQuerier(t *testing.T) {
+	Convey("Given a dialer, string executor and graph query manager", t, func() {
+		dialer := gremconnect.NewWebSocketDialer("testaddress")
+		execute := func(string, map[string]string, map[string]string) ([][]byte, error) { return nil, nil }
+		gm := NewGraphManager(dialer, logging.NewNilLogger(), execute)
+		Convey("When PaginateQuerier is called", func() {
+			pq := gm.PaginateQuerier()
+			Convey("Then we should return the paginate querier", func() {
+				So(pq, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestNamedQuerier(t *testing.T) {
+	Convey("Given a dialer, string executor and graph query manager", t, func() {
+		dialer := gremconnect.NewWebSocketDialer("testaddress")
+		execute := func(string, map[string]string, map[string]string) ([][]byte, error) { return nil, nil }
+		gm := NewGraphManager(dialer, logging.NewNilLogger(), execute)
+		Convey("When NamedQuerier is called", func() {
+			nq := gm.NamedQuerier()
+			Convey("Then we should return the named querier", func() {
+				So(nq, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestInvalidQueries(t *testing.T) {
+	Convey("Given a graph query manager and a traversal built with errors", t, func() {
+		dialer := gremconnect.NewWebSocketDialer("testaddress")
+		var sent []string
+		execute := func(q string, _, _ map[string]string) ([][]byte, error) {
+			sent = append(sent, q)
+			return nil, nil
+		}
+		gm := NewGraphManager(dialer, logging.NewNilLogger(), execute)
+		bad := traversal.NewTraversal().V().Has("a", "b", "c", "d")
+		Convey("When the traversal is given to the sub-managers", func() {
+			_, err1 := gm.VerticesByQuery(bad)
+			_, err2 := gm.VertexIDsByQuery(bad)
+			_, err3 := gm.AddVertexByQuery(bad)
+			err4 := gm.DropVerticesByQuery(bad)
+			_, err5 := gm.Paginate(bad).Next(context.Background())
+			Convey("Then it should be refused before it's sent", func() {
+				for _, err := range []error{err1, err2, err3, err4, err5} {
+					So(errors.Is(err, gremerror.ErrArgumentCount), ShouldBeTrue)
+				}
+				So(sent, ShouldBeEmpty)
+			})
+		})
+	})
+}
+
+func TestDialectQueries(t *testing.T) {
+	Convey("Given a graph query manager with a dialect", t, func() {
+		dialer := gremconnect.NewWebSocketDialer("testaddress")
+		var sent []string
+		execute := func(q string, _, _ map[string]string) ([][]byte, error) {
+			sent = append(sent, q)
+			return nil, nil
+		}
+		gm := NewGraphManager(dialer, logging.NewNilLogger(), execute)
+		gm.SetDialect(dialect.CosmosDB)
+		Convey("When a step the dialect doesn't have is given to the sub-managers", func() {
+			bad := traversal.NewTraversal().V().Sack()
+			_, err1 := gm.VertexIDsByQuery(bad)
+			_, err2 := gm.Paginate(bad).Next(context.Background())
+			Convey("Then it should be refused before it's sent", func() {
+				So(errors.Is(err1, gremerror.ErrNotInDialect), ShouldBeTrue)
+				So(errors.Is(err2, gremerror.ErrNotInDialect), ShouldBeTrue)
+				So(sent, ShouldBeEmpty)
+			})
+		})
+	})
+}
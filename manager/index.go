@@ -0,0 +1,138 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package manager
+
+import (
+	"time"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/model"
+	"github.com/northwesternmutual/grammes/query/graph"
+	"github.com/northwesternmutual/grammes/query/schemaaction"
+	"github.com/northwesternmutual/grammes/query/schemastatus"
+	"github.com/northwesternmutual/grammes/schema"
+)
+
+// indexStatusReportScript turns a graph index status report
+// into a map that is decoded into an IndexStatusReport.
+const indexStatusReportScript = "['indexName': report.getIndexName(), 'succeeded': report.getSucceeded(), " +
+	"'converged': report.getConvergedKeys().collectEntries { k, v -> [(k): 'SchemaStatus.' + v.name()] }, " +
+	"'notConverged': report.getNotConvergedKeys().collectEntries { k, v -> [(k): 'SchemaStatus.' + v.name()] }, " +
+	"'elapsedMillis': report.getElapsed().toMillis()]"
+
+// relationIndexStatusReportScript does the same as indexStatusReportScript
+// for a vertex-centric index, which only has a single status.
+const relationIndexStatusReportScript = "['indexName': report.getIndexName(), 'succeeded': report.getSucceeded(), " +
+	"(report.getSucceeded() ? 'converged' : 'notConverged'): " +
+	"[(report.getIndexName()): 'SchemaStatus.' + report.getActualStatus().name()], " +
+	"'elapsedMillis': report.getElapsed().toMillis()]"
+
+// AddIndex builds the composite, mixed, or vertex-centric
+// index described by the IndexSchema and commits it. The
+// keys being indexed must already exist in the schema.
+func (s *schemaManager) AddIndex(index model.IndexSchema) error {
+	statement, err := schema.IndexStatement(index)
+	if err != nil {
+		s.logger.Error("invalid index",
+			gremerror.NewGrammesError("AddIndex", err),
+		)
+		return err
+	}
+
+	if err = s.executeManagement("AddIndex", statement.String()); err != nil {
+		return err
+	}
+
+	s.logger.Debug("Added Index", map[string]interface{}{
+		"Name": index.Name,
+		"Type": string(index.Type),
+	})
+
+	return nil
+}
+
+// UpdateIndex performs the action on the graph index and
+// commits it. When reindexing or removing an index this
+// blocks until the job running the action has completed.
+func (s *schemaManager) UpdateIndex(name string, action schemaaction.SchemaAction) error {
+	return s.updateIndex("UpdateIndex", graph.NewManagement().GetGraphIndex(name), action)
+}
+
+// UpdateRelationIndex performs the action on the vertex-centric
+// index built on the relation type and commits it.
+func (s *schemaManager) UpdateRelationIndex(relationType, name string, action schemaaction.SchemaAction) error {
+	return s.updateIndex("UpdateRelationIndex", graph.NewManagement().GetRelationIndex(relationType, name), action)
+}
+
+func (s *schemaManager) updateIndex(function string, index graph.String, action schemaaction.SchemaAction) error {
+	return s.executeManagement(function,
+		"job = "+graph.NewManagement().UpdateIndex(index, action).String(),
+		"if (job != null) { job.get() }",
+	)
+}
+
+// AwaitIndexStatus waits for every key of the graph index to
+// reach the status, or for the timeout to pass. A timeout of
+// zero uses the default of the graph. The returned report tells
+// whether it succeeded and which keys are in which status.
+func (s *schemaManager) AwaitIndexStatus(name string, status schemastatus.SchemaStatus, timeout time.Duration) (model.IndexStatusReport, error) {
+	watcher := graph.AwaitGraphIndexStatus(name).Status(status)
+	return s.awaitIndexStatus("AwaitIndexStatus", watcher, timeout, indexStatusReportScript)
+}
+
+// AwaitRelationIndexStatus waits for the vertex-centric index on the
+// relation type to reach the status, or for the timeout to pass.
+func (s *schemaManager) AwaitRelationIndexStatus(name, relationType string, status schemastatus.SchemaStatus, timeout time.Duration) (model.IndexStatusReport, error) {
+	watcher := graph.AwaitRelationIndexStatus(name, relationType).Status(status)
+	return s.awaitIndexStatus("AwaitRelationIndexStatus", watcher, timeout, relationIndexStatusReportScript)
+}
+
+func (s *schemaManager) awaitIndexStatus(function string, watcher graph.String, timeout time.Duration, reportScript string) (model.IndexStatusReport, error) {
+	var report model.IndexStatusReport
+
+	if timeout > 0 {
+		watcher = watcher.Timeout(timeout)
+	}
+	query := "report = " + watcher.Call().String() + "\n" + reportScript
+
+	data, err := s.executeStringQuery(query)
+	if err != nil {
+		s.logger.Error("invalid query",
+			gremerror.NewQueryError(function, query, err),
+		)
+		return report, err
+	}
+
+	if err = unmarshalGraphSONResult(function, data, &report); err != nil {
+		s.logger.Error("report unmarshal",
+			gremerror.NewGrammesError(function, err),
+		)
+		return report, err
+	}
+
+	s.logger.Debug("Awaited Index Status", map[string]interface{}{
+		"Name":      report.IndexName,
+		"Succeeded": report.Succeeded,
+		"Elapsed":   report.Elapsed().String(),
+	})
+
+	return report, nil
+}
@@ -0,0 +1,215 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package manager
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/logging"
+	"github.com/northwesternmutual/grammes/model"
+	"github.com/northwesternmutual/grammes/query/mapping"
+	"github.com/northwesternmutual/grammes/query/schemaaction"
+	"github.com/northwesternmutual/grammes/query/schemastatus"
+)
+
+func TestAddIndex(t *testing.T) {
+	Convey("Given a string executor and schema manager", t, func() {
+		var query string
+		execute := func(q string) ([][]byte, error) {
+			query = q
+			return nil, nil
+		}
+		sm := newSchemaManager(logging.NewNilLogger(), execute)
+		Convey("When AddIndex is called with a unique composite index", func() {
+			err := sm.AddIndex(model.IndexSchema{
+				Name:      "byName",
+				Type:      model.CompositeIndex,
+				Element:   "Vertex",
+				Unique:    true,
+				IndexOnly: "person",
+				Keys:      []model.IndexKeySchema{{Name: "name"}},
+			})
+			Convey("Then the index should be built and committed in one script", func() {
+				So(err, ShouldBeNil)
+				So(query, ShouldEqual, "mgmt = graph.openManagement()\n"+
+					"mgmt.buildIndex(\"byName\",Vertex.class).addKey(mgmt.getPropertyKey(\"name\")).unique()"+
+					".indexOnly(mgmt.getVertexLabel(\"person\")).buildCompositeIndex()\n"+
+					"mgmt.commit()")
+			})
+		})
+		Convey("When AddIndex is called with a mixed index", func() {
+			err := sm.AddIndex(model.IndexSchema{
+				Name:         "search",
+				Type:         model.MixedIndex,
+				Element:      "Edge",
+				BackingIndex: "search",
+				Keys:         []model.IndexKeySchema{{Name: "note", Mapping: mapping.TextString}},
+			})
+			Convey("Then the keys should use their mapping", func() {
+				So(err, ShouldBeNil)
+				So(query, ShouldContainSubstring, "mgmt.buildIndex(\"search\",Edge.class)"+
+					".addKey(mgmt.getPropertyKey(\"note\"),Mapping.TEXTSTRING.asParameter()).buildMixedIndex(\"search\")")
+			})
+		})
+		Convey("When AddIndex is called with a vertex-centric index", func() {
+			err := sm.AddIndex(model.IndexSchema{
+				Name:    "knowsBySince",
+				Type:    model.VertexCentricIndex,
+				Element: "knows",
+				Order:   "desc",
+				Keys:    []model.IndexKeySchema{{Name: "since"}},
+			})
+			Convey("Then the edge index should default to both directions", func() {
+				So(err, ShouldBeNil)
+				So(query, ShouldContainSubstring, "mgmt.buildEdgeIndex(mgmt.getEdgeLabel(\"knows\"),"+
+					"\"knowsBySince\",BOTH,desc,mgmt.getPropertyKey(\"since\"))")
+			})
+		})
+		Convey("When AddIndex is called without any keys", func() {
+			query = ""
+			err := sm.AddIndex(model.IndexSchema{Name: "byName"})
+			Convey("Then an invalid index error should be returned without querying", func() {
+				So(err, ShouldEqual, gremerror.ErrInvalidIndex)
+				So(query, ShouldBeEmpty)
+			})
+		})
+	})
+}
+
+func TestAddIndexQueryError(t *testing.T) {
+	Convey("Given a string executor and schema manager", t, func() {
+		execute := func(string) ([][]byte, error) { return nil, errors.New("ERROR") }
+		sm := newSchemaManager(logging.NewNilLogger(), execute)
+		Convey("When AddIndex is called and encounters a querying error", func() {
+			err := sm.AddIndex(model.IndexSchema{Name: "byName", Keys: []model.IndexKeySchema{{Name: "name"}}})
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestUpdateIndex(t *testing.T) {
+	Convey("Given a string executor and schema manager", t, func() {
+		var query string
+		execute := func(q string) ([][]byte, error) {
+			query = q
+			return nil, nil
+		}
+		sm := newSchemaManager(logging.NewNilLogger(), execute)
+		Convey("When UpdateIndex is called", func() {
+			err := sm.UpdateIndex("byName", schemaaction.Reindex)
+			Convey("Then the job should be waited on before committing", func() {
+				So(err, ShouldBeNil)
+				So(query, ShouldEqual, "mgmt = graph.openManagement()\n"+
+					"job = mgmt.updateIndex(mgmt.getGraphIndex(\"byName\"),SchemaAction.REINDEX)\n"+
+					"if (job != null) { job.get() }\n"+
+					"mgmt.commit()")
+			})
+		})
+		Convey("When UpdateRelationIndex is called", func() {
+			err := sm.UpdateRelationIndex("knows", "knowsBySince", schemaaction.EnableIndex)
+			Convey("Then the relation index should be referenced", func() {
+				So(err, ShouldBeNil)
+				So(query, ShouldContainSubstring, "mgmt.updateIndex(mgmt.getRelationIndex("+
+					"mgmt.getRelationType(\"knows\"),\"knowsBySince\"),SchemaAction.ENABLE_INDEX)")
+			})
+		})
+	})
+}
+
+func TestUpdateIndexQueryError(t *testing.T) {
+	Convey("Given a string executor and schema manager", t, func() {
+		execute := func(string) ([][]byte, error) { return nil, errors.New("ERROR") }
+		sm := newSchemaManager(logging.NewNilLogger(), execute)
+		Convey("When UpdateIndex is called and encounters a querying error", func() {
+			err := sm.UpdateIndex("byName", schemaaction.DisableIndex)
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestAwaitIndexStatus(t *testing.T) {
+	Convey("Given a string executor and schema manager", t, func() {
+		var query string
+		execute := func(q string) ([][]byte, error) {
+			query = q
+			return [][]byte{[]byte(indexStatusResponse)}, nil
+		}
+		sm := newSchemaManager(logging.NewNilLogger(), execute)
+		Convey("When AwaitIndexStatus is called", func() {
+			report, err := sm.AwaitIndexStatus("byName", schemastatus.Enabled, 10*time.Second)
+			Convey("Then the watcher should be configured", func() {
+				So(err, ShouldBeNil)
+				So(query, ShouldStartWith, "report = ManagementSystem.awaitGraphIndexStatus(graph,\"byName\")"+
+					".status(SchemaStatus.ENABLED).timeout(10000,java.time.temporal.ChronoUnit.MILLIS).call()\n")
+			})
+			Convey("Then the report should be decoded", func() {
+				So(report.IndexName, ShouldEqual, "byName")
+				So(report.Succeeded, ShouldBeFalse)
+				So(report.Converged, ShouldResemble, map[string]schemastatus.SchemaStatus{"name": schemastatus.Enabled})
+				So(report.NotConverged, ShouldResemble, map[string]schemastatus.SchemaStatus{"age": schemastatus.Registered})
+				So(report.Elapsed(), ShouldEqual, 1500*time.Millisecond)
+			})
+		})
+		Convey("When AwaitRelationIndexStatus is called without a timeout", func() {
+			_, err := sm.AwaitRelationIndexStatus("knowsBySince", "knows", schemastatus.Registered, 0)
+			Convey("Then the default timeout of the graph should be used", func() {
+				So(err, ShouldBeNil)
+				So(query, ShouldStartWith, "report = ManagementSystem.awaitRelationIndexStatus(graph,\"knowsBySince\",\"knows\")"+
+					".status(SchemaStatus.REGISTERED).call()\n")
+			})
+		})
+	})
+}
+
+func TestAwaitIndexStatusQueryError(t *testing.T) {
+	Convey("Given a string executor and schema manager", t, func() {
+		execute := func(string) ([][]byte, error) { return nil, errors.New("ERROR") }
+		sm := newSchemaManager(logging.NewNilLogger(), execute)
+		Convey("When AwaitIndexStatus is called and encounters a querying error", func() {
+			_, err := sm.AwaitIndexStatus("byName", schemastatus.Enabled, 0)
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestAwaitIndexStatusEmptyResponse(t *testing.T) {
+	Convey("Given a string executor and schema manager", t, func() {
+		execute := func(string) ([][]byte, error) { return [][]byte{[]byte(`{"@type":"g:List","@value":[]}`)}, nil }
+		sm := newSchemaManager(logging.NewNilLogger(), execute)
+		Convey("When AwaitIndexStatus is called and nothing is returned", func() {
+			_, err := sm.AwaitIndexStatus("byName", schemastatus.Enabled, 0)
+			Convey("Then an empty response error should be returned", func() {
+				So(err, ShouldEqual, gremerror.ErrEmptyResponse)
+			})
+		})
+	})
+}
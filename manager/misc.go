@@ -21,10 +21,9 @@
 package manager
 
 import (
-	"errors"
-	"fmt"
 	"strconv"
 
+	"github.com/northwesternmutual/grammes/dialect"
 	"github.com/northwesternmutual/grammes/gremerror"
 	"github.com/northwesternmutual/grammes/logging"
 	"github.com/northwesternmutual/grammes/model"
@@ -32,19 +31,21 @@ import (
 )
 
 type miscQueryManager struct {
-	logger             logging.Logger
-	executeStringQuery stringExecutor
+	logger       logging.Logger
+	executeQuery queryExecutor
+	// dialect gives the IDs the representation of the database.
+	dialect *dialect.Dialect
 }
 
-func newMiscQueryManager(logger logging.Logger, execute stringExecutor) *miscQueryManager {
+func newMiscQueryManager(logger logging.Logger, execute queryExecutor) *miscQueryManager {
 	return &miscQueryManager{
-		executeStringQuery: execute,
-		logger:             logger,
+		executeQuery: execute,
+		logger:       logger,
 	}
 }
 
 func (m *miscQueryManager) DropAll() error {
-	_, err := m.executeStringQuery("g.V().drop()")
+	_, err := m.executeQuery(traversal.NewTraversal().V().Drop())
 	return err
 }
 
@@ -56,12 +57,12 @@ func (m *miscQueryManager) SetVertexProperty(id interface{}, keyAndVals ...inter
 		return gremerror.ErrOddNumberOfParameters
 	}
 
-	query := traversal.NewTraversal().V().HasID(id)
+	query := traversal.NewTraversal().V().HasID(m.dialect.ID(id))
 	for i := 0; i < len(keyAndVals); i += 2 {
 		query.AddStep("property", keyAndVals[i], keyAndVals[i+1])
 	}
 
-	if _, err := m.executeStringQuery(query.String()); err != nil {
+	if _, err := m.executeQuery(query); err != nil {
 		m.logger.Error("invalid query",
 			gremerror.NewQueryError("SetVertexProperty", query.String(), err),
 		)
@@ -71,13 +72,48 @@ func (m *miscQueryManager) SetVertexProperty(id interface{}, keyAndVals ...inter
 	return nil
 }
 
+// ApplyPatch will apply every change in the patch to the vertex
+// with the given ID. The changes are sent as a single traversal
+// so they are either all applied or none of them are.
+func (m *miscQueryManager) ApplyPatch(id interface{}, patch model.Patch) error {
+	if patch.IsEmpty() {
+		return nil
+	}
+
+	patch.ID = m.dialect.ID(id)
+	patch.Removed = m.propertyIDs(patch.Removed)
+	query := patch.Traversal()
+
+	if _, err := m.executeQuery(query); err != nil {
+		m.logger.Error("invalid query",
+			gremerror.NewQueryError("ApplyPatch", query.String(), err),
+		)
+		return err
+	}
+
+	return nil
+}
+
+// propertyIDs returns a copy of the properties with
+// their IDs the way the dialect represents them.
+func (m *miscQueryManager) propertyIDs(props model.PropertyMap) model.PropertyMap {
+	converted := make(model.PropertyMap, len(props))
+	for key, vals := range props {
+		for _, v := range vals {
+			v.Value.ID = model.PropertyID{Value: m.dialect.ID(v.ID())}
+			converted[key] = append(converted[key], v)
+		}
+	}
+	return converted
+}
+
 // VertexCount retrieves the number of vertices
 // that are currently on the graph as an int64.
 func (m *miscQueryManager) VertexCount() (int64, error) {
 	// Query the graph for the count using IDs.
 	query := traversal.NewTraversal().V().Count()
 
-	responses, err := m.executeStringQuery(query.String())
+	responses, err := m.executeQuery(query)
 	if err != nil {
 		m.logger.Error("VertexCount",
 			gremerror.NewQueryError("VertexCount", query.String(), err),
@@ -85,31 +121,13 @@ func (m *miscQueryManager) VertexCount() (int64, error) {
 		return 0, err
 	}
 
-	if len(responses) == 0 {
-		return 0, gremerror.ErrEmptyResponse
-	}
-
-	var rawResp model.IDList
-
-	err = jsonUnmarshal(responses[0], &rawResp)
+	count, err := unmarshalCount(responses)
 	if err != nil {
 		m.logger.Error("unmarshal",
-			gremerror.NewUnmarshalError("VertexCount", responses[0], err),
+			gremerror.NewGrammesError("VertexCount", err),
 		)
 		return 0, err
 	}
 
-	if len(rawResp.IDs) == 0 {
-		return 0, errors.New(fmt.Sprintf("invalid response %s", string(responses[0])))
-	}
-	v, ok := rawResp.IDs[0].(map[string]interface{})
-	if !ok {
-		return 0, errors.New(fmt.Sprintf("invalid response %s", string(responses[0])))
-	}
-	count, ok := v["@value"].(float64)
-	if !ok {
-		return 0, errors.New(fmt.Sprintf("invalid response %s", string(responses[0])))
-	}
-
-	return int64(count), nil
+	return count, nil
 }
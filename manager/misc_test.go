@@ -27,12 +27,15 @@ import (
 
 	. "github.com/smartystreets/goconvey/convey"
 
+	"github.com/northwesternmutual/grammes/dialect"
 	"github.com/northwesternmutual/grammes/logging"
+	"github.com/northwesternmutual/grammes/model"
+	"github.com/northwesternmutual/grammes/query"
 )
 
 func TestDropAll(t *testing.T) {
 	Convey("Given a string executor and misc query manager", t, func() {
-		execute := func(string) ([][]byte, error) { return nil, nil }
+		execute := func(query.Query) ([][]byte, error) { return nil, nil }
 		mm := newMiscQueryManager(logging.NewNilLogger(), execute)
 		Convey("When DropAll is called", func() {
 			err := mm.DropAll()
@@ -45,7 +48,7 @@ func TestDropAll(t *testing.T) {
 
 func TestSetVertexProperty(t *testing.T) {
 	Convey("Given a string executor and misc query manager", t, func() {
-		execute := func(string) ([][]byte, error) { return nil, nil }
+		execute := func(query.Query) ([][]byte, error) { return nil, nil }
 		mm := newMiscQueryManager(logging.NewNilLogger(), execute)
 		Convey("When SetVertexProperty is called", func() {
 			err := mm.SetVertexProperty(1234, "prop1", "prop2")
@@ -58,7 +61,7 @@ func TestSetVertexProperty(t *testing.T) {
 
 func TestSetVertexPropertyParameterError(t *testing.T) {
 	Convey("Given a string executor and misc query manager", t, func() {
-		execute := func(string) ([][]byte, error) { return nil, nil }
+		execute := func(query.Query) ([][]byte, error) { return nil, nil }
 		mm := newMiscQueryManager(logging.NewNilLogger(), execute)
 		Convey("When SetVertexProperty is called with an odd number of properties", func() {
 			err := mm.SetVertexProperty(1234, "prop1")
@@ -71,7 +74,7 @@ func TestSetVertexPropertyParameterError(t *testing.T) {
 
 func TestSetVertexPropertyQueryError(t *testing.T) {
 	Convey("Given a string executor and misc query manager", t, func() {
-		execute := func(string) ([][]byte, error) { return nil, errors.New("ERROR") }
+		execute := func(query.Query) ([][]byte, error) { return nil, errors.New("ERROR") }
 		mm := newMiscQueryManager(logging.NewNilLogger(), execute)
 		Convey("When SetVertexProperty is called and encounters a querying error", func() {
 			err := mm.SetVertexProperty(1234)
@@ -84,7 +87,7 @@ func TestSetVertexPropertyQueryError(t *testing.T) {
 
 func TestVertexCount(t *testing.T) {
 	Convey("Given a string executor and misc query manager", t, func() {
-		execute := func(string) ([][]byte, error) { return [][]byte{[]byte(idResponse)}, nil }
+		execute := func(query.Query) ([][]byte, error) { return [][]byte{[]byte(idResponse)}, nil }
 		mm := newMiscQueryManager(logging.NewNilLogger(), execute)
 		Convey("When SetVertexProperty is called", func() {
 			c, _ := mm.VertexCount()
@@ -97,7 +100,7 @@ func TestVertexCount(t *testing.T) {
 
 func TestVertexCountQueryError(t *testing.T) {
 	Convey("Given a string executor and misc query manager", t, func() {
-		execute := func(string) ([][]byte, error) { return nil, errors.New("ERROR") }
+		execute := func(query.Query) ([][]byte, error) { return nil, errors.New("ERROR") }
 		mm := newMiscQueryManager(logging.NewNilLogger(), execute)
 		Convey("When SetVertexProperty is called and encounters a querying error", func() {
 			_, err := mm.VertexCount()
@@ -114,7 +117,7 @@ func TestVertexCountUnmarshalError(t *testing.T) {
 	}()
 	jsonUnmarshal = func([]byte, interface{}) error { return errors.New("ERROR") }
 	Convey("Given a string executor and misc query manager", t, func() {
-		execute := func(string) ([][]byte, error) { return nil, nil }
+		execute := func(query.Query) ([][]byte, error) { return nil, nil }
 		mm := newMiscQueryManager(logging.NewNilLogger(), execute)
 		Convey("When SetVertexProperty is called and encounters an numarshalling error", func() {
 			_, err := mm.VertexCount()
@@ -124,3 +127,62 @@ func TestVertexCountUnmarshalError(t *testing.T) {
 		})
 	})
 }
+
+func TestApplyPatch(t *testing.T) {
+	Convey("Given a string executor and misc query manager", t, func() {
+		var sent string
+		execute := func(q query.Query) ([][]byte, error) {
+			sent = q.String()
+			return nil, nil
+		}
+		mm := newMiscQueryManager(logging.NewNilLogger(), execute)
+		Convey("When ApplyPatch is called", func() {
+			old := model.NewVertex("person", "name", "damien")
+			new := model.NewVertex("person", "name", "dame")
+			err := mm.ApplyPatch(1234, model.Diff(old, new))
+			Convey("Then the patch should be sent as a single query", func() {
+				So(err, ShouldBeNil)
+				So(sent, ShouldEqual, `g.V().hasId(1234).property(single,"name","dame")`)
+			})
+		})
+
+		Convey("When ApplyPatch is called with a dialect and a removed value", func() {
+			mm.dialect = dialect.TinkerGraph
+			old := model.NewVertex("person")
+			old.AddPropertyValue("nick", "d")
+			old.AddPropertyValue("nick", "dame")
+			old.Value.Properties["nick"][0].Value.ID = model.PropertyID{Type: "g:Int64", Value: float64(7)}
+			old.Value.Properties["nick"][1].Value.ID = model.PropertyID{Type: "g:Int64", Value: float64(8)}
+			new := model.NewVertex("person")
+			new.AddPropertyValue("nick", "dame")
+			err := mm.ApplyPatch(1234, model.Diff(old, new))
+			Convey("Then the IDs should be written the way the dialect represents them", func() {
+				So(err, ShouldBeNil)
+				So(sent, ShouldEqual, `g.V().hasId(1234L).sideEffect(properties("nick").hasId(7L).drop())`)
+			})
+		})
+
+		Convey("When ApplyPatch is called with an empty patch", func() {
+			sent = ""
+			err := mm.ApplyPatch(1234, model.Patch{})
+			Convey("Then nothing should be sent", func() {
+				So(err, ShouldBeNil)
+				So(sent, ShouldBeEmpty)
+			})
+		})
+	})
+}
+
+func TestApplyPatchQueryError(t *testing.T) {
+	Convey("Given a string executor and misc query manager", t, func() {
+		execute := func(query.Query) ([][]byte, error) { return nil, errors.New("ERROR") }
+		mm := newMiscQueryManager(logging.NewNilLogger(), execute)
+		Convey("When ApplyPatch is called and encounters a querying error", func() {
+			patch := model.Diff(model.NewVertex("person"), model.NewVertex("person", "name", "dame"))
+			err := mm.ApplyPatch(1234, patch)
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -22,13 +22,24 @@ package manager
 
 import (
 	"encoding/json"
+	"fmt"
+	"io"
+	"time"
 
+	"github.com/northwesternmutual/grammes/dialect"
+	"github.com/northwesternmutual/grammes/gremerror"
 	"github.com/northwesternmutual/grammes/logging"
 	"github.com/northwesternmutual/grammes/model"
 	"github.com/northwesternmutual/grammes/query"
 	"github.com/northwesternmutual/grammes/query/cardinality"
+	"github.com/northwesternmutual/grammes/query/consistency"
 	"github.com/northwesternmutual/grammes/query/datatype"
+	"github.com/northwesternmutual/grammes/query/graph"
 	"github.com/northwesternmutual/grammes/query/multiplicity"
+	"github.com/northwesternmutual/grammes/query/schemaaction"
+	"github.com/northwesternmutual/grammes/query/schemastatus"
+	"github.com/northwesternmutual/grammes/query/traversal"
+	"github.com/northwesternmutual/grammes/schema"
 )
 
 var (
@@ -60,12 +71,55 @@ func unmarshalID(data [][]byte) (id interface{}, err error) {
 	return id, err
 }
 
+// unmarshalCount will take a raw response from
+// a count() step and unmarshal it into an int64.
+func unmarshalCount(data [][]byte) (int64, error) {
+	if len(data) == 0 {
+		return 0, gremerror.ErrEmptyResponse
+	}
+
+	var rawResp model.IDList
+
+	err := jsonUnmarshal(data[0], &rawResp)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(rawResp.IDs) == 0 {
+		return 0, fmt.Errorf("invalid response %s", string(data[0]))
+	}
+	v, ok := rawResp.IDs[0].(map[string]interface{})
+	if !ok {
+		return 0, fmt.Errorf("invalid response %s", string(data[0]))
+	}
+	count, ok := v["@value"].(float64)
+	if !ok {
+		return 0, fmt.Errorf("invalid response %s", string(data[0]))
+	}
+
+	return int64(count), nil
+}
+
 // executor is the function type that is used when passing in executeRequest.
 type executor func(string, map[string]string, map[string]string) ([][]byte, error)
 
 // executor is the function type that is used when passing in ExecuteStringQuery.
 type stringExecutor func(string) ([][]byte, error)
 
+// queryExecutor is the function type that is used when passing in ExecuteQuery.
+type queryExecutor func(query.Query) ([][]byte, error)
+
+// boundExecutor executes a query with bindings.
+type boundExecutor func(query.Query, map[string]string, map[string]string) ([][]byte, error)
+
+// script is a query written by hand. It's sent
+// to the server as it is, without being checked.
+type script string
+
+func (s script) String() string {
+	return string(s)
+}
+
 // MiscQuerier are miscellaneous queries for the server to perform.
 type MiscQuerier interface {
 	// DropAll will drop all vertices on the graph.
@@ -74,6 +128,8 @@ type MiscQuerier interface {
 	VertexCount() (count int64, err error)
 	// SetVertexProperty will either add or set the property of a vertex.
 	SetVertexProperty(id interface{}, keyAndVals ...interface{}) error
+	// ApplyPatch will apply the property changes of a patch to a vertex.
+	ApplyPatch(id interface{}, patch model.Patch) error
 }
 
 // SchemaQuerier handles all schema related queries to the graph.
@@ -86,6 +142,32 @@ type SchemaQuerier interface {
 	AddPropertyKey(label string, dt datatype.DataType, card cardinality.Cardinality) (id interface{}, err error)
 	// CommitSchema will finalize your changes and apply them to the schema.
 	CommitSchema() (res [][]byte, err error)
+	// DescribeSchema will read the current schema back from the graph.
+	DescribeSchema() (schema model.Schema, err error)
+	// ExportSchema will write the current schema as a JSON or YAML document.
+	ExportSchema(w io.Writer, format schema.Format) (err error)
+	// ImportSchema will create whatever is missing from a schema document.
+	ImportSchema(r io.Reader) (changes schema.Changes, err error)
+	// AddIndex builds a composite, mixed, or vertex-centric index.
+	AddIndex(index model.IndexSchema) (err error)
+	// UpdateIndex performs a schema action on a graph index.
+	UpdateIndex(name string, action schemaaction.SchemaAction) (err error)
+	// UpdateRelationIndex performs a schema action on a vertex-centric index.
+	UpdateRelationIndex(relationType, name string, action schemaaction.SchemaAction) (err error)
+	// AwaitIndexStatus waits for a graph index to reach a status.
+	AwaitIndexStatus(name string, status schemastatus.SchemaStatus, timeout time.Duration) (report model.IndexStatusReport, err error)
+	// AwaitRelationIndexStatus waits for a vertex-centric index to reach a status.
+	AwaitRelationIndexStatus(name, relationType string, status schemastatus.SchemaStatus, timeout time.Duration) (report model.IndexStatusReport, err error)
+	// AddConnection allows an edge label to connect two vertex labels.
+	AddConnection(edgeLabel, outVertexLabel, inVertexLabel string) (err error)
+	// AddProperties allows a vertex label to have the property keys.
+	AddProperties(vertexLabel string, keys ...string) (err error)
+	// AddEdgeProperties allows an edge label to have the property keys.
+	AddEdgeProperties(edgeLabel string, keys ...string) (err error)
+	// SetTTL sets how long elements with a schema element live.
+	SetTTL(element graph.String, ttl time.Duration) (err error)
+	// SetConsistency sets the consistency of a schema element or index.
+	SetConsistency(element graph.String, modifier consistency.ConsistencyModifier) (err error)
 }
 
 // GetVertexQuerier are functions specifically related to getting vertices.
@@ -152,6 +234,59 @@ type ExecuteQuerier interface {
 	ExecuteBoundStringQuery(stringQuery string, bindings map[string]string, rebindings map[string]string) (res [][]byte, err error)
 }
 
+// EdgeQuerier handles the edges on the graph.
+type EdgeQuerier interface {
+	// AddEdge adds an edge from one vertex to another with the label and properties provided.
+	AddEdge(outID, inID interface{}, label string, properties ...interface{}) (edge model.Edge, err error)
+	// EdgeByID will return a single edge based on the ID provided.
+	EdgeByID(id interface{}) (edge model.Edge, err error)
+	// EdgesByLabel will return every edge with the given label.
+	EdgesByLabel(label string) (edges []model.Edge, err error)
+	// Edges will return edges based on the label and properties.
+	Edges(label string, properties ...interface{}) (edges []model.Edge, err error)
+	// EdgesBetween will return the edges in either direction between two vertices.
+	EdgesBetween(a, b interface{}, labels ...string) (edges []model.Edge, err error)
+	// EdgeCount will return the number of edges on the graph.
+	EdgeCount() (count int64, err error)
+	// SetEdgeProperty will either add or set the property of an edge.
+	SetEdgeProperty(id interface{}, keyAndVals ...interface{}) error
+	// DropEdgeByID drops edges based on their IDs.
+	DropEdgeByID(ids ...interface{}) error
+	// DropEdgeLabel drops all edges with given label.
+	DropEdgeLabel(label string) error
+}
+
+// UpsertQuerier gets or creates elements on the graph.
+type UpsertQuerier interface {
+	// UpsertVertex gets or creates a vertex and returns whether it was created.
+	UpsertVertex(label string, match, set map[string]interface{}) (vertex model.Vertex, created bool, err error)
+	// UpsertEdge gets or creates an edge and returns whether it was created.
+	UpsertEdge(outID, inID interface{}, label string, match, set map[string]interface{}) (edge model.Edge, created bool, err error)
+}
+
+// BatchQuerier sends many changes to the graph in few requests.
+type BatchQuerier interface {
+	// ExecuteBatch executes the operations of the batch in chunks.
+	ExecuteBatch(batch *Batch) (report BatchReport, err error)
+}
+
+// PaginateQuerier reads large results a page at a time.
+type PaginateQuerier interface {
+	// Paginate returns a paginator over the results of the query.
+	Paginate(query traversal.String, options ...PageOption) *Paginator
+}
+
+// NamedQuerier handles query templates that are
+// prepared once and executed with parameters.
+type NamedQuerier interface {
+	// Prepare registers the template with placeholders under the name.
+	Prepare(name string, template query.Query) error
+	// ExecNamed executes the named query with the parameters by name.
+	ExecNamed(name string, params map[string]interface{}) ([][]byte, error)
+	// NamedQueries returns the prepared queries with their metrics.
+	NamedQueries() []NamedQuery
+}
+
 // VertexQuerier handles the vertices on the graph.
 type VertexQuerier interface {
 	DropQuerier
@@ -164,6 +299,11 @@ type VertexQuerier interface {
 type GraphManager interface {
 	MiscQuerier
 	VertexQuerier
+	EdgeQuerier
+	UpsertQuerier
+	BatchQuerier
+	PaginateQuerier
+	NamedQuerier
 	ExecuteQuerier
 	SchemaQuerier
 
@@ -179,6 +319,16 @@ type GraphManager interface {
 	DropQuerier() DropQuerier
 	// Returns the interface and functions associated with the VertexQuerier.
 	VertexQuerier() VertexQuerier
+	// Returns the interface and functions associated with the EdgeQuerier.
+	EdgeQuerier() EdgeQuerier
+	// Returns the interface and functions associated with the UpsertQuerier.
+	UpsertQuerier() UpsertQuerier
+	// Returns the interface and functions associated with the BatchQuerier.
+	BatchQuerier() BatchQuerier
+	// Returns the interface and functions associated with the PaginateQuerier.
+	PaginateQuerier() PaginateQuerier
+	// Returns the interface and functions associated with the NamedQuerier.
+	NamedQuerier() NamedQuerier
 	// Returns the interface and functions associated with the ExecuteQuerier.
 	ExecuteQuerier() ExecuteQuerier
 	// Returns the interface and functions associated with the SchemaQuerier.
@@ -186,4 +336,12 @@ type GraphManager interface {
 
 	// Sets the logging object used by the GraphManager.
 	SetLogger(logging.Logger)
+	// Sets the TinkerPop version of the server, such as "3.6.2".
+	SetTinkerPopVersion(version string)
+	// Sets whether string values are kept in the scripts
+	// instead of being sent as bindings.
+	SetInlineValues(inline bool)
+	// Sets the dialect of the graph database, such as
+	// dialect.Neptune, that the queries are checked against.
+	SetDialect(d *dialect.Dialect)
 }
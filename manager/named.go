@@ -0,0 +1,226 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package manager
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/logging"
+	"github.com/northwesternmutual/grammes/query"
+	"github.com/northwesternmutual/grammes/query/datatype"
+	"github.com/northwesternmutual/grammes/query/traversal"
+)
+
+// NamedQuery is a query template registered with
+// Prepare, along with the metrics of its executions.
+type NamedQuery struct {
+	Name string
+	// Script is the template as it's sent to the server.
+	Script string
+	// Parameters are the types of the placeholders by name.
+	Parameters map[string]datatype.DataType
+	// Executions counts the times the query was sent,
+	// and Failures the times it returned an error.
+	Executions int
+	Failures   int
+	// TotalTime is the time spent in all of the executions.
+	TotalTime    time.Duration
+	LastExecuted time.Time
+}
+
+type namedQueryManager struct {
+	logger            logging.Logger
+	executeBoundQuery boundExecutor
+
+	mu      sync.Mutex
+	queries map[string]*namedQuery
+}
+
+// namedQuery is a registered template with its placeholders.
+type namedQuery struct {
+	NamedQuery
+	template     query.Query
+	placeholders []query.Placeholder
+}
+
+func newNamedQueryManager(logger logging.Logger, executor boundExecutor) *namedQueryManager {
+	return &namedQueryManager{
+		logger:            logger,
+		executeBoundQuery: executor,
+		queries:           make(map[string]*namedQuery),
+	}
+}
+
+// Prepare registers the template under the name. The template holds
+// query.Placeholder values, which are the parameters given to
+// ExecNamed. Preparing a name again replaces its template and metrics.
+func (m *namedQueryManager) Prepare(name string, template query.Query) error {
+	script := template.String()
+	placeholders, err := placeholdersOf(template)
+
+	switch v, ok := template.(query.Validator); {
+	case ok && v.Err() != nil:
+		err = v.Err()
+	case name == "":
+		err = fmt.Errorf("empty name: %w", gremerror.ErrInvalidParameter)
+	}
+	if err != nil {
+		m.logger.Error("invalid named query",
+			gremerror.NewQueryError("Prepare", script, err),
+		)
+		return err
+	}
+
+	q := &namedQuery{
+		NamedQuery: NamedQuery{
+			Name:       name,
+			Script:     script,
+			Parameters: make(map[string]datatype.DataType, len(placeholders)),
+		},
+		template:     template,
+		placeholders: placeholders,
+	}
+	for _, p := range placeholders {
+		q.Parameters[p.Name] = p.Type
+	}
+
+	m.mu.Lock()
+	m.queries[name] = q
+	m.mu.Unlock()
+
+	return nil
+}
+
+// placeholdersOf returns the placeholders given to the steps of
+// a traversal, or the placeholders written in any other script.
+func placeholdersOf(template query.Query) ([]query.Placeholder, error) {
+	if t, ok := template.(traversal.String); ok {
+		return t.Placeholders()
+	}
+	return query.Placeholders(template.String())
+}
+
+// ExecNamed executes the query prepared with the name. The
+// parameters are checked against the types of the placeholders
+// and sent as bindings, so the script is the same every time.
+func (m *namedQueryManager) ExecNamed(name string, params map[string]interface{}) ([][]byte, error) {
+	m.mu.Lock()
+	q, ok := m.queries[name]
+	m.mu.Unlock()
+
+	if !ok {
+		err := fmt.Errorf("%s: %w", name, gremerror.ErrNotPrepared)
+		m.logger.Error("named query", gremerror.NewGrammesError("ExecNamed", err))
+		return nil, err
+	}
+
+	bindings, err := bind(q.placeholders, params)
+	if err != nil {
+		m.logger.Error("invalid parameters",
+			gremerror.NewQueryError("ExecNamed", q.Script, err),
+		)
+		return nil, err
+	}
+
+	start := time.Now()
+	res, err := m.executeBoundQuery(q.template, bindings, map[string]string{})
+	elapsed := time.Since(start)
+
+	m.mu.Lock()
+	q.Executions++
+	if err != nil {
+		q.Failures++
+	}
+	q.TotalTime += elapsed
+	q.LastExecuted = start
+	executions, failures := q.Executions, q.Failures
+	m.mu.Unlock()
+
+	m.logger.Debug("named query", map[string]interface{}{
+		"name":       name,
+		"duration":   elapsed.String(),
+		"executions": executions,
+		"failures":   failures,
+	})
+
+	return res, err
+}
+
+// bind returns the bindings of the parameters, which
+// must be given for every placeholder and nothing else.
+func bind(placeholders []query.Placeholder, params map[string]interface{}) (map[string]string, error) {
+	for name := range params {
+		if !hasPlaceholder(placeholders, name) {
+			return nil, fmt.Errorf("%s is unknown: %w", name, gremerror.ErrInvalidParameter)
+		}
+	}
+
+	bindings := make(map[string]string, len(placeholders))
+	for _, p := range placeholders {
+		v, ok := params[p.Name]
+		if !ok {
+			return nil, fmt.Errorf("%s is missing: %w", p.Name, gremerror.ErrInvalidParameter)
+		}
+
+		b, err := p.Bind(v)
+		if err != nil {
+			return nil, err
+		}
+		bindings[p.Name] = b
+	}
+
+	return bindings, nil
+}
+
+func hasPlaceholder(placeholders []query.Placeholder, name string) bool {
+	for _, p := range placeholders {
+		if p.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// NamedQueries returns the prepared queries
+// and their metrics in the order of their names.
+func (m *namedQueryManager) NamedQueries() []NamedQuery {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	res := make([]NamedQuery, 0, len(m.queries))
+	for _, q := range m.queries {
+		n := q.NamedQuery
+		n.Parameters = make(map[string]datatype.DataType, len(q.Parameters))
+		for k, v := range q.Parameters {
+			n.Parameters[k] = v
+		}
+		res = append(res, n)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Name < res[j].Name
+	})
+	return res
+}
@@ -0,0 +1,163 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package manager
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/logging"
+	"github.com/northwesternmutual/grammes/query"
+	"github.com/northwesternmutual/grammes/query/datatype"
+	"github.com/northwesternmutual/grammes/query/predicate"
+	"github.com/northwesternmutual/grammes/query/traversal"
+)
+
+// boundRequest is a query given to the bound executor.
+type boundRequest struct {
+	script   string
+	bindings map[string]string
+}
+
+func boundRecorder(requests *[]boundRequest, err error) boundExecutor {
+	return func(q query.Query, bindings, _ map[string]string) ([][]byte, error) {
+		*requests = append(*requests, boundRequest{q.String(), bindings})
+		return nil, err
+	}
+}
+
+func TestNamedQuery(t *testing.T) {
+	Convey("Given a prepared named query", t, func() {
+		var requests []boundRequest
+		nm := newNamedQueryManager(logging.NewNilLogger(), boundRecorder(&requests, nil))
+		template := traversal.NewTraversal().V().
+			Has("person", "age", predicate.GreaterThan(query.Placeholder{Name: "age", Type: datatype.Integer})).
+			Has("name", query.Placeholder{Name: "name", Type: datatype.String})
+		So(nm.Prepare("adults", template), ShouldBeNil)
+
+		Convey("When ExecNamed is called twice", func() {
+			_, err1 := nm.ExecNamed("adults", map[string]interface{}{"age": 18, "name": "a"})
+			_, err2 := nm.ExecNamed("adults", map[string]interface{}{"age": 21, "name": "b"})
+			Convey("Then the same script should be sent with the parameters as bindings", func() {
+				So(err1, ShouldBeNil)
+				So(err2, ShouldBeNil)
+				So(requests, ShouldResemble, []boundRequest{
+					{`g.V().has("person","age",gt(Integer.valueOf(age))).has("name",String.valueOf(name))`, map[string]string{"age": "18", "name": "a"}},
+					{`g.V().has("person","age",gt(Integer.valueOf(age))).has("name",String.valueOf(name))`, map[string]string{"age": "21", "name": "b"}},
+				})
+			})
+			Convey("Then the metrics should count the executions", func() {
+				queries := nm.NamedQueries()
+				So(queries, ShouldHaveLength, 1)
+				So(queries[0].Name, ShouldEqual, "adults")
+				So(queries[0].Executions, ShouldEqual, 2)
+				So(queries[0].Failures, ShouldEqual, 0)
+				So(queries[0].LastExecuted.IsZero(), ShouldBeFalse)
+				So(queries[0].Parameters, ShouldResemble, map[string]datatype.DataType{
+					"age":  datatype.Integer,
+					"name": datatype.String,
+				})
+			})
+		})
+
+		Convey("When a parameter is missing, unknown or of another type", func() {
+			_, err1 := nm.ExecNamed("adults", map[string]interface{}{"age": 18})
+			_, err2 := nm.ExecNamed("adults", map[string]interface{}{"age": 18, "name": "a", "x": 1})
+			_, err3 := nm.ExecNamed("adults", map[string]interface{}{"age": "18", "name": "a"})
+			Convey("Then the query should not be sent", func() {
+				So(errors.Is(err1, gremerror.ErrInvalidParameter), ShouldBeTrue)
+				So(errors.Is(err2, gremerror.ErrInvalidParameter), ShouldBeTrue)
+				So(errors.Is(err3, gremerror.ErrInvalidParameter), ShouldBeTrue)
+				So(requests, ShouldBeEmpty)
+			})
+		})
+
+		Convey("When a name that wasn't prepared is executed", func() {
+			_, err := nm.ExecNamed("children", nil)
+			Convey("Then ErrNotPrepared should be returned", func() {
+				So(errors.Is(err, gremerror.ErrNotPrepared), ShouldBeTrue)
+			})
+		})
+	})
+}
+
+func TestNamedQueryFailures(t *testing.T) {
+	Convey("Given a named query whose executions fail", t, func() {
+		var requests []boundRequest
+		nm := newNamedQueryManager(logging.NewNilLogger(), boundRecorder(&requests, errors.New("ERROR")))
+		So(nm.Prepare("all", traversal.NewTraversal().V()), ShouldBeNil)
+		Convey("When ExecNamed is called", func() {
+			_, err := nm.ExecNamed("all", nil)
+			Convey("Then the failure should be counted", func() {
+				So(err, ShouldNotBeNil)
+				So(nm.NamedQueries()[0].Failures, ShouldEqual, 1)
+			})
+		})
+	})
+}
+
+func TestNamedQueryPrepare(t *testing.T) {
+	Convey("Given a named query manager", t, func() {
+		nm := newNamedQueryManager(logging.NewNilLogger(), boundRecorder(new([]boundRequest), nil))
+		Convey("When a template is prepared without a name", func() {
+			err := nm.Prepare("", traversal.NewTraversal().V())
+			Convey("Then an error should be returned", func() {
+				So(errors.Is(err, gremerror.ErrInvalidParameter), ShouldBeTrue)
+				So(nm.NamedQueries(), ShouldBeEmpty)
+			})
+		})
+		Convey("When a template has a placeholder with a reserved name", func() {
+			err := nm.Prepare("g", traversal.NewTraversal().V().Has("name", query.Placeholder{Name: "graph", Type: datatype.String}))
+			Convey("Then an error should be returned", func() {
+				So(errors.Is(err, gremerror.ErrInvalidParameter), ShouldBeTrue)
+				So(nm.NamedQueries(), ShouldBeEmpty)
+			})
+		})
+		Convey("When a template has a string value that looks like a placeholder", func() {
+			err := nm.Prepare("notes", traversal.NewTraversal().V().
+				Has("note", "Long.valueOf(x)").
+				Has("name", query.Placeholder{Name: "name", Type: datatype.String}))
+			Convey("Then only the placeholder should be a parameter", func() {
+				So(err, ShouldBeNil)
+				queries := nm.NamedQueries()
+				So(queries, ShouldHaveLength, 1)
+				So(queries[0].Parameters, ShouldResemble, map[string]datatype.DataType{"name": datatype.String})
+			})
+		})
+		Convey("When a template with a build error is prepared", func() {
+			err := nm.Prepare("bad", traversal.NewTraversal().V().DateDiff("x"))
+			Convey("Then the build error should be returned", func() {
+				So(errors.Is(err, gremerror.ErrArgumentType), ShouldBeTrue)
+			})
+		})
+		Convey("When a name is prepared again", func() {
+			So(nm.Prepare("q", traversal.NewTraversal().V()), ShouldBeNil)
+			So(nm.Prepare("q", traversal.NewTraversal().E()), ShouldBeNil)
+			Convey("Then the template should be replaced", func() {
+				So(nm.NamedQueries(), ShouldHaveLength, 1)
+				So(nm.NamedQueries()[0].Script, ShouldEqual, "g.E()")
+			})
+		})
+	})
+}
@@ -0,0 +1,241 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/logging"
+	"github.com/northwesternmutual/grammes/model"
+	"github.com/northwesternmutual/grammes/query/traversal"
+)
+
+// defaultPageSize is how many elements are in a page by default.
+const defaultPageSize = 100
+
+type paginateQueryManager struct {
+	logger       logging.Logger
+	executeQuery queryExecutor
+}
+
+func newPaginateQueryManager(logger logging.Logger, executor queryExecutor) *paginateQueryManager {
+	return &paginateQueryManager{
+		logger:       logger,
+		executeQuery: executor,
+	}
+}
+
+// Paginate returns a paginator that reads the
+// results of the query a page at a time.
+func (m *paginateQueryManager) Paginate(query traversal.String, options ...PageOption) *Paginator {
+	p := &Paginator{
+		logger:       m.logger,
+		executeQuery: m.executeQuery,
+		query:        query,
+		pageSize:     defaultPageSize,
+	}
+
+	for _, option := range options {
+		option(p)
+	}
+
+	return p
+}
+
+// PageOption is used to change how a paginator reads its pages.
+type PageOption func(*Paginator)
+
+// WithPageSize sets how many elements are in a page.
+func WithPageSize(n int) PageOption {
+	return func(p *Paginator) {
+		p.pageSize = n
+	}
+}
+
+// WithPageKey makes the paginator order the elements by the
+// property key and start every page after the value of the
+// key the last page ended on, which keeps every page as quick
+// as the first. The values of the key should be unique, since
+// elements that share the value a page ends on are skipped,
+// and elements without the key are left out.
+func WithPageKey(key string) PageOption {
+	return func(p *Paginator) {
+		p.key = key
+	}
+}
+
+// Paginator reads the results of a query a page at a time. Without
+// a key the pages are read with range steps, which the server has
+// to count up to on every page, and the order of the results
+// should be fixed by the query.
+type Paginator struct {
+	logger       logging.Logger
+	executeQuery queryExecutor
+
+	query    traversal.String
+	pageSize int
+	key      string
+
+	// offset is where the next range starts.
+	offset int
+	// last is the value of the key the last page ended on.
+	last string
+	done bool
+}
+
+// Done returns whether every page has been read.
+func (p *Paginator) Done() bool {
+	return p.done
+}
+
+// Next returns the next page of vertices. Once
+// every page has been read the page is empty.
+func (p *Paginator) Next(ctx context.Context) ([]model.Vertex, error) {
+	var vertices []model.Vertex
+	if err := p.NextInto(ctx, &vertices); err != nil {
+		return nil, err
+	}
+	return vertices, nil
+}
+
+// NextInto unmarshals the next page into dest, which
+// should be a pointer to a slice such as *[]model.Edge.
+// Once every page has been read the slice is empty.
+func (p *Paginator) NextInto(ctx context.Context, dest interface{}) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if p.done {
+		return jsonUnmarshal([]byte("[]"), dest)
+	}
+
+	query := p.pageQuery()
+	responses, err := p.executeQuery(query)
+	if err != nil {
+		p.logger.Error("invalid query",
+			gremerror.NewQueryError("Next", query.String(), err),
+		)
+		return err
+	}
+
+	items, err := pageItems(responses)
+	if err != nil {
+		p.logger.Error("unmarshal",
+			gremerror.NewGrammesError("Next", err),
+		)
+		return err
+	}
+
+	if p.key != "" && len(items) > 0 {
+		if p.last, err = keyLiteral(items[len(items)-1], p.key); err != nil {
+			return gremerror.NewGrammesError("Next", err)
+		}
+	}
+	p.offset += len(items)
+	p.done = len(items) < p.pageSize
+
+	data, err := json.Marshal(items)
+	if err != nil {
+		return gremerror.NewGrammesError("Next", err)
+	}
+	if err = jsonUnmarshal(data, dest); err != nil {
+		return gremerror.NewUnmarshalError("Next", data, err)
+	}
+
+	return nil
+}
+
+// pageQuery builds the query for the next page.
+func (p *Paginator) pageQuery() traversal.String {
+	if p.key == "" {
+		return p.query.Range(p.offset, p.offset+p.pageSize)
+	}
+
+	query := p.query
+	if p.last == "" {
+		query = query.Has(p.key)
+	} else {
+		query = query.Has(p.key, traversal.Custom("gt("+p.last+")"))
+	}
+
+	return query.Order().By(p.key).Limit(p.pageSize)
+}
+
+// pageItems returns the elements in the responses
+// without unmarshalling the elements themselves.
+func pageItems(responses [][]byte) ([]json.RawMessage, error) {
+	items := []json.RawMessage{}
+	for _, res := range responses {
+		var list model.List
+		if err := jsonUnmarshal(res, &list); err == nil && list.Type != "" {
+			res, _ = json.Marshal(list.Value)
+		}
+
+		var part []json.RawMessage
+		if err := jsonUnmarshal(res, &part); err != nil {
+			return nil, gremerror.NewUnmarshalError("pageItems", res, err)
+		}
+		items = append(items, part...)
+	}
+	return items, nil
+}
+
+// keyLiteral renders the value of the key on a vertex or edge
+// so that it can be compared with the values of the next page.
+func keyLiteral(raw json.RawMessage, key string) (string, error) {
+	var w model.ValueWrapper
+	found := false
+
+	var vertex model.Vertex
+	if err := jsonUnmarshal(raw, &vertex); err == nil && len(vertex.Value.Properties[key]) > 0 {
+		w, found = vertex.Value.Properties[key][0].Value.Value, true
+	} else {
+		var edge model.Edge
+		if err = jsonUnmarshal(raw, &edge); err == nil {
+			var p model.EdgePropertyDetails
+			p, found = edge.Value.Properties[key]
+			w = p.Value.Value
+		}
+	}
+	if !found {
+		return "", gremerror.NewPropertyError("keyLiteral", key, gremerror.ErrPropertyNotFound)
+	}
+
+	switch w.Type {
+	case "g:Date", "g:Timestamp":
+		if t, err := w.AsTime(); err == nil {
+			return "new Date(" + strconv.FormatInt(t.UnixNano()/1e6, 10) + "L)", nil
+		}
+	}
+	if s, err := w.AsString(); err == nil {
+		return fmtValue(s), nil
+	}
+	if i, err := w.AsInt64(); err == nil {
+		return strconv.FormatInt(i, 10), nil
+	}
+	if f, err := w.AsFloat64(); err == nil {
+		return strconv.FormatFloat(f, 'g', -1, 64), nil
+	}
+	return fmtValue(w.Value), nil
+}
@@ -0,0 +1,177 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package manager
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/logging"
+	"github.com/northwesternmutual/grammes/model"
+	"github.com/northwesternmutual/grammes/query"
+	"github.com/northwesternmutual/grammes/query/traversal"
+)
+
+func pageVertex(id, name string) string {
+	return `{"@type":"g:Vertex","@value":{"id":{"@type":"g:Int64","@value":` + id + `},"label":"person","properties":{` +
+		`"name":[{"@type":"g:VertexProperty","@value":{"id":{"@type":"g:Int64","@value":0},"value":"` + name + `","label":"name"}}],` +
+		`"age":[{"@type":"g:VertexProperty","@value":{"id":{"@type":"g:Int64","@value":1},"value":{"@type":"g:Int32","@value":` + id + `},"label":"age"}}]}}}`
+}
+
+// pageExecutor answers every query with the next of the pages.
+func pageExecutor(queries *[]string, pages ...[]string) queryExecutor {
+	return func(q query.Query) ([][]byte, error) {
+		*queries = append(*queries, q.String())
+		if len(*queries) > len(pages) {
+			return nil, errors.New("ERROR")
+		}
+		page := pages[len(*queries)-1]
+		return [][]byte{[]byte(`{"@type":"g:List","@value":[` + strings.Join(page, ",") + `]}`)}, nil
+	}
+}
+
+func TestPaginateRange(t *testing.T) {
+	Convey("Given a paginator without a key", t, func() {
+		var queries []string
+		pm := newPaginateQueryManager(logging.NewNilLogger(), pageExecutor(&queries,
+			[]string{pageVertex("1", "a"), pageVertex("2", "b")},
+			[]string{pageVertex("3", "c")},
+		))
+		p := pm.Paginate(traversal.NewTraversal().V().HasLabel("person"), WithPageSize(2))
+		Convey("When every page is read", func() {
+			first, err1 := p.Next(context.Background())
+			second, err2 := p.Next(context.Background())
+			third, err3 := p.Next(context.Background())
+			Convey("Then the pages should be read with range steps", func() {
+				So(err1, ShouldBeNil)
+				So(err2, ShouldBeNil)
+				So(err3, ShouldBeNil)
+				So(queries, ShouldResemble, []string{
+					`g.V().hasLabel("person").range(0,2)`,
+					`g.V().hasLabel("person").range(2,4)`,
+				})
+				So(first, ShouldHaveLength, 2)
+				So(second, ShouldHaveLength, 1)
+				So(third, ShouldBeEmpty)
+				So(p.Done(), ShouldBeTrue)
+			})
+		})
+	})
+}
+
+func TestPaginateKey(t *testing.T) {
+	Convey("Given a paginator with a string key", t, func() {
+		var queries []string
+		pm := newPaginateQueryManager(logging.NewNilLogger(), pageExecutor(&queries,
+			[]string{pageVertex("1", "a"), pageVertex("2", `b\"`)},
+			[]string{},
+		))
+		p := pm.Paginate(traversal.NewTraversal().V(), WithPageSize(2), WithPageKey("name"))
+		Convey("When every page is read", func() {
+			first, _ := p.Next(context.Background())
+			second, err := p.Next(context.Background())
+			Convey("Then the next page should start after the last key", func() {
+				So(err, ShouldBeNil)
+				So(queries, ShouldResemble, []string{
+					`g.V().has("name").order().by("name").limit(2)`,
+					`g.V().has("name",gt("b\"")).order().by("name").limit(2)`,
+				})
+				So(first[1].Value.Properties["name"][0].GetValue(), ShouldEqual, `b"`)
+				So(second, ShouldBeEmpty)
+				So(p.Done(), ShouldBeTrue)
+			})
+		})
+	})
+	Convey("Given a paginator with a number key", t, func() {
+		var queries []string
+		pm := newPaginateQueryManager(logging.NewNilLogger(), pageExecutor(&queries,
+			[]string{pageVertex("1", "a")},
+			[]string{},
+		))
+		p := pm.Paginate(traversal.NewTraversal().V(), WithPageSize(1), WithPageKey("age"))
+		Convey("When two pages are read", func() {
+			p.Next(context.Background())
+			p.Next(context.Background())
+			Convey("Then the number should be compared", func() {
+				So(queries[1], ShouldEqual, `g.V().has("age",gt(1)).order().by("age").limit(1)`)
+			})
+		})
+	})
+	Convey("Given a paginator with a key the elements don't have", t, func() {
+		var queries []string
+		pm := newPaginateQueryManager(logging.NewNilLogger(), pageExecutor(&queries, []string{pageVertex("1", "a")}))
+		p := pm.Paginate(traversal.NewTraversal().V(), WithPageSize(1), WithPageKey("city"))
+		Convey("When a page is read", func() {
+			_, err := p.Next(context.Background())
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestPaginateNextInto(t *testing.T) {
+	Convey("Given a paginator over edges", t, func() {
+		var queries []string
+		edge := `{"@type":"g:Edge","@value":{"id":7,"label":"knows","inV":2,"outV":1,` +
+			`"properties":{"since":{"@type":"g:Property","@value":{"key":"since","value":{"@type":"g:Date","@value":1000}}}}}}`
+		pm := newPaginateQueryManager(logging.NewNilLogger(), pageExecutor(&queries, []string{edge}, []string{}))
+		p := pm.Paginate(traversal.NewTraversal().E(), WithPageSize(1), WithPageKey("since"))
+		Convey("When the pages are read into edges", func() {
+			var edges []model.Edge
+			err := p.NextInto(context.Background(), &edges)
+			So(err, ShouldBeNil)
+			p.NextInto(context.Background(), &edges)
+			Convey("Then the edges should be unmarshalled and the dates compared", func() {
+				So(queries[1], ShouldEqual, `g.E().has("since",gt(new Date(1000L))).order().by("since").limit(1)`)
+				So(edges, ShouldBeEmpty)
+			})
+		})
+	})
+}
+
+func TestPaginateErrors(t *testing.T) {
+	Convey("Given a paginator", t, func() {
+		var queries []string
+		pm := newPaginateQueryManager(logging.NewNilLogger(), pageExecutor(&queries))
+		p := pm.Paginate(traversal.NewTraversal().V())
+		Convey("When the query fails", func() {
+			_, err := p.Next(context.Background())
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(p.Done(), ShouldBeFalse)
+			})
+		})
+		Convey("When the context is done", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			_, err := p.Next(ctx)
+			Convey("Then the context error should be returned", func() {
+				So(err, ShouldEqual, context.Canceled)
+				So(queries, ShouldBeEmpty)
+			})
+		})
+	})
+}
@@ -21,10 +21,15 @@
 package manager
 
 import (
+	"fmt"
+
+	"github.com/northwesternmutual/grammes/dialect"
 	"github.com/northwesternmutual/grammes/gremconnect"
 	"github.com/northwesternmutual/grammes/gremerror"
 	"github.com/northwesternmutual/grammes/logging"
 	"github.com/northwesternmutual/grammes/query"
+	"github.com/northwesternmutual/grammes/query/graph"
+	"github.com/northwesternmutual/grammes/query/traversal"
 )
 
 // Query handles the querying actions to the server.
@@ -32,6 +37,14 @@ type queryManager struct {
 	dialer         gremconnect.Dialer
 	logger         logging.Logger
 	executeRequest executor
+	// inline keeps the string values in the script
+	// instead of moving them into bindings.
+	inline bool
+	// dialect checks the queries before they're sent.
+	dialect *dialect.Dialect
+	// version is the TinkerPop version of the server. Traversals
+	// with newer steps are logged before they're sent.
+	version string
 }
 
 // NewQueryManager returns a new Query Manager that
@@ -52,11 +65,13 @@ func (m *queryManager) setLogger(newLogger logging.Logger) {
 // request to the gremlin server after turning it
 // into a string.
 func (m *queryManager) ExecuteQuery(query query.Query) ([][]byte, error) {
-	return m.ExecuteBoundStringQuery(query.String(), map[string]string{}, map[string]string{})
+	return m.ExecuteBoundQuery(query, map[string]string{}, map[string]string{})
 }
 
 // ExecuteStringQuery takes a string query and
 // uses it to make a request to the gremlin server.
+// The script is sent as it is. Use query.Parameterize
+// to move its values into bindings.
 func (m *queryManager) ExecuteStringQuery(query string) ([][]byte, error) {
 	return m.ExecuteBoundStringQuery(query, map[string]string{}, map[string]string{})
 }
@@ -66,12 +81,100 @@ func (m *queryManager) ExecuteStringQuery(query string) ([][]byte, error) {
 
 // ExecuteBoundQuery takes a query object and bindings to allow
 // for simplified queries to the gremlin server.
-func (m *queryManager) ExecuteBoundQuery(query query.Query, bindings, rebindings map[string]string) ([][]byte, error) {
-	return m.ExecuteBoundStringQuery(query.String(), bindings, rebindings)
+// Queries that were built with errors or that the dialect
+// of the database doesn't support aren't sent.
+func (m *queryManager) ExecuteBoundQuery(q query.Query, bindings, rebindings map[string]string) ([][]byte, error) {
+	q, err := m.check(q)
+	if err != nil {
+		m.logger.Error("invalid query",
+			gremerror.NewQueryError("ExecuteBoundQuery", q.String(), err),
+		)
+		return nil, err
+	}
+
+	t, ok := q.(traversal.String)
+	if !ok {
+		return m.ExecuteBoundStringQuery(q.String(), bindings, rebindings)
+	}
+
+	m.checkVersion(t)
+	if m.inline {
+		return m.ExecuteBoundStringQuery(t.String(), bindings, rebindings)
+	}
+
+	script, bound, err := bindTraversal(t, bindings)
+	if err != nil {
+		m.logger.Error("invalid query",
+			gremerror.NewQueryError("ExecuteBoundQuery", t.String(), err),
+		)
+		return nil, err
+	}
+
+	return m.ExecuteBoundStringQuery(script, bound, rebindings)
+}
+
+// check returns the query rewritten for the dialect of the
+// database, or the error that keeps it from being sent.
+func (m *queryManager) check(q query.Query) (query.Query, error) {
+	if v, ok := q.(query.Validator); ok && v.Err() != nil {
+		return q, v.Err()
+	}
+
+	switch t := q.(type) {
+	case traversal.String:
+		return m.dialect.Traversal(t)
+	case graph.String:
+		return q, m.dialect.Graph(t)
+	}
+
+	return q, nil
+}
+
+// checkVersion logs the traversals that use a step
+// newer than the TinkerPop version of the server.
+func (m *queryManager) checkVersion(t traversal.String) {
+	if m.version == "" {
+		return
+	}
+
+	step, version := t.MinimumVersion()
+	if traversal.CompareVersions(version, m.version) > 0 {
+		m.logger.Error("step needs a newer server",
+			gremerror.NewQueryError("ExecuteBoundQuery", t.String(),
+				fmt.Errorf("%s needs TinkerPop %s: %w", step, version, gremerror.ErrStepVersion)),
+		)
+	}
+}
+
+// bindTraversal moves the string values of the traversal into
+// bindings and adds the given ones, which can't use their names.
+func bindTraversal(t traversal.String, bindings map[string]string) (string, map[string]string, error) {
+	script, bound := t.Bind()
+	for k, v := range bindings {
+		if _, taken := bound[k]; taken {
+			return "", nil, fmt.Errorf("%s is bound by the traversal: %w", k, gremerror.ErrInvalidParameter)
+		}
+		bound[k] = v
+	}
+	return script, bound, nil
+}
+
+// executeManagementQuery checks that the database has the
+// management system used by the script before sending it.
+func (m *queryManager) executeManagementQuery(script string) ([][]byte, error) {
+	if err := m.dialect.Script(script); err != nil {
+		m.logger.Error("invalid query",
+			gremerror.NewQueryError("executeManagementQuery", script, err),
+		)
+		return nil, err
+	}
+
+	return m.ExecuteStringQuery(script)
 }
 
 // ExecuteBoundStringQuery uses bindings and rebindings to allow
-// for simplified queries to the gremlin server.
+// for simplified queries to the gremlin server. The script is
+// sent as it is, without moving its values into bindings.
 func (m *queryManager) ExecuteBoundStringQuery(query string, bindings, rebindings map[string]string) ([][]byte, error) {
 	if m.dialer.IsDisposed() {
 		return nil, gremerror.ErrDisposedConnection
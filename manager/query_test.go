@@ -21,11 +21,16 @@
 package manager
 
 import (
+	"errors"
 	"testing"
 	"time"
 
+	"github.com/northwesternmutual/grammes/dialect"
 	"github.com/northwesternmutual/grammes/gremconnect"
+	"github.com/northwesternmutual/grammes/gremerror"
 	"github.com/northwesternmutual/grammes/logging"
+	"github.com/northwesternmutual/grammes/query/graph"
+	"github.com/northwesternmutual/grammes/query/traversal"
 	. "github.com/smartystreets/goconvey/convey"
 )
 
@@ -75,6 +80,170 @@ func TestExecuteQuery(t *testing.T) {
 	})
 }
 
+func TestExecuteQueryBindings(t *testing.T) {
+	Convey("Given a query manager that records the requests", t, func() {
+		dialer := gremconnect.NewWebSocketDialer("testaddress")
+		var script string
+		var bindings map[string]string
+		execute := func(s string, b map[string]string, _ map[string]string) ([][]byte, error) {
+			script, bindings = s, b
+			return nil, nil
+		}
+		qm := newQueryManager(dialer, logging.NewNilLogger(), execute)
+		Convey("When ExecuteQuery is called with string values", func() {
+			_, err := qm.ExecuteQuery(traversal.NewTraversal().V().Has("name", "damien"))
+			Convey("Then the values should be sent as bindings", func() {
+				So(err, ShouldBeNil)
+				So(script, ShouldEqual, "g.V().has(_p0,_p1)")
+				So(bindings, ShouldResemble, map[string]string{"_p0": "name", "_p1": "damien"})
+			})
+		})
+		Convey("When ExecuteStringQuery is called with string values", func() {
+			_, err := qm.ExecuteStringQuery(`g.V().has("name",/x"/)`)
+			Convey("Then the script should be sent as it is", func() {
+				So(err, ShouldBeNil)
+				So(script, ShouldEqual, `g.V().has("name",/x"/)`)
+				So(bindings, ShouldBeEmpty)
+			})
+		})
+		Convey("When ExecuteBoundQuery is called with bindings of its own", func() {
+			g := traversal.NewTraversal().V(traversal.Custom("x")).Has("name")
+			_, err := qm.ExecuteBoundQuery(g, map[string]string{"x": "1"}, nil)
+			Convey("Then the bindings should be merged", func() {
+				So(err, ShouldBeNil)
+				So(script, ShouldEqual, "g.V(x).has(_p0)")
+				So(bindings, ShouldResemble, map[string]string{"x": "1", "_p0": "name"})
+			})
+		})
+		Convey("When ExecuteBoundQuery is called with a binding the traversal uses", func() {
+			script = ""
+			g := traversal.NewTraversal().V().Has("name")
+			_, err := qm.ExecuteBoundQuery(g, map[string]string{"_p0": "1"}, nil)
+			Convey("Then the query should not be sent", func() {
+				So(errors.Is(err, gremerror.ErrInvalidParameter), ShouldBeTrue)
+				So(script, ShouldBeEmpty)
+			})
+		})
+		Convey("When the values are kept inline", func() {
+			qm.inline = true
+			_, err := qm.ExecuteQuery(traversal.NewTraversal().V().Has("name", "damien"))
+			Convey("Then the values should stay in the script", func() {
+				So(err, ShouldBeNil)
+				So(script, ShouldEqual, `g.V().has("name","damien")`)
+				So(bindings, ShouldBeEmpty)
+			})
+		})
+	})
+}
+
+func TestExecuteQueryBuildError(t *testing.T) {
+	Convey("Given a query manager that records the requests", t, func() {
+		dialer := gremconnect.NewWebSocketDialer("testaddress")
+		var sent bool
+		execute := func(string, map[string]string, map[string]string) ([][]byte, error) {
+			sent = true
+			return nil, nil
+		}
+		qm := newQueryManager(dialer, logging.NewNilLogger(), execute)
+		Convey("When ExecuteQuery is called with a traversal built with errors", func() {
+			_, err := qm.ExecuteQuery(traversal.NewTraversal().V().Has("a", "b", "c", "d"))
+			Convey("Then the build error should be returned", func() {
+				var buildErr *gremerror.BuildError
+				So(errors.As(err, &buildErr), ShouldBeTrue)
+				So(buildErr.Step(), ShouldEqual, "has")
+				So(buildErr.Argument(), ShouldEqual, "params")
+				So(errors.Is(err, gremerror.ErrArgumentCount), ShouldBeTrue)
+			})
+			Convey("Then the traversal should not be sent", func() {
+				So(sent, ShouldBeFalse)
+			})
+		})
+	})
+}
+
+func TestExecuteQueryDialect(t *testing.T) {
+	Convey("Given a query manager with a dialect that records the requests", t, func() {
+		dialer := gremconnect.NewWebSocketDialer("testaddress")
+		var script string
+		execute := func(s string, _ map[string]string, _ map[string]string) ([][]byte, error) {
+			script = s
+			return nil, nil
+		}
+		qm := newQueryManager(dialer, logging.NewNilLogger(), execute)
+		qm.dialect = dialect.CosmosDB
+		qm.inline = true
+		Convey("When ExecuteQuery is called with a traversal the dialect supports", func() {
+			_, err := qm.ExecuteQuery(traversal.NewTraversal().V(1234))
+			Convey("Then it should be rewritten for the dialect", func() {
+				So(err, ShouldBeNil)
+				So(script, ShouldEqual, `g.V("1234")`)
+			})
+		})
+		Convey("When ExecuteQuery is called with a step the dialect doesn't have", func() {
+			_, err := qm.ExecuteQuery(traversal.NewTraversal().V().Match(traversal.NewTraversal().Out()))
+			Convey("Then a dialect error should be returned", func() {
+				var dialectErr *gremerror.DialectError
+				So(errors.As(err, &dialectErr), ShouldBeTrue)
+				So(dialectErr.Step(), ShouldEqual, "match")
+				So(script, ShouldBeEmpty)
+			})
+		})
+		Convey("When ExecuteQuery is called with a management query", func() {
+			_, err := qm.ExecuteQuery(graph.NewGraph().OpenManagement())
+			Convey("Then a dialect error should be returned", func() {
+				So(errors.Is(err, gremerror.ErrNotInDialect), ShouldBeTrue)
+			})
+		})
+		Convey("When a management script is executed", func() {
+			_, err := qm.executeManagementQuery("graph.openManagement().commit()")
+			Convey("Then a dialect error should be returned", func() {
+				So(errors.Is(err, gremerror.ErrNotInDialect), ShouldBeTrue)
+				So(script, ShouldBeEmpty)
+			})
+		})
+	})
+}
+
+// errorLogger records the errors that are logged.
+type errorLogger struct {
+	logging.NilLogger
+	errs []error
+}
+
+func (l *errorLogger) Error(_ string, err error) { l.errs = append(l.errs, err) }
+
+func TestExecuteQueryVersion(t *testing.T) {
+	Convey("Given a query manager for an older TinkerPop version", t, func() {
+		dialer := gremconnect.NewWebSocketDialer("testaddress")
+		var script string
+		execute := func(s string, _ map[string]string, _ map[string]string) ([][]byte, error) {
+			script = s
+			return nil, nil
+		}
+		logger := &errorLogger{}
+		qm := newQueryManager(dialer, logger, execute)
+		qm.version = "3.5.4"
+		qm.inline = true
+		Convey("When ExecuteQuery is called with a newer step", func() {
+			_, err := qm.ExecuteQuery(traversal.NewTraversal().V().Map(traversal.NewAnonymousTraversal().ElementMap()).MergeV())
+			Convey("Then a warning should be logged and the query still sent", func() {
+				So(err, ShouldBeNil)
+				So(script, ShouldEqual, "g.V().map(__.elementMap()).mergeV()")
+				So(logger.errs, ShouldHaveLength, 1)
+				So(errors.Is(logger.errs[0], gremerror.ErrStepVersion), ShouldBeTrue)
+				So(logger.errs[0].Error(), ShouldContainSubstring, "mergeV needs TinkerPop 3.6.0")
+			})
+		})
+		Convey("When ExecuteQuery is called with steps the version has", func() {
+			_, err := qm.ExecuteQuery(traversal.NewTraversal().V().ElementMap())
+			Convey("Then nothing should be logged", func() {
+				So(err, ShouldBeNil)
+				So(logger.errs, ShouldBeEmpty)
+			})
+		})
+	})
+}
+
 func TestExecuteStringQuery(t *testing.T) {
 	Convey("Given a dialer, string executor and query manager", t, func() {
 		dialer := gremconnect.NewWebSocketDialer("testaddress")
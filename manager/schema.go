@@ -21,16 +21,60 @@
 package manager
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
+	"strings"
 
 	"github.com/northwesternmutual/grammes/gremerror"
 	"github.com/northwesternmutual/grammes/logging"
+	"github.com/northwesternmutual/grammes/model"
 	"github.com/northwesternmutual/grammes/query/cardinality"
 	"github.com/northwesternmutual/grammes/query/datatype"
 	"github.com/northwesternmutual/grammes/query/graph"
 	"github.com/northwesternmutual/grammes/query/multiplicity"
+	"github.com/northwesternmutual/grammes/schema"
 )
 
+// describeSchemaScript gathers the whole schema into a map through
+// the management system. The management transaction is rolled
+// back afterwards because nothing in it has been changed. Data
+// types are named the way a script refers to their class, so
+// only the classes imported by default go by their simple name.
+var describeSchemaScript = strings.Join([]string{
+	"mgmt = " + graph.NewGraph().OpenManagement().String(),
+	"edgeLabels = mgmt.getRelationTypes(EdgeLabel.class).toList()",
+	"dataTypePackages = ['java.lang', 'java.util', 'org.janusgraph.core.attribute']",
+	"schema = [",
+	"'vertexLabels': mgmt.getVertexLabels().collect { l -> [" +
+		"'name': l.name(), 'static': l.isStatic(), 'partitioned': l.isPartitioned(), " +
+		"'properties': l.mappedProperties().collect { k -> k.name() }] },",
+	"'edgeLabels': edgeLabels.collect { l -> [" +
+		"'name': l.name(), 'multiplicity': l.multiplicity().name(), " +
+		"'directed': l.isDirected(), 'unidirected': l.isUnidirected(), " +
+		"'properties': l.mappedProperties().collect { k -> k.name() }] },",
+	"'propertyKeys': mgmt.getRelationTypes(PropertyKey.class).collect { k -> [" +
+		"'name': k.name(), 'dataType': (k.dataType().getPackage()?.getName() in dataTypePackages ? " +
+		"k.dataType().getSimpleName() : k.dataType().getName()) + '.class', " +
+		"'cardinality': k.cardinality().name().toLowerCase()] },",
+	"'indexes': [Vertex.class, Edge.class].collectMany { c -> mgmt.getGraphIndexes(c).collect { i -> [" +
+		"'name': i.name(), 'type': i.isCompositeIndex() ? 'composite' : 'mixed', " +
+		"'element': c.getSimpleName(), 'unique': i.isUnique(), 'backingIndex': i.getBackingIndex(), " +
+		"'indexOnly': mgmt.getIndexOnlyConstraint(i.name())?.name(), " +
+		"'keys': i.getFieldKeys().collect { k -> ['name': k.name(), 'status': 'SchemaStatus.' + i.getIndexStatus(k).name(), " +
+		"'mapping': i.isMixedIndex() ? i.getParametersFor(k).findResult { p -> p.key() == 'mapping' ? 'Mapping.' + p.value() : null } : null] }] } } + " +
+		"edgeLabels.collectMany { l -> mgmt.getRelationIndexes(l).collect { i -> [" +
+		"'name': i.name(), 'type': 'vertex-centric', 'element': l.name(), 'unique': false, " +
+		"'direction': i.getDirection().name(), 'order': i.getSortOrder().name(), " +
+		"'keys': i.getSortKey().collect { k -> ['name': k.name(), 'status': 'SchemaStatus.' + i.getIndexStatus().name()] }] } },",
+	"'connections': edgeLabels.collectMany { l -> l.mappedConnections().collect { c -> [" +
+		"'edgeLabel': l.name(), 'outVertexLabel': c.getOutgoingVertexLabel().name(), " +
+		"'inVertexLabel': c.getIncomingVertexLabel().name()] } }",
+	"]",
+	"mgmt.rollback()",
+	"schema",
+}, "\n")
+
 type schemaManager struct {
 	logger             logging.Logger
 	executeStringQuery stringExecutor
@@ -146,3 +190,114 @@ func (s *schemaManager) CommitSchema() ([][]byte, error) {
 
 	return data, nil
 }
+
+// DescribeSchema reads the current schema back from the graph.
+// This includes every vertex label, edge label, property key,
+// index along with its status, and connection between labels.
+func (s *schemaManager) DescribeSchema() (model.Schema, error) {
+	data, err := s.executeStringQuery(describeSchemaScript)
+	if err != nil {
+		s.logger.Error("invalid query",
+			gremerror.NewQueryError("DescribeSchema", describeSchemaScript, err),
+		)
+		return model.Schema{}, err
+	}
+
+	current, err := unmarshalSchema(data)
+	if err != nil {
+		s.logger.Error("schema unmarshal",
+			gremerror.NewGrammesError("DescribeSchema", err),
+		)
+	}
+
+	return current, err
+}
+
+// ExportSchema writes the current schema of the graph as a JSON
+// or YAML document. The document is sorted and leaves out the
+// status of indexes so exports of two graphs can be diffed.
+func (s *schemaManager) ExportSchema(w io.Writer, format schema.Format) error {
+	current, err := s.DescribeSchema()
+	if err != nil {
+		return err
+	}
+
+	if err = schema.Encode(w, current, format); err != nil {
+		s.logger.Error("schema encode",
+			gremerror.NewGrammesError("ExportSchema", err),
+		)
+		return err
+	}
+
+	return nil
+}
+
+// ImportSchema reads a document written by ExportSchema and
+// creates whatever is missing from the graph in a single
+// management transaction. Importing the same document
+// again doesn't change anything. The changes made are returned.
+func (s *schemaManager) ImportSchema(r io.Reader) (schema.Changes, error) {
+	desired, err := schema.Decode(r)
+	if err != nil {
+		s.logger.Error("schema decode",
+			gremerror.NewGrammesError("ImportSchema", err),
+		)
+		return schema.Changes{}, err
+	}
+
+	changes, err := schema.Apply(schemaQuerier{s}, schema.Migration{Schema: desired})
+	if err != nil {
+		s.logger.Error("schema apply",
+			gremerror.NewGrammesError("ImportSchema", err),
+		)
+		return changes, err
+	}
+
+	return changes, nil
+}
+
+// schemaQuerier lets the schema manager be used
+// to apply a schema with the schema package.
+type schemaQuerier struct {
+	*schemaManager
+}
+
+func (q schemaQuerier) ExecuteStringQuery(query string) ([][]byte, error) {
+	return q.executeStringQuery(query)
+}
+
+// unmarshalSchema decodes the GraphSON map returned by
+// the describe schema script into a Schema struct.
+func unmarshalSchema(data [][]byte) (model.Schema, error) {
+	var current model.Schema
+	err := unmarshalGraphSONResult("DescribeSchema", data, &current)
+	return current, err
+}
+
+// unmarshalGraphSONResult decodes the first GraphSON
+// value returned by a script into the given struct.
+func unmarshalGraphSONResult(function string, data [][]byte, v interface{}) error {
+	for _, res := range data {
+		decoded, err := model.UnmarshalGraphSON(res)
+		if err != nil {
+			return err
+		}
+
+		list, ok := decoded.([]interface{})
+		if !ok || len(list) == 0 {
+			continue
+		}
+
+		raw, err := json.Marshal(list[0])
+		if err != nil {
+			return err
+		}
+		if err = jsonUnmarshal(raw, v); err != nil {
+			return gremerror.NewUnmarshalError(function, res, err)
+		}
+
+		return nil
+	}
+
+	return gremerror.ErrEmptyResponse
+}
@@ -21,16 +21,21 @@
 package manager
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
+	"strings"
 	"testing"
 
 	. "github.com/smartystreets/goconvey/convey"
 
 	"github.com/northwesternmutual/grammes/logging"
+	"github.com/northwesternmutual/grammes/model"
 	"github.com/northwesternmutual/grammes/query/cardinality"
 	"github.com/northwesternmutual/grammes/query/datatype"
 	"github.com/northwesternmutual/grammes/query/multiplicity"
+	"github.com/northwesternmutual/grammes/query/schemastatus"
+	"github.com/northwesternmutual/grammes/schema"
 )
 
 func TestAddEdgeLabel(t *testing.T) {
@@ -223,3 +228,138 @@ func TestCommitSchemaQueryError(t *testing.T) {
 		})
 	})
 }
+
+func TestDescribeSchema(t *testing.T) {
+	Convey("Given a string executor and schema manager", t, func() {
+		var query string
+		execute := func(q string) ([][]byte, error) {
+			query = q
+			return [][]byte{[]byte(schemaResponse)}, nil
+		}
+		sm := newSchemaManager(logging.NewNilLogger(), execute)
+		Convey("When DescribeSchema is called", func() {
+			current, err := sm.DescribeSchema()
+			Convey("Then the schema should be read through the management system", func() {
+				So(err, ShouldBeNil)
+				So(query, ShouldStartWith, "mgmt = graph.openManagement()")
+				So(query, ShouldEndWith, "mgmt.rollback()\nschema")
+			})
+			Convey("Then the schema should be decoded", func() {
+				So(current.VertexLabels, ShouldResemble, []model.VertexLabelSchema{{Name: "person"}})
+				So(current.EdgeLabels[0].Multiplicity, ShouldEqual, multiplicity.Multi)
+				So(current.EdgeLabels[0].Directed, ShouldBeTrue)
+				So(current.PropertyKeys[0].DataType, ShouldEqual, datatype.String)
+				So(current.PropertyKeys[0].Cardinality, ShouldEqual, cardinality.Single)
+				So(current.PropertyKeys[1].DataType, ShouldEqual, datatype.UUID)
+				So(current.PropertyKeys[2].DataType, ShouldEqual, datatype.Instant)
+				So(current.Indexes[0].Type, ShouldEqual, model.CompositeIndex)
+				So(current.Indexes[0].Unique, ShouldBeTrue)
+				So(current.Indexes[0].IndexOnly, ShouldEqual, "person")
+				So(current.Indexes[0].Status(), ShouldEqual, schemastatus.Enabled)
+				So(current.HasConnection("knows", "person", "person"), ShouldBeTrue)
+			})
+		})
+	})
+}
+
+func TestDescribeSchemaQueryError(t *testing.T) {
+	Convey("Given a string executor and schema manager", t, func() {
+		execute := func(string) ([][]byte, error) { return nil, errors.New("ERROR") }
+		sm := newSchemaManager(logging.NewNilLogger(), execute)
+		Convey("When DescribeSchema is called and encounters a querying error", func() {
+			_, err := sm.DescribeSchema()
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestDescribeSchemaEmptyResponse(t *testing.T) {
+	Convey("Given a string executor and schema manager", t, func() {
+		execute := func(string) ([][]byte, error) { return [][]byte{[]byte(`[]`)}, nil }
+		sm := newSchemaManager(logging.NewNilLogger(), execute)
+		Convey("When DescribeSchema is called and receives nothing back", func() {
+			_, err := sm.DescribeSchema()
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestExportSchema(t *testing.T) {
+	Convey("Given a string executor and schema manager", t, func() {
+		execute := func(string) ([][]byte, error) { return [][]byte{[]byte(schemaResponse)}, nil }
+		sm := newSchemaManager(logging.NewNilLogger(), execute)
+		Convey("When ExportSchema is called", func() {
+			var buf bytes.Buffer
+			err := sm.ExportSchema(&buf, schema.YAML)
+			Convey("Then the schema should be written without index statuses", func() {
+				So(err, ShouldBeNil)
+				So(buf.String(), ShouldContainSubstring, "- name: byName\n  type: composite\n")
+				So(buf.String(), ShouldNotContainSubstring, "status")
+			})
+		})
+	})
+}
+
+func TestExportSchemaQueryError(t *testing.T) {
+	Convey("Given a string executor and schema manager", t, func() {
+		execute := func(string) ([][]byte, error) { return nil, errors.New("ERROR") }
+		sm := newSchemaManager(logging.NewNilLogger(), execute)
+		Convey("When ExportSchema is called and encounters a querying error", func() {
+			err := sm.ExportSchema(&bytes.Buffer{}, schema.JSON)
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestImportSchema(t *testing.T) {
+	Convey("Given a string executor and schema manager", t, func() {
+		var queries []string
+		execute := func(q string) ([][]byte, error) {
+			if strings.HasSuffix(q, "\nschema") {
+				return [][]byte{[]byte(schemaResponse)}, nil
+			}
+			queries = append(queries, q)
+			return nil, nil
+		}
+		sm := newSchemaManager(logging.NewNilLogger(), execute)
+		Convey("When a document exported from the same graph is imported", func() {
+			var buf bytes.Buffer
+			So(sm.ExportSchema(&buf, schema.JSON), ShouldBeNil)
+			doc := buf.String()
+			changes, err := sm.ImportSchema(&buf)
+			Convey("Then the document should keep every data type and index option", func() {
+				So(doc, ShouldContainSubstring, `"dataType": "UUID.class"`)
+				So(doc, ShouldContainSubstring, `"dataType": "java.time.Instant.class"`)
+				So(doc, ShouldContainSubstring, `"indexOnly": "person"`)
+			})
+			Convey("Then nothing should be changed", func() {
+				So(err, ShouldBeNil)
+				So(changes.IsEmpty(), ShouldBeTrue)
+				So(queries, ShouldBeEmpty)
+			})
+		})
+		Convey("When a document with a new property key is imported", func() {
+			doc := "propertyKeys:\n- name: age\n  dataType: Integer.class\n  cardinality: single\n"
+			changes, err := sm.ImportSchema(strings.NewReader(doc))
+			Convey("Then only the property key should be created", func() {
+				So(err, ShouldBeNil)
+				So(changes.PropertyKeys, ShouldHaveLength, 1)
+				So(queries, ShouldHaveLength, 1)
+				So(queries[0], ShouldContainSubstring, "mgmt.makePropertyKey(\"age\").dataType(Integer.class).cardinality(single).make()")
+			})
+		})
+		Convey("When an invalid document is imported", func() {
+			_, err := sm.ImportSchema(strings.NewReader(`{"propertyKeys": [{"name": "age", "cardinality": "many"}]}`))
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(queries, ShouldBeEmpty)
+			})
+		})
+	})
+}
@@ -192,4 +192,52 @@ var (
 		}
 	}
 	`
+	schemaResponse = `
+	{
+		"@type": "g:List",
+		"@value": [{
+			"@type": "g:Map",
+			"@value": [
+				"vertexLabels", {"@type": "g:List", "@value": [
+					{"@type": "g:Map", "@value": ["name", "person", "static", false, "partitioned", false]}
+				]},
+				"edgeLabels", {"@type": "g:List", "@value": [
+					{"@type": "g:Map", "@value": ["name", "knows", "multiplicity", "MULTI", "directed", true, "unidirected", false]}
+				]},
+				"propertyKeys", {"@type": "g:List", "@value": [
+					{"@type": "g:Map", "@value": ["name", "name", "dataType", "String.class", "cardinality", "single"]},
+					{"@type": "g:Map", "@value": ["name", "uid", "dataType", "UUID.class", "cardinality", "single"]},
+					{"@type": "g:Map", "@value": ["name", "seen", "dataType", "java.time.Instant.class", "cardinality", "list"]}
+				]},
+				"indexes", {"@type": "g:List", "@value": [
+					{"@type": "g:Map", "@value": [
+						"name", "byName", "type", "composite", "element", "Vertex", "unique", true, "backingIndex", "internalindex",
+						"indexOnly", "person",
+						"keys", {"@type": "g:List", "@value": [
+							{"@type": "g:Map", "@value": ["name", "name", "status", "SchemaStatus.ENABLED"]}
+						]}
+					]}
+				]},
+				"connections", {"@type": "g:List", "@value": [
+					{"@type": "g:Map", "@value": ["edgeLabel", "knows", "outVertexLabel", "person", "inVertexLabel", "person"]}
+				]}
+			]
+		}]
+	}
+	`
+	indexStatusResponse = `
+	{
+		"@type": "g:List",
+		"@value": [{
+			"@type": "g:Map",
+			"@value": [
+				"indexName", "byName",
+				"succeeded", false,
+				"converged", {"@type": "g:Map", "@value": ["name", "SchemaStatus.ENABLED"]},
+				"notConverged", {"@type": "g:Map", "@value": ["age", "SchemaStatus.REGISTERED"]},
+				"elapsedMillis", {"@type": "g:Int64", "@value": 1500}
+			]
+		}]
+	}
+	`
 )
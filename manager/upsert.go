@@ -0,0 +1,295 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package manager
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/northwesternmutual/grammes/dialect"
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/logging"
+	"github.com/northwesternmutual/grammes/model"
+	"github.com/northwesternmutual/grammes/query"
+	__ "github.com/northwesternmutual/grammes/query/anonymous"
+	"github.com/northwesternmutual/grammes/query/traversal"
+)
+
+type upsertQueryManager struct {
+	logger       logging.Logger
+	executeQuery queryExecutor
+	// version is the TinkerPop version of the server. When
+	// it's empty the server is expected to support mergeV.
+	version string
+	// dialect gives the IDs the representation of the database.
+	dialect *dialect.Dialect
+}
+
+func newUpsertQueryManager(logger logging.Logger, executor queryExecutor) *upsertQueryManager {
+	return &upsertQueryManager{
+		logger:       logger,
+		executeQuery: executor,
+	}
+}
+
+// supportsMerge returns whether a TinkerPop version such
+// as "3.6.2" has the mergeV and mergeE steps. An empty
+// version is expected to have them.
+func supportsMerge(version string) bool {
+	return version == "" ||
+		traversal.CompareVersions(version, traversal.MinimumVersion("mergeV")) >= 0
+}
+
+// fmtValue formats a value the same way
+// a step parameter is formatted.
+func fmtValue(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return query.Quote(s)
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+// sortedKeys returns the keys of the properties in order
+// so the same upsert always renders the same query.
+func sortedKeys(properties map[string]interface{}) []string {
+	keys := make([]string, 0, len(properties))
+	for k := range properties {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// mergeMap renders the map given to mergeV or mergeE
+// with the tokens first and the properties after.
+func mergeMap(tokens []string, properties map[string]interface{}) traversal.Custom {
+	entries := append([]string{}, tokens...)
+	for _, k := range sortedKeys(properties) {
+		entries = append(entries, fmtValue(k)+":"+fmtValue(properties[k]))
+	}
+	return traversal.Custom("[" + strings.Join(entries, ",") + "]")
+}
+
+// stepAdder is a traversal or an anonymous traversal.
+type stepAdder interface {
+	AddStep(step string, params ...interface{})
+}
+
+// setProperties adds a property step for every property.
+func setProperties(g stepAdder, properties map[string]interface{}) {
+	for _, k := range sortedKeys(properties) {
+		g.AddStep("property", k, properties[k])
+	}
+}
+
+// hasProperties adds a has step for every property.
+func hasProperties(g traversal.String, properties map[string]interface{}) traversal.String {
+	for _, k := range sortedKeys(properties) {
+		g = g.Has(k, properties[k])
+	}
+	return g
+}
+
+// projectUpsert turns the element into the map that is
+// read by unmarshalUpsert. The created traversal must
+// result in either true or false.
+func projectUpsert(g stepAdder, created traversal.Anonymous) {
+	g.AddStep("project", "element", "created")
+	g.AddStep("by", __.Identity())
+	g.AddStep("by", created)
+}
+
+// mergeQuery finishes an upsert that uses mergeV or mergeE.
+// The number of matching elements is counted beforehand
+// so it is known whether the merge created the element.
+func mergeQuery(existing traversal.String, step string, merge traversal.Custom, set map[string]interface{}) traversal.String {
+	query := existing.Count().As("existing")
+	query.AddStep(step, merge)
+	setProperties(&query, set)
+
+	projectUpsert(&query,
+		__.Choose(__.Select("existing").Is(0), __.Constant("true"), __.Constant("false")),
+	)
+	return query
+}
+
+// coalesceQuery finishes an upsert for servers older
+// than TinkerPop 3.6 that don't have mergeV or mergeE.
+func coalesceQuery(existing traversal.String, create traversal.Anonymous, set map[string]interface{}) traversal.String {
+	found := __.Unfold()
+	setProperties(&found, set)
+	projectUpsert(&found, __.Constant("false"))
+
+	setProperties(&create, set)
+	projectUpsert(&create, __.Constant("true"))
+
+	return existing.Fold().Coalesce(found, create)
+}
+
+// upsertVertexQuery builds the query used by UpsertVertex.
+func (u *upsertQueryManager) upsertVertexQuery(label string, match, set map[string]interface{}) traversal.String {
+	existing := hasProperties(traversal.NewTraversal().V().HasLabel(label), match)
+
+	if supportsMerge(u.version) {
+		merge := mergeMap([]string{"(T.label):" + fmtValue(label)}, match)
+		return mergeQuery(existing, "mergeV", merge, set)
+	}
+
+	create := __.AddV(label)
+	setProperties(&create, match)
+	return coalesceQuery(existing, create, set)
+}
+
+// upsertEdgeQuery builds the query used by UpsertEdge.
+func (u *upsertQueryManager) upsertEdgeQuery(outID, inID interface{}, label string, match, set map[string]interface{}) traversal.String {
+	outID, inID = u.dialect.ID(outID), u.dialect.ID(inID)
+	existing := traversal.NewTraversal().V().HasID(outID).OutE(label).Where(__.InV().HasID(inID))
+	existing = hasProperties(existing, match)
+
+	if supportsMerge(u.version) {
+		merge := mergeMap([]string{
+			"(T.label):" + fmtValue(label),
+			"(Direction.OUT):" + fmtValue(outID),
+			"(Direction.IN):" + fmtValue(inID),
+		}, match)
+		return mergeQuery(existing, "mergeE", merge, set)
+	}
+
+	create := __.V().HasID(outID).AddE(label).To(__.V().HasID(inID))
+	setProperties(&create, match)
+	return coalesceQuery(existing, create, set)
+}
+
+// UpsertVertex will get the vertex with the label that has the
+// match properties or create it when there is none. The set
+// properties are given to the vertex in both cases. Whether
+// the vertex was created is returned alongside it.
+func (u *upsertQueryManager) UpsertVertex(label string, match, set map[string]interface{}) (model.Vertex, bool, error) {
+	query := u.upsertVertexQuery(label, match, set)
+
+	responses, err := u.executeQuery(query)
+	if err != nil {
+		u.logger.Error("invalid query",
+			gremerror.NewQueryError("UpsertVertex", query.String(), err),
+		)
+		return nilVertex, false, err
+	}
+
+	var vertex model.Vertex
+	created, err := unmarshalUpsert(responses, &vertex)
+	if err != nil {
+		u.logger.Error("unmarshal",
+			gremerror.NewGrammesError("UpsertVertex", err),
+		)
+		return nilVertex, false, err
+	}
+
+	return vertex, created, nil
+}
+
+// UpsertEdge will get the edge with the label going from outID to
+// inID that has the match properties or create it when there is
+// none. The set properties are given to the edge in both cases.
+// Whether the edge was created is returned alongside it.
+func (u *upsertQueryManager) UpsertEdge(outID, inID interface{}, label string, match, set map[string]interface{}) (model.Edge, bool, error) {
+	query := u.upsertEdgeQuery(outID, inID, label, match, set)
+
+	responses, err := u.executeQuery(query)
+	if err != nil {
+		u.logger.Error("invalid query",
+			gremerror.NewQueryError("UpsertEdge", query.String(), err),
+		)
+		return model.Edge{}, false, err
+	}
+
+	var edge model.Edge
+	created, err := unmarshalUpsert(responses, &edge)
+	if err != nil {
+		u.logger.Error("unmarshal",
+			gremerror.NewGrammesError("UpsertEdge", err),
+		)
+		return model.Edge{}, false, err
+	}
+
+	return edge, created, nil
+}
+
+// unmarshalUpsert reads the element and whether it was created
+// from the map made by projectUpsert. Both the GraphSON 2 and
+// GraphSON 3 forms of the list and map are understood.
+func unmarshalUpsert(data [][]byte, element interface{}) (bool, error) {
+	for _, res := range data {
+		var list model.List
+		var items []json.RawMessage
+
+		if err := jsonUnmarshal(res, &list); err == nil && list.Type != "" {
+			res, _ = json.Marshal(list.Value)
+		}
+		if err := jsonUnmarshal(res, &items); err != nil {
+			return false, gremerror.NewUnmarshalError("unmarshalUpsert", res, err)
+		}
+		if len(items) == 0 {
+			continue
+		}
+
+		fields, err := upsertFields(items[0])
+		if err != nil {
+			return false, gremerror.NewUnmarshalError("unmarshalUpsert", items[0], err)
+		}
+
+		var created bool
+		if err = jsonUnmarshal(fields["created"], &created); err != nil {
+			return false, gremerror.NewUnmarshalError("unmarshalUpsert", items[0], err)
+		}
+		if err = jsonUnmarshal(fields["element"], element); err != nil {
+			return false, gremerror.NewUnmarshalError("unmarshalUpsert", items[0], err)
+		}
+
+		return created, nil
+	}
+
+	return false, gremerror.ErrEmptyResponse
+}
+
+// upsertFields reads the entries of a g:Map or a plain JSON object.
+func upsertFields(raw json.RawMessage) (map[string]json.RawMessage, error) {
+	var typed struct {
+		Type  string            `json:"@type"`
+		Value []json.RawMessage `json:"@value"`
+	}
+	if err := jsonUnmarshal(raw, &typed); err == nil && typed.Type == "g:Map" {
+		fields := make(map[string]json.RawMessage, len(typed.Value)/2)
+		for i := 0; i+1 < len(typed.Value); i += 2 {
+			var key string
+			if err = jsonUnmarshal(typed.Value[i], &key); err != nil {
+				return nil, err
+			}
+			fields[key] = typed.Value[i+1]
+		}
+		return fields, nil
+	}
+
+	var fields map[string]json.RawMessage
+	err := jsonUnmarshal(raw, &fields)
+	return fields, err
+}
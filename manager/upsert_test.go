@@ -0,0 +1,187 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package manager
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/logging"
+	"github.com/northwesternmutual/grammes/query"
+)
+
+var (
+	upsertVertexResponse = `
+	{
+		"@type": "g:List",
+		"@value": [{
+			"@type": "g:Map",
+			"@value": [
+				"element", {"@type": "g:Vertex", "@value": {"id": 28720, "label": "person"}},
+				"created", true
+			]
+		}]
+	}
+	`
+	upsertEdgeResponse = `
+	[{
+		"element": {"@type": "g:Edge", "@value": {"id": 1234, "label": "knows", "inV": 2, "outV": 1}},
+		"created": false
+	}]
+	`
+)
+
+func TestSupportsMerge(t *testing.T) {
+	Convey("Given TinkerPop versions", t, func() {
+		Convey("Then versions from 3.6 onwards should support merging", func() {
+			So(supportsMerge(""), ShouldBeTrue)
+			So(supportsMerge("3.6.0"), ShouldBeTrue)
+			So(supportsMerge("3.7.1-SNAPSHOT"), ShouldBeTrue)
+			So(supportsMerge("4.0"), ShouldBeTrue)
+		})
+		Convey("Then versions before 3.6 should not support merging", func() {
+			So(supportsMerge("3.5.4"), ShouldBeFalse)
+			So(supportsMerge("3.4"), ShouldBeFalse)
+			So(supportsMerge("unknown"), ShouldBeFalse)
+		})
+	})
+}
+
+func TestUpsertVertex(t *testing.T) {
+	Convey("Given a string executor and upsert query manager", t, func() {
+		var sent string
+		execute := func(q query.Query) ([][]byte, error) {
+			sent = q.String()
+			return [][]byte{[]byte(upsertVertexResponse)}, nil
+		}
+		um := newUpsertQueryManager(logging.NewNilLogger(), execute)
+		match := map[string]interface{}{"name": "damien"}
+		set := map[string]interface{}{"age": 30}
+		Convey("When UpsertVertex is called", func() {
+			v, created, err := um.UpsertVertex("person", match, set)
+			Convey("Then mergeV should be used", func() {
+				So(err, ShouldBeNil)
+				So(sent, ShouldEqual, `g.V().hasLabel("person").has("name","damien").count().as("existing")`+
+					`.mergeV([(T.label):"person","name":"damien"]).property("age",30)`+
+					`.project("element","created").by(__.identity())`+
+					`.by(__.choose(__.select("existing").is(0),__.constant(true),__.constant(false)))`)
+			})
+			Convey("Then the vertex and whether it was created should be returned", func() {
+				So(v.Label(), ShouldEqual, "person")
+				So(created, ShouldBeTrue)
+			})
+		})
+		Convey("When UpsertVertex is called for an older server", func() {
+			um.version = "3.5.4"
+			_, _, err := um.UpsertVertex("person", match, set)
+			Convey("Then fold and coalesce should be used", func() {
+				So(err, ShouldBeNil)
+				So(sent, ShouldEqual, `g.V().hasLabel("person").has("name","damien").fold().coalesce(`+
+					`__.unfold().property("age",30).project("element","created").by(__.identity()).by(__.constant(false)),`+
+					`__.addV("person").property("name","damien").property("age",30)`+
+					`.project("element","created").by(__.identity()).by(__.constant(true)))`)
+			})
+		})
+	})
+}
+
+func TestUpsertEdge(t *testing.T) {
+	Convey("Given a string executor and upsert query manager", t, func() {
+		var sent string
+		execute := func(q query.Query) ([][]byte, error) {
+			sent = q.String()
+			return [][]byte{[]byte(upsertEdgeResponse)}, nil
+		}
+		um := newUpsertQueryManager(logging.NewNilLogger(), execute)
+		set := map[string]interface{}{"since": 2018}
+		Convey("When UpsertEdge is called", func() {
+			e, created, err := um.UpsertEdge(1, 2, "knows", nil, set)
+			Convey("Then mergeE should be used", func() {
+				So(err, ShouldBeNil)
+				So(sent, ShouldEqual, `g.V().hasId(1).outE("knows").where(__.inV().hasId(2)).count().as("existing")`+
+					`.mergeE([(T.label):"knows",(Direction.OUT):1,(Direction.IN):2]).property("since",2018)`+
+					`.project("element","created").by(__.identity())`+
+					`.by(__.choose(__.select("existing").is(0),__.constant(true),__.constant(false)))`)
+			})
+			Convey("Then the edge and whether it was created should be returned", func() {
+				So(e.Label(), ShouldEqual, "knows")
+				So(created, ShouldBeFalse)
+			})
+		})
+		Convey("When UpsertEdge is called for an older server", func() {
+			um.version = "3.5.4"
+			_, _, err := um.UpsertEdge(1, 2, "knows", nil, set)
+			Convey("Then fold and coalesce should be used", func() {
+				So(err, ShouldBeNil)
+				So(sent, ShouldEqual, `g.V().hasId(1).outE("knows").where(__.inV().hasId(2)).fold().coalesce(`+
+					`__.unfold().property("since",2018).project("element","created").by(__.identity()).by(__.constant(false)),`+
+					`__.V().hasId(1).addE("knows").to(__.V().hasId(2)).property("since",2018)`+
+					`.project("element","created").by(__.identity()).by(__.constant(true)))`)
+			})
+		})
+	})
+}
+
+func TestUpsertQueryError(t *testing.T) {
+	Convey("Given a string executor and upsert query manager", t, func() {
+		execute := func(query.Query) ([][]byte, error) { return nil, errors.New("ERROR") }
+		um := newUpsertQueryManager(logging.NewNilLogger(), execute)
+		Convey("When the upserts encounter a querying error", func() {
+			_, _, vertexErr := um.UpsertVertex("person", nil, nil)
+			_, _, edgeErr := um.UpsertEdge(1, 2, "knows", nil, nil)
+			Convey("Then the errors should be returned", func() {
+				So(vertexErr, ShouldNotBeNil)
+				So(edgeErr, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestUpsertEmptyResponse(t *testing.T) {
+	Convey("Given a string executor and upsert query manager", t, func() {
+		execute := func(query.Query) ([][]byte, error) { return [][]byte{[]byte("[]")}, nil }
+		um := newUpsertQueryManager(logging.NewNilLogger(), execute)
+		Convey("When the upserts return nothing", func() {
+			_, _, vertexErr := um.UpsertVertex("person", nil, nil)
+			_, _, edgeErr := um.UpsertEdge(1, 2, "knows", nil, nil)
+			Convey("Then the empty response error should be returned", func() {
+				So(vertexErr, ShouldEqual, gremerror.ErrEmptyResponse)
+				So(edgeErr, ShouldEqual, gremerror.ErrEmptyResponse)
+			})
+		})
+	})
+}
+
+func TestUpsertUnmarshalError(t *testing.T) {
+	Convey("Given a string executor and upsert query manager", t, func() {
+		execute := func(query.Query) ([][]byte, error) { return [][]byte{[]byte(`[{"created": "yes"}]`)}, nil }
+		um := newUpsertQueryManager(logging.NewNilLogger(), execute)
+		Convey("When UpsertVertex is called and the response cannot be read", func() {
+			_, _, err := um.UpsertVertex("person", nil, nil)
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
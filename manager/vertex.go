@@ -34,7 +34,7 @@ type vertexQueryManager struct {
 }
 
 // NewVertexQueryManager will return a manager for the vertices.
-func newVertexQueryManager(logger logging.Logger, executeRequest stringExecutor) *vertexQueryManager {
+func newVertexQueryManager(logger logging.Logger, executeRequest queryExecutor) *vertexQueryManager {
 	return &vertexQueryManager{
 		addVertexQueryManager: newAddVertexQueryManager(logger, executeRequest),
 		getVertexQueryManager: newGetVertexQueryManager(logger, executeRequest),
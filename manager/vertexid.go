@@ -33,14 +33,14 @@ import (
 )
 
 type vertexIDQueryManager struct {
-	logger             logging.Logger
-	executeStringQuery stringExecutor
+	logger       logging.Logger
+	executeQuery queryExecutor
 }
 
-func newVertexIDQueryManager(logger logging.Logger, executor stringExecutor) *vertexIDQueryManager {
+func newVertexIDQueryManager(logger logging.Logger, executor queryExecutor) *vertexIDQueryManager {
 	return &vertexIDQueryManager{
-		logger:             logger,
-		executeStringQuery: executor,
+		logger:       logger,
+		executeQuery: executor,
 	}
 }
 
@@ -51,11 +51,17 @@ func (v *vertexIDQueryManager) VertexIDsByString(q string) ([]interface{}, error
 		q += ".id()"
 	}
 
+	return v.vertexIDs(script(q))
+}
+
+// vertexIDs executes the query, which ends with
+// an id() step, and unmarshals the IDs it returns.
+func (v *vertexIDQueryManager) vertexIDs(q query.Query) ([]interface{}, error) {
 	// retrieve all the vertices from the graph.
-	responses, err := v.executeStringQuery(q)
+	responses, err := v.executeQuery(q)
 	if err != nil {
 		v.logger.Error("invalid query",
-			gremerror.NewQueryError("VertexIDs", q, err),
+			gremerror.NewQueryError("VertexIDs", q.String(), err),
 		)
 		return nil, err
 	}
@@ -88,7 +94,15 @@ func (v *vertexIDQueryManager) VertexIDsByString(q string) ([]interface{}, error
 // run through and extract all the vertex IDs matching the
 // traversal and return them in an array.
 func (v *vertexIDQueryManager) VertexIDsByQuery(query query.Query) ([]interface{}, error) {
-	ids, err := v.VertexIDsByString(query.String())
+	t, ok := query.(traversal.String)
+	if !ok {
+		return v.VertexIDsByString(query.String())
+	}
+	if last, _ := t.LastStep(); last.Name != "id" {
+		t = t.ID()
+	}
+
+	ids, err := v.vertexIDs(t)
 	if err != nil {
 		v.logger.Error("error gathering IDs",
 			gremerror.NewGrammesError("VertexIDsByQuery", err),
@@ -114,7 +128,7 @@ func (v *vertexIDQueryManager) VertexIDs(label string, properties ...interface{}
 		query.AddStep("has", properties[i], properties[i+1])
 	}
 
-	ids, err := v.VertexIDsByString(query.String())
+	ids, err := v.vertexIDs(query.ID())
 	if err != nil {
 		v.logger.Error("error gathering IDs",
 			gremerror.NewGrammesError("VertexIDs", err),
@@ -28,11 +28,12 @@ import (
 	. "github.com/smartystreets/goconvey/convey"
 
 	"github.com/northwesternmutual/grammes/logging"
+	"github.com/northwesternmutual/grammes/query"
 )
 
 func TestVertexIDsByString(t *testing.T) {
 	Convey("Given a string executor and query manager", t, func() {
-		execute := func(string) ([][]byte, error) { return [][]byte{[]byte(idResponse)}, nil }
+		execute := func(query.Query) ([][]byte, error) { return [][]byte{[]byte(idResponse)}, nil }
 		qm := newVertexIDQueryManager(logging.NewNilLogger(), execute)
 		Convey("When VertexIDsByString is called", func() {
 			_, err := qm.VertexIDsByString("testquery")
@@ -45,7 +46,7 @@ func TestVertexIDsByString(t *testing.T) {
 
 func TestVertexIDsByStringQueryError(t *testing.T) {
 	Convey("Given a string executor and query manager", t, func() {
-		execute := func(string) ([][]byte, error) { return nil, errors.New("ERROR") }
+		execute := func(query.Query) ([][]byte, error) { return nil, errors.New("ERROR") }
 		qm := newVertexIDQueryManager(logging.NewNilLogger(), execute)
 		Convey("When VertexIDsByString is called and encounters a querying error", func() {
 			_, err := qm.VertexIDsByString("testquery")
@@ -62,7 +63,7 @@ func TestVertexIDsByStringUnmarshalError(t *testing.T) {
 	}()
 	jsonUnmarshal = func([]byte, interface{}) error { return errors.New("ERROR") }
 	Convey("Given a string executor and query manager", t, func() {
-		execute := func(string) ([][]byte, error) { return [][]byte{[]byte(idResponse)}, nil }
+		execute := func(query.Query) ([][]byte, error) { return [][]byte{[]byte(idResponse)}, nil }
 		qm := newVertexIDQueryManager(logging.NewNilLogger(), execute)
 		Convey("When VertexIDsByString is called and encounters an unmarshalling error", func() {
 			_, err := qm.VertexIDsByString("testquery")
@@ -75,7 +76,7 @@ func TestVertexIDsByStringUnmarshalError(t *testing.T) {
 
 func TestVertexIDByQuery(t *testing.T) {
 	Convey("Given a string executor and query manager", t, func() {
-		execute := func(string) ([][]byte, error) { return [][]byte{[]byte(idResponse)}, nil }
+		execute := func(query.Query) ([][]byte, error) { return [][]byte{[]byte(idResponse)}, nil }
 		qm := newVertexIDQueryManager(logging.NewNilLogger(), execute)
 		Convey("When VertexIDsByQuery is called", func() {
 			var q mockQuery
@@ -89,7 +90,7 @@ func TestVertexIDByQuery(t *testing.T) {
 
 func TestVertexIDByQueryError(t *testing.T) {
 	Convey("Given a string executor and query manager", t, func() {
-		execute := func(string) ([][]byte, error) { return nil, errors.New("ERROR") }
+		execute := func(query.Query) ([][]byte, error) { return nil, errors.New("ERROR") }
 		qm := newVertexIDQueryManager(logging.NewNilLogger(), execute)
 		Convey("When VertexIDsByQuery is called and encounters a querying error", func() {
 			var q mockQuery
@@ -103,7 +104,7 @@ func TestVertexIDByQueryError(t *testing.T) {
 
 func TestVertexIDs(t *testing.T) {
 	Convey("Given a string executor and query manager", t, func() {
-		execute := func(string) ([][]byte, error) { return [][]byte{[]byte(idResponse)}, nil }
+		execute := func(query.Query) ([][]byte, error) { return [][]byte{[]byte(idResponse)}, nil }
 		qm := newVertexIDQueryManager(logging.NewNilLogger(), execute)
 		Convey("When VertexIDs is called", func() {
 			_, err := qm.VertexIDs("testlabel", "prop1", "prop2")
@@ -116,7 +117,7 @@ func TestVertexIDs(t *testing.T) {
 
 func TestVertexIDsParamError(t *testing.T) {
 	Convey("Given a string executor and query manager", t, func() {
-		execute := func(string) ([][]byte, error) { return [][]byte{[]byte(idResponse)}, nil }
+		execute := func(query.Query) ([][]byte, error) { return [][]byte{[]byte(idResponse)}, nil }
 		qm := newVertexIDQueryManager(logging.NewNilLogger(), execute)
 		Convey("When VertexIDs is called with an odd number of parameters", func() {
 			_, err := qm.VertexIDs("testlabel", "prop1")
@@ -129,7 +130,7 @@ func TestVertexIDsParamError(t *testing.T) {
 
 func TestVertexIDsQueryError(t *testing.T) {
 	Convey("Given a string executor and query manager", t, func() {
-		execute := func(string) ([][]byte, error) { return nil, errors.New("ERROR") }
+		execute := func(query.Query) ([][]byte, error) { return nil, errors.New("ERROR") }
 		qm := newVertexIDQueryManager(logging.NewNilLogger(), execute)
 		Convey("When VertexIDs is called and encounters a querying error", func() {
 			_, err := qm.VertexIDs("testlabel", "prop1", "prop2")
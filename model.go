@@ -22,6 +22,7 @@ package grammes
 
 import (
 	"github.com/northwesternmutual/grammes/gremconnect"
+	"github.com/northwesternmutual/grammes/manager"
 	"github.com/northwesternmutual/grammes/model"
 )
 
@@ -32,6 +33,10 @@ var (
 	NewVertex = model.NewVertex
 	// NewProperty returns a property struct meant for adding it to a vertex.
 	NewProperty = model.NewProperty
+	// Diff returns the patch that turns an old version of a vertex into a new one.
+	Diff = model.Diff
+	// NewBatch returns an empty batch of operations to execute together.
+	NewBatch = manager.NewBatch
 
 	// Unmarshal functions.
 
@@ -149,6 +154,58 @@ type IDList = model.IDList
 // import it everywhere in the grammes package.
 type PropertyList = model.PropertyList
 
+// Patch is used to get quick access
+// to the model.Patch without having to
+// import it everywhere in the grammes package.
+//
+// Patch holds the property changes between two
+// versions of the same vertex.
+type Patch = model.Patch
+
+// Schema is used to get quick access
+// to the model.Schema without having to
+// import it everywhere in the grammes package.
+//
+// Schema holds the definition of a JanusGraph schema.
+type Schema = model.Schema
+
+// IndexSchema is used to get quick access
+// to the model.IndexSchema without having to
+// import it everywhere in the grammes package.
+//
+// IndexSchema describes an index in the schema.
+type IndexSchema = model.IndexSchema
+
+// IndexKeySchema is used to get quick access
+// to the model.IndexKeySchema without having to
+// import it everywhere in the grammes package.
+//
+// IndexKeySchema describes a key of an index.
+type IndexKeySchema = model.IndexKeySchema
+
+// IndexStatusReport is used to get quick access
+// to the model.IndexStatusReport without having to
+// import it everywhere in the grammes package.
+//
+// IndexStatusReport is the result of waiting
+// for an index to reach a status.
+type IndexStatusReport = model.IndexStatusReport
+
+// Batch is used to get quick access
+// to the manager.Batch without having to
+// import it everywhere in the grammes package.
+//
+// Batch accumulates operations that change the graph
+// so they can be sent to the server in a few requests.
+type Batch = manager.Batch
+
+// BatchReport is used to get quick access
+// to the manager.BatchReport without having to
+// import it everywhere in the grammes package.
+//
+// BatchReport holds the result of every operation in a batch.
+type BatchReport = manager.BatchReport
+
 // SimpleValue is used to get quick access
 // to the model.SimpleValue without having to
 // import it everywhere in the grammes package.
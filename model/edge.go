@@ -22,6 +22,7 @@ package model
 
 import (
 	"errors"
+	"time"
 
 	"github.com/northwesternmutual/grammes/query/traversal"
 )
@@ -42,6 +43,56 @@ func (e *Edge) PropertyValue(key string) interface{} {
 	return e.Value.Properties[key].Value.Value.PropertyDetailedValue.Value
 }
 
+// value is the valueLookup used by the typed getters.
+func (e *Edge) value(key string) (ValueWrapper, bool) {
+	p, ok := e.Value.Properties[key]
+	if !ok {
+		return ValueWrapper{}, false
+	}
+	return p.Value.Value, true
+}
+
+// Has returns whether the edge has a value for the given key.
+func (e *Edge) Has(key string) bool {
+	_, ok := e.Value.Properties[key]
+	return ok
+}
+
+// GetAll returns the value of the property in a slice. Edges
+// can't have multi-properties so this will only hold one value.
+func (e *Edge) GetAll(key string) ([]interface{}, error) {
+	w, err := lookupValue("GetAll", key, e.value)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{w.Value}, nil
+}
+
+// GetString returns the value of the property as a string.
+func (e *Edge) GetString(key string) (string, error) {
+	return getString("GetString", key, e.value)
+}
+
+// GetInt64 returns the value of the property as an int64.
+func (e *Edge) GetInt64(key string) (int64, error) {
+	return getInt64("GetInt64", key, e.value)
+}
+
+// GetFloat64 returns the value of the property as a float64.
+func (e *Edge) GetFloat64(key string) (float64, error) {
+	return getFloat64("GetFloat64", key, e.value)
+}
+
+// GetBool returns the value of the property as a bool.
+func (e *Edge) GetBool(key string) (bool, error) {
+	return getBool("GetBool", key, e.value)
+}
+
+// GetTime returns the value of the property as a time.Time.
+func (e *Edge) GetTime(key string) (time.Time, error) {
+	return getTime("GetTime", key, e.value)
+}
+
 // ID will retrieve the Edge ID for you.
 func (e *Edge) ID() interface{} {
 	return e.Value.ID
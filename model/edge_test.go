@@ -21,9 +21,12 @@
 package model
 
 import (
+	"errors"
 	"testing"
 
 	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/gremerror"
 )
 
 func TestPropertyValue(t *testing.T) {
@@ -151,3 +154,30 @@ func TestQueryInVertex(t *testing.T) {
 		})
 	})
 }
+
+func TestEdgeTypedGetters(t *testing.T) {
+	Convey("Given a decoded edge with typed properties", t, func() {
+		data := []byte(`[{"@type":"g:Edge","@value":{"id":1,"label":"knows","inV":2,"outV":3,"properties":{
+			"weight":{"@type":"g:Property","@value":{"key":"weight","value":{"@type":"g:Double","@value":0.5}}},
+			"since":{"@type":"g:Property","@value":{"key":"since","value":{"@type":"g:Int64","@value":2001}}}}}}]`)
+		edges, err := UnmarshalEdgeList([][]byte{data})
+		So(err, ShouldBeNil)
+		e := edges[0]
+
+		Convey("When the typed getters are called", func() {
+			weight, weightErr := e.GetFloat64("weight")
+			since, sinceErr := e.GetInt64("since")
+			_, lossErr := e.GetInt64("weight")
+			_, missingErr := e.GetString("missing")
+			Convey("Then the values and errors should be returned", func() {
+				So(weightErr, ShouldBeNil)
+				So(weight, ShouldEqual, 0.5)
+				So(sinceErr, ShouldBeNil)
+				So(since, ShouldEqual, int64(2001))
+				So(errors.Is(lossErr, gremerror.ErrPropertyType), ShouldBeTrue)
+				So(errors.Is(missingErr, gremerror.ErrPropertyNotFound), ShouldBeTrue)
+				So(e.Has("weight"), ShouldBeTrue)
+			})
+		})
+	})
+}
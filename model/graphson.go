@@ -0,0 +1,93 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package model
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+)
+
+// UnmarshalGraphSON unmarshals a GraphSON response into plain
+// Go values. Typed values are unwrapped, g:Map becomes a
+// map[string]interface{}, g:List and g:Set become a []interface{},
+// whole numbers become an int64, and other numbers a float64.
+func UnmarshalGraphSON(data []byte) (interface{}, error) {
+	var raw interface{}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&raw); err != nil {
+		return nil, gremerror.NewUnmarshalError("UnmarshalGraphSON", data, err)
+	}
+
+	return simplifyGraphSON(raw), nil
+}
+
+// simplifyGraphSON recursively unwraps the typed
+// GraphSON values into their plain Go counterparts.
+func simplifyGraphSON(v interface{}) interface{} {
+	switch t := v.(type) {
+	case json.Number:
+		if i, err := t.Int64(); err == nil {
+			return i
+		}
+		f, _ := t.Float64()
+		return f
+	case []interface{}:
+		res := make([]interface{}, 0, len(t))
+		for _, item := range t {
+			res = append(res, simplifyGraphSON(item))
+		}
+		return res
+	case map[string]interface{}:
+		typ, hasType := t["@type"].(string)
+		val, hasValue := t["@value"]
+		if !hasType || !hasValue || len(t) != 2 {
+			res := make(map[string]interface{}, len(t))
+			for k, item := range t {
+				res[k] = simplifyGraphSON(item)
+			}
+			return res
+		}
+
+		switch typ {
+		case "g:Map":
+			items, _ := val.([]interface{})
+			res := make(map[string]interface{}, len(items)/2)
+			for i := 0; i+1 < len(items); i += 2 {
+				res[fmt.Sprint(simplifyGraphSON(items[i]))] = simplifyGraphSON(items[i+1])
+			}
+			return res
+		case "g:Double", "g:Float":
+			if n, ok := val.(json.Number); ok {
+				f, _ := n.Float64()
+				return f
+			}
+		}
+
+		return simplifyGraphSON(val)
+	default:
+		return v
+	}
+}
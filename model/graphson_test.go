@@ -0,0 +1,68 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package model
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestUnmarshalGraphSON(t *testing.T) {
+	Convey("Given a GraphSON 3 response with maps, lists and typed numbers", t, func() {
+		data := []byte(`{"@type":"g:List","@value":[{"@type":"g:Map","@value":[
+			"count",{"@type":"g:Int64","@value":9007199254740993},
+			"weight",{"@type":"g:Double","@value":1},
+			"names",{"@type":"g:Set","@value":["a","b"]}]}]}`)
+		Convey("When 'UnmarshalGraphSON' is called", func() {
+			res, err := UnmarshalGraphSON(data)
+			Convey("Then the values should be plain Go values", func() {
+				So(err, ShouldBeNil)
+				m := res.([]interface{})[0].(map[string]interface{})
+				So(m["count"], ShouldEqual, int64(9007199254740993))
+				So(m["weight"], ShouldEqual, float64(1))
+				So(m["names"], ShouldResemble, []interface{}{"a", "b"})
+			})
+		})
+	})
+
+	Convey("Given a GraphSON 2 response with plain objects", t, func() {
+		data := []byte(`[{"name":"damien","age":{"@type":"g:Int32","@value":30}}]`)
+		Convey("When 'UnmarshalGraphSON' is called", func() {
+			res, err := UnmarshalGraphSON(data)
+			Convey("Then the objects should be kept as maps", func() {
+				So(err, ShouldBeNil)
+				m := res.([]interface{})[0].(map[string]interface{})
+				So(m["name"], ShouldEqual, "damien")
+				So(m["age"], ShouldEqual, int64(30))
+			})
+		})
+	})
+
+	Convey("Given invalid JSON", t, func() {
+		Convey("When 'UnmarshalGraphSON' is called", func() {
+			_, err := UnmarshalGraphSON([]byte(`{`))
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package model
+
+import (
+	"time"
+
+	"github.com/northwesternmutual/grammes/query/schemastatus"
+)
+
+// IndexStatusReport is the result of waiting for an index
+// to reach a status. Converged holds the keys that reached
+// the status and NotConverged the keys that didn't along
+// with the status they're currently in. For a vertex-centric
+// index the index name itself is used as the key.
+type IndexStatusReport struct {
+	IndexName     string                               `json:"indexName"`
+	Succeeded     bool                                 `json:"succeeded"`
+	Converged     map[string]schemastatus.SchemaStatus `json:"converged"`
+	NotConverged  map[string]schemastatus.SchemaStatus `json:"notConverged"`
+	ElapsedMillis int64                                `json:"elapsedMillis"`
+}
+
+// Elapsed returns how long was spent waiting for the index.
+func (r *IndexStatusReport) Elapsed() time.Duration {
+	return time.Duration(r.ElapsedMillis) * time.Millisecond
+}
@@ -0,0 +1,239 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package model
+
+import (
+	"reflect"
+	"sort"
+
+	"github.com/northwesternmutual/grammes/query/cardinality"
+	"github.com/northwesternmutual/grammes/query/traversal"
+)
+
+// Patch holds the property changes between two
+// versions of the same vertex. It can be turned into
+// a single traversal that applies all of them at once.
+type Patch struct {
+	// ID is the ID of the vertex being patched.
+	ID interface{}
+	// Added holds keys that are new to the vertex, values
+	// that are new to a multi-property and every value of
+	// a multi-property that is replaced.
+	Added PropertyMap
+	// Changed holds the new value of single
+	// cardinality properties whose value changed.
+	Changed PropertyMap
+	// Removed holds values that were taken away from a
+	// multi-property that still has other values. They're
+	// dropped by the IDs the server gave them.
+	Removed PropertyMap
+	// RemovedKeys holds keys that no longer exist on the vertex
+	// and multi-properties that are replaced, because a value
+	// taken away from them has no ID to drop it by.
+	RemovedKeys []string
+	// Cardinalities holds the cardinality the added and
+	// changed properties should be written with.
+	Cardinalities map[string]cardinality.Cardinality
+}
+
+// Diff will compare an old and new version of a vertex and
+// return the patch that turns the old version into the new one.
+// Values with different meta-properties are considered different.
+// Only the old version's values need the IDs given by the server.
+func Diff(old, new Vertex) Patch {
+	patch := Patch{
+		ID:            old.ID(),
+		Added:         make(PropertyMap),
+		Changed:       make(PropertyMap),
+		Removed:       make(PropertyMap),
+		Cardinalities: make(map[string]cardinality.Cardinality),
+	}
+	if patch.ID == nil {
+		patch.ID = new.ID()
+	}
+
+	for key, oldVals := range old.Value.Properties {
+		if len(new.Value.Properties[key]) == 0 && len(oldVals) > 0 {
+			patch.RemovedKeys = append(patch.RemovedKeys, key)
+		}
+	}
+
+	for key, newVals := range new.Value.Properties {
+		if len(newVals) == 0 {
+			continue
+		}
+
+		var (
+			card    = new.PropertyCardinality(key)
+			oldVals = old.Value.Properties[key]
+		)
+
+		switch {
+		case len(oldVals) == 0:
+			patch.Added[key] = newVals
+		case card == cardinality.Single && len(oldVals) == 1:
+			if !propertiesEqual(oldVals[0], newVals[0]) {
+				patch.Changed[key] = newVals[:1]
+			}
+		default:
+			if card == cardinality.Single {
+				card = cardinality.List
+			}
+			var removed []Property
+			for _, v := range oldVals {
+				if !containsProperty(newVals, v) {
+					removed = append(removed, v)
+				}
+			}
+			if !haveIDs(removed) {
+				// the values can't be dropped one at a time,
+				// so every value of the key is replaced.
+				patch.RemovedKeys = append(patch.RemovedKeys, key)
+				patch.Added[key] = newVals
+				break
+			}
+
+			if len(removed) > 0 {
+				patch.Removed[key] = removed
+			}
+			for _, v := range newVals {
+				if !containsProperty(oldVals, v) {
+					patch.Added[key] = append(patch.Added[key], v)
+				}
+			}
+		}
+
+		if len(patch.Added[key]) > 0 || len(patch.Changed[key]) > 0 {
+			patch.Cardinalities[key] = card
+		}
+	}
+
+	sort.Strings(patch.RemovedKeys)
+	return patch
+}
+
+// IsEmpty returns whether the patch has no changes to apply.
+func (p Patch) IsEmpty() bool {
+	return len(p.Added) == 0 && len(p.Changed) == 0 &&
+		len(p.Removed) == 0 && len(p.RemovedKeys) == 0
+}
+
+// Traversal returns a single traversal that applies every
+// change in the patch to the vertex. Removals are done first
+// inside of sideEffect() steps so the vertex keeps streaming
+// to the property() steps that follow them.
+func (p Patch) Traversal() traversal.String {
+	query := newTrav().V().HasID(p.ID)
+
+	if len(p.RemovedKeys) > 0 {
+		query.AddStep("sideEffect", newTrav().Properties(p.RemovedKeys...).Drop())
+	}
+
+	for _, key := range sortedKeys(p.Removed) {
+		for _, val := range p.Removed[key] {
+			drop := newTrav().Properties(key).HasID(val.ID()).Drop()
+			query.AddStep("sideEffect", drop)
+		}
+	}
+
+	for _, key := range sortedKeys(p.Changed) {
+		for _, val := range p.Changed[key] {
+			query.AddStep("property", propertyParams(cardinality.Single, key, val)...)
+		}
+	}
+
+	for _, key := range sortedKeys(p.Added) {
+		card := p.Cardinalities[key]
+		for _, val := range p.Added[key] {
+			query.AddStep("property", propertyParams(card, key, val)...)
+		}
+	}
+
+	return query
+}
+
+// propertyParams builds the parameters of a property() step.
+func propertyParams(card cardinality.Cardinality, key string, val Property) []interface{} {
+	var params []interface{}
+	if card != "" {
+		params = append(params, card)
+	}
+	params = append(params, key, val.GetValue())
+	return append(params, val.MetaKeyValues()...)
+}
+
+func sortedKeys(m PropertyMap) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// haveIDs returns whether every property has
+// the ID the server gave it.
+func haveIDs(props []Property) bool {
+	for _, p := range props {
+		if p.ID() == nil {
+			return false
+		}
+	}
+	return true
+}
+
+func containsProperty(props []Property, p Property) bool {
+	for _, v := range props {
+		if propertiesEqual(v, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// propertiesEqual compares the value and meta-properties
+// of two properties. Numbers are compared by value so a decoded
+// float64 is equal to the int it was originally written as.
+func propertiesEqual(a, b Property) bool {
+	if !valuesEqual(a.Value.Value, b.Value.Value) {
+		return false
+	}
+	if len(a.Value.Properties) != len(b.Value.Properties) {
+		return false
+	}
+	for k, v := range a.Value.Properties {
+		other, ok := b.Value.Properties[k]
+		if !ok || !valuesEqual(v, other) {
+			return false
+		}
+	}
+	return true
+}
+
+func valuesEqual(a, b ValueWrapper) bool {
+	if reflect.DeepEqual(a.Value, b.Value) {
+		return true
+	}
+
+	af, aErr := a.AsFloat64()
+	bf, bErr := b.AsFloat64()
+	return aErr == nil && bErr == nil && af == bf
+}
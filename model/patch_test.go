@@ -0,0 +1,113 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package model
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/query/cardinality"
+)
+
+func TestDiff(t *testing.T) {
+	Convey("Given an old and new version of a vertex", t, func() {
+		old := NewVertex("person", "name", "damien", "age", float64(30), "city", "chicago")
+		old.Value.ID = 1234
+		old.AddPropertyValue("nick", "d")
+		old.AddPropertyValue("nick", "dame")
+		old.Value.Properties["nick"][0].Value.ID = PropertyID{Type: "g:Int64", Value: float64(7)}
+		old.Value.Properties["nick"][1].Value.ID = PropertyID{Type: "g:Int64", Value: float64(8)}
+
+		new := NewVertex("person", "name", "damien", "age", 31, "email", "d@example.com")
+		new.Value.ID = 1234
+		new.AddPropertyValue("nick", "dame")
+		new.AddPropertyValue("nick", "dj")
+
+		Convey("When 'Diff' is called", func() {
+			patch := Diff(old, new)
+			Convey("Then the added, changed and removed properties should be found", func() {
+				So(patch.ID, ShouldEqual, 1234)
+				So(patch.RemovedKeys, ShouldResemble, []string{"city"})
+				So(len(patch.Changed["age"]), ShouldEqual, 1)
+				So(patch.Changed["name"], ShouldBeNil)
+				So(len(patch.Added["email"]), ShouldEqual, 1)
+				So(patch.Added["nick"][0].GetValue(), ShouldEqual, "dj")
+				So(patch.Removed["nick"][0].GetValue(), ShouldEqual, "d")
+				So(patch.Cardinalities["nick"], ShouldEqual, cardinality.List)
+				So(patch.IsEmpty(), ShouldBeFalse)
+			})
+
+			Convey("Then the traversal should apply all of them at once", func() {
+				So(patch.Traversal().String(), ShouldEqual,
+					`g.V().hasId(1234)`+
+						`.sideEffect(properties("city").drop())`+
+						`.sideEffect(properties("nick").hasId(7).drop())`+
+						`.property(single,"age",31)`+
+						`.property(single,"email","d@example.com")`+
+						`.property(list,"nick","dj")`)
+			})
+		})
+	})
+
+	Convey("Given two vertices with the same properties", t, func() {
+		old := NewVertex("person", "age", float64(30))
+		new := NewVertex("person", "age", 30)
+		Convey("When 'Diff' is called", func() {
+			patch := Diff(old, new)
+			Convey("Then the patch should be empty", func() {
+				So(patch.IsEmpty(), ShouldBeTrue)
+			})
+		})
+	})
+
+	Convey("Given a multi-property whose values have no IDs", t, func() {
+		old := NewVertex("person")
+		old.AddPropertyValue("nick", "d")
+		old.AddPropertyValue("nick", "d", "since", 2010)
+		new := NewVertex("person")
+		new.AddPropertyValue("nick", "d", "since", 2010)
+		Convey("When 'Diff' is called", func() {
+			patch := Diff(old, new)
+			Convey("Then every value of the key should be replaced", func() {
+				So(patch.RemovedKeys, ShouldResemble, []string{"nick"})
+				So(patch.Removed, ShouldBeEmpty)
+				So(patch.Traversal().String(), ShouldEqual,
+					`g.V().hasId().sideEffect(properties("nick").drop())`+
+						`.property(list,"nick","d","since",2010)`)
+			})
+		})
+	})
+
+	Convey("Given a value whose meta-property changed", t, func() {
+		old := NewVertex("person")
+		old.AddPropertyValue("location", "chicago", "startTime", 1997)
+		new := NewVertex("person")
+		new.AddPropertyValue("location", "chicago", "startTime", 1998)
+		Convey("When 'Diff' is called", func() {
+			patch := Diff(old, new)
+			Convey("Then the value should be changed with its meta-properties", func() {
+				So(patch.Traversal().String(), ShouldEqual,
+					`g.V().hasId().property(single,"location","chicago","startTime",1998)`)
+			})
+		})
+	})
+}
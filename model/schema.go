@@ -0,0 +1,193 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package model
+
+import (
+	"github.com/northwesternmutual/grammes/query/cardinality"
+	"github.com/northwesternmutual/grammes/query/datatype"
+	"github.com/northwesternmutual/grammes/query/mapping"
+	"github.com/northwesternmutual/grammes/query/multiplicity"
+	"github.com/northwesternmutual/grammes/query/schemastatus"
+)
+
+// JanusGraph:
+// https://docs.janusgraph.org/schema/
+
+// Schema holds the definition of a JanusGraph schema.
+// This includes every vertex label, edge label, property key,
+// index, and connection that has been made on the graph.
+type Schema struct {
+	VertexLabels []VertexLabelSchema `json:"vertexLabels" yaml:"vertexLabels"`
+	EdgeLabels   []EdgeLabelSchema   `json:"edgeLabels" yaml:"edgeLabels"`
+	PropertyKeys []PropertyKeySchema `json:"propertyKeys" yaml:"propertyKeys"`
+	Indexes      []IndexSchema       `json:"indexes" yaml:"indexes"`
+	Connections  []ConnectionSchema  `json:"connections" yaml:"connections"`
+}
+
+// VertexLabelSchema describes a vertex label in the schema.
+// Properties are the keys the label is allowed to have
+// when 'schema.constraints' is enabled on the graph.
+type VertexLabelSchema struct {
+	Name        string   `json:"name" yaml:"name"`
+	Static      bool     `json:"static" yaml:"static"`
+	Partitioned bool     `json:"partitioned" yaml:"partitioned"`
+	Properties  []string `json:"properties,omitempty" yaml:"properties,omitempty"`
+}
+
+// EdgeLabelSchema describes an edge label in the schema.
+type EdgeLabelSchema struct {
+	Name         string                    `json:"name" yaml:"name"`
+	Multiplicity multiplicity.Multiplicity `json:"multiplicity" yaml:"multiplicity"`
+	Directed     bool                      `json:"directed" yaml:"directed"`
+	Unidirected  bool                      `json:"unidirected" yaml:"unidirected"`
+	Properties   []string                  `json:"properties,omitempty" yaml:"properties,omitempty"`
+}
+
+// PropertyKeySchema describes a property key in the schema.
+type PropertyKeySchema struct {
+	Name        string                  `json:"name" yaml:"name"`
+	DataType    datatype.DataType       `json:"dataType" yaml:"dataType"`
+	Cardinality cardinality.Cardinality `json:"cardinality" yaml:"cardinality"`
+}
+
+// IndexType is the kind of index that's been built.
+type IndexType string
+
+const (
+	// CompositeIndex is a graph index used
+	// for exact matches on its keys.
+	CompositeIndex IndexType = "composite"
+	// MixedIndex is a graph index backed
+	// by an external indexing backend.
+	MixedIndex IndexType = "mixed"
+	// VertexCentricIndex is an index built
+	// locally per vertex on an edge label.
+	VertexCentricIndex IndexType = "vertex-centric"
+)
+
+// String will convert IndexType to a string.
+func (i IndexType) String() string {
+	return string(i)
+}
+
+// IndexSchema describes an index in the schema.
+// For a graph index, Element is either "Vertex" or
+// "Edge". For a vertex-centric index it's the edge label.
+// IndexOnly restricts a graph index to a single label.
+type IndexSchema struct {
+	Name         string           `json:"name" yaml:"name"`
+	Type         IndexType        `json:"type" yaml:"type"`
+	Element      string           `json:"element" yaml:"element"`
+	Unique       bool             `json:"unique" yaml:"unique"`
+	IndexOnly    string           `json:"indexOnly,omitempty" yaml:"indexOnly,omitempty"`
+	BackingIndex string           `json:"backingIndex,omitempty" yaml:"backingIndex,omitempty"`
+	Direction    string           `json:"direction,omitempty" yaml:"direction,omitempty"`
+	Order        string           `json:"order,omitempty" yaml:"order,omitempty"`
+	Keys         []IndexKeySchema `json:"keys" yaml:"keys"`
+}
+
+// IndexKeySchema describes a key of an index and its status.
+// Mapping is only used by keys of a mixed index.
+type IndexKeySchema struct {
+	Name    string                    `json:"name" yaml:"name"`
+	Status  schemastatus.SchemaStatus `json:"status,omitempty" yaml:"status,omitempty"`
+	Mapping mapping.Mapping           `json:"mapping,omitempty" yaml:"mapping,omitempty"`
+}
+
+// ConnectionSchema describes which vertex labels
+// an edge label is allowed to connect.
+type ConnectionSchema struct {
+	EdgeLabel      string `json:"edgeLabel" yaml:"edgeLabel"`
+	OutVertexLabel string `json:"outVertexLabel" yaml:"outVertexLabel"`
+	InVertexLabel  string `json:"inVertexLabel" yaml:"inVertexLabel"`
+}
+
+// VertexLabel returns the vertex label with the given name.
+func (s *Schema) VertexLabel(name string) (VertexLabelSchema, bool) {
+	for _, l := range s.VertexLabels {
+		if l.Name == name {
+			return l, true
+		}
+	}
+	return VertexLabelSchema{}, false
+}
+
+// EdgeLabel returns the edge label with the given name.
+func (s *Schema) EdgeLabel(name string) (EdgeLabelSchema, bool) {
+	for _, l := range s.EdgeLabels {
+		if l.Name == name {
+			return l, true
+		}
+	}
+	return EdgeLabelSchema{}, false
+}
+
+// PropertyKey returns the property key with the given name.
+func (s *Schema) PropertyKey(name string) (PropertyKeySchema, bool) {
+	for _, k := range s.PropertyKeys {
+		if k.Name == name {
+			return k, true
+		}
+	}
+	return PropertyKeySchema{}, false
+}
+
+// Index returns the index with the given name.
+func (s *Schema) Index(name string) (IndexSchema, bool) {
+	for _, i := range s.Indexes {
+		if i.Name == name {
+			return i, true
+		}
+	}
+	return IndexSchema{}, false
+}
+
+// HasConnection returns whether the edge label is
+// allowed to connect the given vertex labels.
+func (s *Schema) HasConnection(edgeLabel, outVertexLabel, inVertexLabel string) bool {
+	for _, c := range s.Connections {
+		if c.EdgeLabel == edgeLabel && c.OutVertexLabel == outVertexLabel && c.InVertexLabel == inVertexLabel {
+			return true
+		}
+	}
+	return false
+}
+
+// Status returns the status of the index as a whole. This is the
+// least progressed status among all of its keys, so an index is
+// only enabled once every one of its keys is enabled and is
+// disabled as soon as any of its keys are.
+func (i *IndexSchema) Status() schemastatus.SchemaStatus {
+	var order = map[schemastatus.SchemaStatus]int{
+		schemastatus.Disabled:   0,
+		schemastatus.Installed:  1,
+		schemastatus.Registered: 2,
+		schemastatus.Enabled:    3,
+	}
+
+	var status schemastatus.SchemaStatus
+	for _, k := range i.Keys {
+		if status == "" || order[k.Status] < order[status] {
+			status = k.Status
+		}
+	}
+	return status
+}
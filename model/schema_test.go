@@ -0,0 +1,75 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package model
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/query/schemastatus"
+)
+
+func TestSchemaLookups(t *testing.T) {
+	Convey("Given a schema", t, func() {
+		schema := Schema{
+			VertexLabels: []VertexLabelSchema{{Name: "person"}},
+			EdgeLabels:   []EdgeLabelSchema{{Name: "knows"}},
+			PropertyKeys: []PropertyKeySchema{{Name: "name"}},
+			Indexes:      []IndexSchema{{Name: "byName"}},
+		}
+		Convey("When the lookups are called", func() {
+			_, hasPerson := schema.VertexLabel("person")
+			_, hasKnows := schema.EdgeLabel("knows")
+			_, hasName := schema.PropertyKey("name")
+			_, hasIndex := schema.Index("byName")
+			_, hasMissing := schema.VertexLabel("missing")
+			Convey("Then the existing elements should be found", func() {
+				So(hasPerson, ShouldBeTrue)
+				So(hasKnows, ShouldBeTrue)
+				So(hasName, ShouldBeTrue)
+				So(hasIndex, ShouldBeTrue)
+				So(hasMissing, ShouldBeFalse)
+			})
+		})
+	})
+}
+
+func TestIndexSchemaStatus(t *testing.T) {
+	Convey("Given an index with keys in different states", t, func() {
+		index := IndexSchema{Keys: []IndexKeySchema{
+			{Name: "a", Status: schemastatus.Enabled},
+			{Name: "b", Status: schemastatus.Registered},
+		}}
+		Convey("When 'Status' is called", func() {
+			Convey("Then the least progressed status should be returned", func() {
+				So(index.Status(), ShouldEqual, schemastatus.Registered)
+			})
+		})
+
+		Convey("When one of the keys is disabled", func() {
+			index.Keys = append(index.Keys, IndexKeySchema{Name: "c", Status: schemastatus.Disabled})
+			Convey("Then the index should be disabled", func() {
+				So(index.Status(), ShouldEqual, schemastatus.Disabled)
+			})
+		})
+	})
+}
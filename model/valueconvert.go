@@ -0,0 +1,257 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package model
+
+import (
+	"encoding/json"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+)
+
+// GraphSON types that hold whole numbers.
+var integerTypes = map[string]bool{
+	"g:Int16":  true,
+	"g:Int32":  true,
+	"g:Int64":  true,
+	"gx:Byte":  true,
+	"gx:Int16": true,
+}
+
+// GraphSON types that hold floating point numbers.
+var floatTypes = map[string]bool{
+	"g:Float":       true,
+	"g:Double":      true,
+	"gx:BigDecimal": true,
+}
+
+// GraphSON types that hold a date as milliseconds since the epoch.
+var dateTypes = map[string]bool{
+	"g:Date":      true,
+	"g:Timestamp": true,
+}
+
+// rawNumber returns the undecoded number from the
+// response if the value was unmarshalled from one.
+func (w ValueWrapper) rawNumber() (json.Number, bool) {
+	raw := strings.TrimSpace(w.raw)
+	if raw == "" || raw[0] == '"' {
+		return "", false
+	}
+
+	var n json.Number
+	dec := json.NewDecoder(strings.NewReader(raw))
+	dec.UseNumber()
+	if err := dec.Decode(&n); err != nil {
+		return "", false
+	}
+
+	return n, true
+}
+
+// AsString returns the value as a string if it is one.
+func (w ValueWrapper) AsString() (string, error) {
+	if str, ok := w.Value.(string); ok && (w.Type == "" || w.Type == "g:String") {
+		return str, nil
+	}
+
+	return "", gremerror.ErrPropertyType
+}
+
+// AsInt64 returns the value as an int64. Floating point
+// values are only converted when they hold a whole number.
+func (w ValueWrapper) AsInt64() (int64, error) {
+	if floatTypes[w.Type] {
+		return 0, gremerror.ErrPropertyType
+	}
+
+	if n, ok := w.rawNumber(); ok {
+		if i, err := n.Int64(); err == nil {
+			return i, nil
+		}
+	}
+
+	switch t := w.Value.(type) {
+	case int:
+		return int64(t), nil
+	case int8:
+		return int64(t), nil
+	case int16:
+		return int64(t), nil
+	case int32:
+		return int64(t), nil
+	case int64:
+		return t, nil
+	case uint8:
+		return int64(t), nil
+	case uint16:
+		return int64(t), nil
+	case uint32:
+		return int64(t), nil
+	case json.Number:
+		return t.Int64()
+	case float32:
+		if float32(math.Trunc(float64(t))) == t {
+			return int64(t), nil
+		}
+	case float64:
+		if math.Trunc(t) == t && math.Abs(t) < 1<<63 {
+			return int64(t), nil
+		}
+	}
+
+	return 0, gremerror.ErrPropertyType
+}
+
+// AsFloat64 returns the value as a float64.
+func (w ValueWrapper) AsFloat64() (float64, error) {
+	if w.Type != "" && !integerTypes[w.Type] && !floatTypes[w.Type] {
+		return 0, gremerror.ErrPropertyType
+	}
+
+	if n, ok := w.rawNumber(); ok {
+		if f, err := n.Float64(); err == nil {
+			return f, nil
+		}
+	}
+
+	switch t := w.Value.(type) {
+	case float64:
+		return t, nil
+	case float32:
+		return float64(t), nil
+	case json.Number:
+		return t.Float64()
+	}
+
+	if i, err := w.AsInt64(); err == nil {
+		return float64(i), nil
+	}
+
+	return 0, gremerror.ErrPropertyType
+}
+
+// AsBool returns the value as a bool if it is one.
+func (w ValueWrapper) AsBool() (bool, error) {
+	if b, ok := w.Value.(bool); ok {
+		return b, nil
+	}
+
+	return false, gremerror.ErrPropertyType
+}
+
+// AsTime returns the value as a time.Time. GraphSON dates
+// and timestamps are read as milliseconds since the epoch
+// while strings are parsed using RFC 3339.
+func (w ValueWrapper) AsTime() (time.Time, error) {
+	switch t := w.Value.(type) {
+	case time.Time:
+		return t, nil
+	case string:
+		parsed, err := time.Parse(time.RFC3339Nano, t)
+		if err != nil {
+			return time.Time{}, gremerror.ErrPropertyType
+		}
+		return parsed, nil
+	}
+
+	if dateTypes[w.Type] {
+		if ms, err := w.AsInt64(); err == nil {
+			return time.Unix(0, ms*int64(time.Millisecond)).UTC(), nil
+		}
+	}
+
+	return time.Time{}, gremerror.ErrPropertyType
+}
+
+// valueLookup finds the value stored under a key on a
+// vertex, edge, or property. It reports whether it exists.
+type valueLookup func(key string) (ValueWrapper, bool)
+
+func lookupValue(function, key string, lookup valueLookup) (ValueWrapper, error) {
+	w, ok := lookup(key)
+	if !ok {
+		return ValueWrapper{}, gremerror.NewPropertyError(function, key, gremerror.ErrPropertyNotFound)
+	}
+	return w, nil
+}
+
+func getString(function, key string, lookup valueLookup) (string, error) {
+	w, err := lookupValue(function, key, lookup)
+	if err != nil {
+		return "", err
+	}
+	s, err := w.AsString()
+	if err != nil {
+		return "", gremerror.NewPropertyError(function, key, err)
+	}
+	return s, nil
+}
+
+func getInt64(function, key string, lookup valueLookup) (int64, error) {
+	w, err := lookupValue(function, key, lookup)
+	if err != nil {
+		return 0, err
+	}
+	i, err := w.AsInt64()
+	if err != nil {
+		return 0, gremerror.NewPropertyError(function, key, err)
+	}
+	return i, nil
+}
+
+func getFloat64(function, key string, lookup valueLookup) (float64, error) {
+	w, err := lookupValue(function, key, lookup)
+	if err != nil {
+		return 0, err
+	}
+	f, err := w.AsFloat64()
+	if err != nil {
+		return 0, gremerror.NewPropertyError(function, key, err)
+	}
+	return f, nil
+}
+
+func getBool(function, key string, lookup valueLookup) (bool, error) {
+	w, err := lookupValue(function, key, lookup)
+	if err != nil {
+		return false, err
+	}
+	b, err := w.AsBool()
+	if err != nil {
+		return false, gremerror.NewPropertyError(function, key, err)
+	}
+	return b, nil
+}
+
+func getTime(function, key string, lookup valueLookup) (time.Time, error) {
+	w, err := lookupValue(function, key, lookup)
+	if err != nil {
+		return time.Time{}, err
+	}
+	t, err := w.AsTime()
+	if err != nil {
+		return time.Time{}, gremerror.NewPropertyError(function, key, err)
+	}
+	return t, nil
+}
@@ -0,0 +1,149 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package model
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+)
+
+func unmarshalWrapper(data string) ValueWrapper {
+	var w ValueWrapper
+	json.Unmarshal([]byte(data), &w)
+	return w
+}
+
+func TestAsInt64(t *testing.T) {
+	Convey("Given a GraphSON g:Int64 too large for a float64", t, func() {
+		w := unmarshalWrapper(`{"@type":"g:Int64","@value":9007199254740993}`)
+		Convey("When 'AsInt64' is called", func() {
+			i, err := w.AsInt64()
+			Convey("Then the value should be converted without loss", func() {
+				So(err, ShouldBeNil)
+				So(i, ShouldEqual, int64(9007199254740993))
+			})
+		})
+	})
+
+	Convey("Given a GraphSON g:Double", t, func() {
+		w := unmarshalWrapper(`{"@type":"g:Double","@value":1.5}`)
+		Convey("When 'AsInt64' is called", func() {
+			_, err := w.AsInt64()
+			Convey("Then a type error should be returned", func() {
+				So(err, ShouldEqual, gremerror.ErrPropertyType)
+			})
+		})
+	})
+
+	Convey("Given a Go int value", t, func() {
+		w := ValueWrapper{PropertyDetailedValue: PropertyDetailedValue{Value: 12}}
+		Convey("When 'AsInt64' is called", func() {
+			i, err := w.AsInt64()
+			Convey("Then the value should be returned", func() {
+				So(err, ShouldBeNil)
+				So(i, ShouldEqual, int64(12))
+			})
+		})
+	})
+}
+
+func TestAsFloat64(t *testing.T) {
+	Convey("Given a GraphSON g:Int32", t, func() {
+		w := unmarshalWrapper(`{"@type":"g:Int32","@value":12}`)
+		Convey("When 'AsFloat64' is called", func() {
+			f, err := w.AsFloat64()
+			Convey("Then the value should be converted", func() {
+				So(err, ShouldBeNil)
+				So(f, ShouldEqual, float64(12))
+			})
+		})
+	})
+
+	Convey("Given a plain string value", t, func() {
+		w := unmarshalWrapper(`"damien"`)
+		Convey("When 'AsFloat64' is called", func() {
+			_, err := w.AsFloat64()
+			Convey("Then a type error should be returned", func() {
+				So(err, ShouldEqual, gremerror.ErrPropertyType)
+			})
+		})
+	})
+}
+
+func TestAsString(t *testing.T) {
+	Convey("Given a plain string value", t, func() {
+		w := unmarshalWrapper(`"damien"`)
+		Convey("When 'AsString' is called", func() {
+			s, err := w.AsString()
+			Convey("Then the string should be returned", func() {
+				So(err, ShouldBeNil)
+				So(s, ShouldEqual, "damien")
+			})
+		})
+	})
+
+	Convey("Given a boolean value", t, func() {
+		w := unmarshalWrapper(`true`)
+		Convey("When 'AsString' is called", func() {
+			_, err := w.AsString()
+			Convey("Then a type error should be returned", func() {
+				So(err, ShouldEqual, gremerror.ErrPropertyType)
+			})
+		})
+
+		Convey("When 'AsBool' is called", func() {
+			b, err := w.AsBool()
+			Convey("Then the bool should be returned", func() {
+				So(err, ShouldBeNil)
+				So(b, ShouldBeTrue)
+			})
+		})
+	})
+}
+
+func TestAsTime(t *testing.T) {
+	Convey("Given a GraphSON g:Date", t, func() {
+		w := unmarshalWrapper(`{"@type":"g:Date","@value":1481750076295}`)
+		Convey("When 'AsTime' is called", func() {
+			tm, err := w.AsTime()
+			Convey("Then the milliseconds should be converted", func() {
+				So(err, ShouldBeNil)
+				So(tm.Equal(time.Unix(0, 1481750076295*int64(time.Millisecond))), ShouldBeTrue)
+			})
+		})
+	})
+
+	Convey("Given an RFC 3339 string", t, func() {
+		w := unmarshalWrapper(`"2018-01-02T15:04:05Z"`)
+		Convey("When 'AsTime' is called", func() {
+			tm, err := w.AsTime()
+			Convey("Then the string should be parsed", func() {
+				So(err, ShouldBeNil)
+				So(tm.Year(), ShouldEqual, 2018)
+			})
+		})
+	})
+}
@@ -21,6 +21,10 @@
 package model
 
 import (
+	"time"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/query/cardinality"
 	"github.com/northwesternmutual/grammes/query/traversal"
 )
 
@@ -39,6 +43,8 @@ type Vertex struct {
 }
 
 // NewVertex is to create a Vertex struct without all the hassle.
+// Giving the same key more than once will result in a
+// multi-property holding every value given for that key.
 func NewVertex(label string, properties ...interface{}) Vertex {
 	var v = Vertex{
 		Type: "g:Vertex",
@@ -53,18 +59,126 @@ func NewVertex(label string, properties ...interface{}) Vertex {
 	}
 
 	for i := 0; i < len(properties); i += 2 {
-		v.Value.Properties[properties[i].(string)] = []Property{
-			NewProperty(properties[i].(string), properties[i+1]),
-		}
+		key := properties[i].(string)
+		v.Value.Properties[key] = append(v.Value.Properties[key],
+			NewProperty(key, properties[i+1]),
+		)
 	}
 
 	return v
 }
 
+// AddPropertyValue appends a value to the property with the given key
+// along with optional meta-property keys and values. Adding more than
+// one value to the same key makes it a multi-property.
+// This only alters the struct and not the graph.
+func (v *Vertex) AddPropertyValue(key string, value interface{}, metaKV ...interface{}) error {
+	if len(metaKV)%2 != 0 {
+		return gremerror.NewGrammesError("AddPropertyValue", gremerror.ErrOddNumberOfParameters)
+	}
+
+	p := NewProperty(key, value)
+	for i := 0; i < len(metaKV); i += 2 {
+		metaKey, ok := metaKV[i].(string)
+		if !ok {
+			return gremerror.NewGrammesError("AddPropertyValue", gremerror.ErrInvalidMetaKey)
+		}
+		p.SetMetaValue(metaKey, metaKV[i+1])
+	}
+
+	if v.Value.Properties == nil {
+		v.Value.Properties = make(PropertyMap)
+	}
+	v.Value.Properties[key] = append(v.Value.Properties[key], p)
+
+	return nil
+}
+
+// SetPropertyCardinality notes the cardinality that the property
+// with the given key should be written with to the graph.
+func (v *Vertex) SetPropertyCardinality(key string, card cardinality.Cardinality) {
+	if v.Value.Cardinalities == nil {
+		v.Value.Cardinalities = make(map[string]cardinality.Cardinality)
+	}
+	v.Value.Cardinalities[key] = card
+}
+
+// PropertyCardinality returns the cardinality that the property with
+// the given key should be written with. When none was set this will
+// be list for multi-properties and single for everything else.
+func (v *Vertex) PropertyCardinality(key string) cardinality.Cardinality {
+	if card, ok := v.Value.Cardinalities[key]; ok {
+		return card
+	}
+	if len(v.Value.Properties[key]) > 1 {
+		return cardinality.List
+	}
+	return cardinality.Single
+}
+
 // PropertyValue returns the value of a property
 // without having to traverse all the way through the structures.
+// If the key or index doesn't exist then nil is returned.
 func (v *Vertex) PropertyValue(key string, index int) interface{} {
-	return v.Value.Properties[key][index].GetValue()
+	vals := v.Value.Properties[key]
+	if index < 0 || index >= len(vals) {
+		return nil
+	}
+	return vals[index].GetValue()
+}
+
+// firstValue is the valueLookup used by the typed
+// getters which read the first value of a property.
+func (v *Vertex) firstValue(key string) (ValueWrapper, bool) {
+	vals := v.Value.Properties[key]
+	if len(vals) == 0 {
+		return ValueWrapper{}, false
+	}
+	return vals[0].Value.Value, true
+}
+
+// Has returns whether the vertex has a value for the given key.
+func (v *Vertex) Has(key string) bool {
+	return len(v.Value.Properties[key]) > 0
+}
+
+// GetAll returns every value of a multi-property in order.
+func (v *Vertex) GetAll(key string) ([]interface{}, error) {
+	vals := v.Value.Properties[key]
+	if len(vals) == 0 {
+		return nil, gremerror.NewPropertyError("GetAll", key, gremerror.ErrPropertyNotFound)
+	}
+
+	res := make([]interface{}, 0, len(vals))
+	for _, p := range vals {
+		res = append(res, p.GetValue())
+	}
+	return res, nil
+}
+
+// GetString returns the first value of the property as a string.
+func (v *Vertex) GetString(key string) (string, error) {
+	return getString("GetString", key, v.firstValue)
+}
+
+// GetInt64 returns the first value of the property as an int64.
+func (v *Vertex) GetInt64(key string) (int64, error) {
+	return getInt64("GetInt64", key, v.firstValue)
+}
+
+// GetFloat64 returns the first value of the property as a float64.
+func (v *Vertex) GetFloat64(key string) (float64, error) {
+	return getFloat64("GetFloat64", key, v.firstValue)
+}
+
+// GetBool returns the first value of the property as a bool.
+func (v *Vertex) GetBool(key string) (bool, error) {
+	return getBool("GetBool", key, v.firstValue)
+}
+
+// GetTime returns the first value of the property as a time.Time.
+func (v *Vertex) GetTime(key string) (time.Time, error) {
+	return getTime("GetTime", key, v.firstValue)
 }
 
 // PropertyMap returns a copy of the properties in a map
@@ -21,9 +21,13 @@
 package model
 
 import (
+	"errors"
 	"testing"
 
 	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/query/cardinality"
 )
 
 func TestVertexPropertyValue(t *testing.T) {
@@ -143,3 +147,159 @@ func TestAddEdge(t *testing.T) {
 		})
 	})
 }
+
+func TestNewVertexMultiProperty(t *testing.T) {
+	Convey("Given a label and a key given more than once", t, func() {
+		Convey("When 'NewVertex' is called", func() {
+			v := NewVertex("person", "nickname", "jim", "nickname", "jimbo", "age", 30)
+			Convey("Then the repeated key should hold every value", func() {
+				So(len(v.Value.Properties["nickname"]), ShouldEqual, 2)
+				So(v.PropertyValue("nickname", 1), ShouldEqual, "jimbo")
+				So(len(v.Value.Properties["age"]), ShouldEqual, 1)
+			})
+		})
+	})
+}
+
+func TestAddPropertyValue(t *testing.T) {
+	Convey("Given a vertex with no properties", t, func() {
+		v := Vertex{Type: "g:Vertex", Value: VertexValue{Label: "person"}}
+		Convey("When 'AddPropertyValue' is called with meta-properties", func() {
+			err := v.AddPropertyValue("location", "chicago", "startTime", 1997, "endTime", 2001)
+			So(err, ShouldBeNil)
+			err = v.AddPropertyValue("location", "milwaukee", "startTime", 2001)
+			So(err, ShouldBeNil)
+			Convey("Then the values should be appended with their meta-properties", func() {
+				So(len(v.Value.Properties["location"]), ShouldEqual, 2)
+				p := v.Value.Properties["location"][0]
+				So(p.GetMetaValue("startTime"), ShouldEqual, 1997)
+				So(p.MetaKeyValues(), ShouldResemble, []interface{}{"endTime", 2001, "startTime", 1997})
+			})
+			Convey("Then the cardinality should default to list", func() {
+				So(v.PropertyCardinality("location"), ShouldEqual, cardinality.List)
+			})
+		})
+
+		Convey("When 'AddPropertyValue' is called with an odd number of meta parameters", func() {
+			err := v.AddPropertyValue("location", "chicago", "startTime")
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When 'AddPropertyValue' is called with a non-string meta key", func() {
+			err := v.AddPropertyValue("location", "chicago", 1, 2)
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestPropertyCardinality(t *testing.T) {
+	Convey("Given a vertex with a single value property", t, func() {
+		v := NewVertex("person", "name", "jim")
+		Convey("When 'PropertyCardinality' is called", func() {
+			Convey("Then it should default to single", func() {
+				So(v.PropertyCardinality("name"), ShouldEqual, cardinality.Single)
+			})
+		})
+
+		Convey("When 'SetPropertyCardinality' is called", func() {
+			v.SetPropertyCardinality("name", cardinality.Set)
+			Convey("Then the set cardinality should be returned", func() {
+				So(v.PropertyCardinality("name"), ShouldEqual, cardinality.Set)
+			})
+		})
+	})
+}
+
+func TestUnmarshalMetaProperties(t *testing.T) {
+	Convey("Given a GraphSON vertex with multi-properties and meta-properties", t, func() {
+		data := []byte(`[{"@type":"g:Vertex","@value":{"id":{"@type":"g:Int64","@value":1},"label":"person","properties":{
+			"location":[
+				{"@type":"g:VertexProperty","@value":{"id":{"@type":"g:Int64","@value":6},"value":"san diego","label":"location",
+					"properties":{"startTime":{"@type":"g:Int32","@value":1997},"endTime":{"@type":"g:Int32","@value":2001}}}},
+				{"@type":"g:VertexProperty","@value":{"id":{"@type":"g:Int64","@value":7},"value":"santa cruz","label":"location",
+					"properties":{"startTime":{"@type":"g:Int32","@value":2001}}}}
+			]}}}]`)
+		Convey("When it is unmarshalled", func() {
+			vertices, err := UnmarshalVertexList([][]byte{data})
+			So(err, ShouldBeNil)
+			So(len(vertices), ShouldEqual, 1)
+			Convey("Then every value and meta-property should be decoded", func() {
+				v := vertices[0]
+				So(len(v.Value.Properties["location"]), ShouldEqual, 2)
+				So(v.PropertyValue("location", 1), ShouldEqual, "santa cruz")
+				p := v.Value.Properties["location"][0]
+				So(p.GetMetaValue("startTime"), ShouldEqual, 1997)
+				So(p.Value.Properties["startTime"].Type, ShouldEqual, "g:Int32")
+				So(p.GetMetaValue("missing"), ShouldBeNil)
+				So(p.ID(), ShouldEqual, int64(6))
+			})
+		})
+	})
+
+	Convey("Given a JanusGraph property ID", t, func() {
+		p := NewProperty("name", "damien")
+		p.Value.ID = PropertyID{
+			Type:  "janusgraph:RelationIdentifier",
+			Value: map[string]interface{}{"relationId": "oe6tp-oefzc-3c3p"},
+		}
+		Convey("When ID is called", func() {
+			Convey("Then the relation ID should be returned", func() {
+				So(p.ID(), ShouldEqual, "oe6tp-oefzc-3c3p")
+			})
+		})
+	})
+}
+
+func TestVertexTypedGetters(t *testing.T) {
+	Convey("Given a decoded vertex with typed properties", t, func() {
+		data := []byte(`[{"@type":"g:Vertex","@value":{"id":1,"label":"person","properties":{
+			"name":[{"@type":"g:VertexProperty","@value":{"id":{"@type":"g:Int64","@value":2},"value":"damien","label":"name"}}],
+			"age":[{"@type":"g:VertexProperty","@value":{"id":{"@type":"g:Int64","@value":3},"value":{"@type":"g:Int32","@value":30},"label":"age"}}],
+			"nick":[{"@type":"g:VertexProperty","@value":{"id":{"@type":"g:Int64","@value":4},"value":"d","label":"nick"}},
+				{"@type":"g:VertexProperty","@value":{"id":{"@type":"g:Int64","@value":5},"value":"dame","label":"nick"}}]}}}]`)
+		vertices, err := UnmarshalVertexList([][]byte{data})
+		So(err, ShouldBeNil)
+		v := vertices[0]
+
+		Convey("When the typed getters are called with matching types", func() {
+			name, nameErr := v.GetString("name")
+			age, ageErr := v.GetInt64("age")
+			nicks, nickErr := v.GetAll("nick")
+			Convey("Then the values should be returned", func() {
+				So(nameErr, ShouldBeNil)
+				So(name, ShouldEqual, "damien")
+				So(ageErr, ShouldBeNil)
+				So(age, ShouldEqual, int64(30))
+				So(nickErr, ShouldBeNil)
+				So(nicks, ShouldResemble, []interface{}{"d", "dame"})
+				So(v.Has("name"), ShouldBeTrue)
+				So(v.Has("missing"), ShouldBeFalse)
+			})
+		})
+
+		Convey("When a getter is called for a missing key", func() {
+			_, err := v.GetString("missing")
+			Convey("Then a not found error should be returned", func() {
+				So(errors.Is(err, gremerror.ErrPropertyNotFound), ShouldBeTrue)
+			})
+		})
+
+		Convey("When a getter is called with the wrong type", func() {
+			_, err := v.GetBool("age")
+			Convey("Then a type error should be returned", func() {
+				So(errors.Is(err, gremerror.ErrPropertyType), ShouldBeTrue)
+			})
+		})
+
+		Convey("When 'PropertyValue' is called with an index out of range", func() {
+			Convey("Then nil should be returned", func() {
+				So(v.PropertyValue("name", 3), ShouldBeNil)
+				So(v.PropertyValue("missing", 0), ShouldBeNil)
+			})
+		})
+	})
+}
@@ -20,6 +20,11 @@
 
 package model
 
+import (
+	"sort"
+	"time"
+)
+
 // Tinkerpop:
 // http://tinkerpop.apache.org/javadocs/3.2.1/core/org/apache/tinkerpop/gremlin/structure/Property.html
 
@@ -69,12 +74,153 @@ func (p *Property) GetLabel() string {
 	return p.Value.Label
 }
 
+// ID returns the ID of the property given by the server, or
+// nil when the property wasn't read from it. JanusGraph IDs are
+// returned as their relation ID and Long IDs as an int64.
+func (p *Property) ID() interface{} {
+	switch t := p.Value.ID.Value.(type) {
+	case map[string]interface{}:
+		if relationID, ok := t["relationId"].(string); ok {
+			return relationID
+		}
+	case float64:
+		if p.Value.ID.Type == "g:Int64" || p.Value.ID.Type == "g:Int32" {
+			return int64(t)
+		}
+	}
+	return p.Value.ID.Value
+}
+
+// GetMetaValue returns the raw value of a meta-property
+// (a property on this property) or nil if it isn't set.
+func (p *Property) GetMetaValue(key string) interface{} {
+	meta, ok := p.Value.Properties[key]
+	if !ok {
+		return nil
+	}
+	return meta.Value
+}
+
+// SetMetaValue will set a meta-property on this property.
+// This only alters the struct and not the graph.
+func (p *Property) SetMetaValue(key string, value interface{}) {
+	if p.Value.Properties == nil {
+		p.Value.Properties = make(map[string]ValueWrapper)
+	}
+
+	p.Value.Properties[key] = ValueWrapper{
+		PropertyDetailedValue: PropertyDetailedValue{
+			Value: value,
+		},
+	}
+}
+
+// metaValue is the valueLookup used by the typed
+// getters when reading meta-properties.
+func (p *Property) metaValue(key string) (ValueWrapper, bool) {
+	w, ok := p.Value.Properties[key]
+	return w, ok
+}
+
+// ownValue is the valueLookup used by the typed
+// getters when reading the property's own value.
+func (p *Property) ownValue(string) (ValueWrapper, bool) {
+	return p.Value.Value, true
+}
+
+// Has returns whether the property has a meta-property with the given key.
+func (p *Property) Has(key string) bool {
+	_, ok := p.Value.Properties[key]
+	return ok
+}
+
+// GetAll returns the value of the meta-property in a slice.
+// Meta-properties can only hold one value each.
+func (p *Property) GetAll(key string) ([]interface{}, error) {
+	w, err := lookupValue("GetAll", key, p.metaValue)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{w.Value}, nil
+}
+
+// GetString returns the value of the property as a string.
+func (p *Property) GetString() (string, error) {
+	return getString("GetString", p.GetLabel(), p.ownValue)
+}
+
+// GetInt64 returns the value of the property as an int64.
+func (p *Property) GetInt64() (int64, error) {
+	return getInt64("GetInt64", p.GetLabel(), p.ownValue)
+}
+
+// GetFloat64 returns the value of the property as a float64.
+func (p *Property) GetFloat64() (float64, error) {
+	return getFloat64("GetFloat64", p.GetLabel(), p.ownValue)
+}
+
+// GetBool returns the value of the property as a bool.
+func (p *Property) GetBool() (bool, error) {
+	return getBool("GetBool", p.GetLabel(), p.ownValue)
+}
+
+// GetTime returns the value of the property as a time.Time.
+func (p *Property) GetTime() (time.Time, error) {
+	return getTime("GetTime", p.GetLabel(), p.ownValue)
+}
+
+// GetMetaString returns the meta-property as a string.
+func (p *Property) GetMetaString(key string) (string, error) {
+	return getString("GetMetaString", key, p.metaValue)
+}
+
+// GetMetaInt64 returns the meta-property as an int64.
+func (p *Property) GetMetaInt64(key string) (int64, error) {
+	return getInt64("GetMetaInt64", key, p.metaValue)
+}
+
+// GetMetaFloat64 returns the meta-property as a float64.
+func (p *Property) GetMetaFloat64(key string) (float64, error) {
+	return getFloat64("GetMetaFloat64", key, p.metaValue)
+}
+
+// GetMetaBool returns the meta-property as a bool.
+func (p *Property) GetMetaBool(key string) (bool, error) {
+	return getBool("GetMetaBool", key, p.metaValue)
+}
+
+// GetMetaTime returns the meta-property as a time.Time.
+func (p *Property) GetMetaTime(key string) (time.Time, error) {
+	return getTime("GetMetaTime", key, p.metaValue)
+}
+
+// MetaKeyValues returns the meta-properties as a flat slice
+// of alternating keys and values sorted by key. This is the
+// form the property() step expects after the key and value.
+func (p *Property) MetaKeyValues() []interface{} {
+	keys := make([]string, 0, len(p.Value.Properties))
+	for k := range p.Value.Properties {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	keyVals := make([]interface{}, 0, len(keys)*2)
+	for _, k := range keys {
+		keyVals = append(keyVals, k, p.Value.Properties[k].Value)
+	}
+
+	return keyVals
+}
+
 // PropertyValue contains the ID,
 // value, and label of this property's value.
+// Properties holds the meta-properties that
+// are attached to this property if there are any.
 type PropertyValue struct {
-	ID    PropertyID   `json:"id"`
-	Value ValueWrapper `json:"value"`
-	Label string       `json:"label"`
+	ID         PropertyID              `json:"id"`
+	Value      ValueWrapper            `json:"value"`
+	Label      string                  `json:"label"`
+	Properties map[string]ValueWrapper `json:"properties,omitempty"`
 }
 
 // PropertyID holds the ID that is used
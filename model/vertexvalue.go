@@ -20,14 +20,22 @@
 
 package model
 
-import "encoding/json"
+import (
+	"encoding/json"
+
+	"github.com/northwesternmutual/grammes/query/cardinality"
+)
 
 // VertexValue contains the 'value' data
 // from the Vertex object.
+// Cardinalities is never sent or received from the
+// server. It's used to note which cardinality a property
+// should be written with when adding the vertex.
 type VertexValue struct {
-	ID         interface{} `json:"id"`
-	Label      string      `json:"label"`
-	Properties PropertyMap `json:"properties,omitempty"`
+	ID            interface{}                        `json:"id"`
+	Label         string                             `json:"label"`
+	Properties    PropertyMap                        `json:"properties,omitempty"`
+	Cardinalities map[string]cardinality.Cardinality `json:"-"`
 }
 
 // PropertyDetailedValue holds the value
@@ -45,6 +53,9 @@ type PropertyDetailedValue struct {
 type ValueWrapper struct {
 	PropertyDetailedValue
 	Partial bool `json:"-"`
+	// raw keeps the undecoded value so numbers
+	// can be converted without losing precision.
+	raw string
 }
 
 // UnmarshalJSON will override the unmarshal
@@ -52,9 +63,16 @@ type ValueWrapper struct {
 // Value into the variables within the struct.
 func (w *ValueWrapper) UnmarshalJSON(data []byte) error {
 	if err := json.Unmarshal(data, &w.PropertyDetailedValue); err == nil {
+		var raw struct {
+			Value json.RawMessage `json:"@value"`
+		}
+		if json.Unmarshal(data, &raw) == nil {
+			w.raw = string(raw.Value)
+		}
 		return nil
 	}
 
 	w.Partial = true
+	w.raw = string(data)
 	return json.Unmarshal(data, &w.Value)
 }
@@ -0,0 +1,734 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+/*
+Package __ starts anonymous traversals, which have no source
+and are nested inside of the steps of another traversal:
+
+	g.V().Repeat(__.Out("knows")).Emit()
+
+Every step of a traversal.String can start one.
+*/
+package __
+
+import (
+	"github.com/northwesternmutual/grammes/query/direction"
+	"github.com/northwesternmutual/grammes/query/dt"
+	"github.com/northwesternmutual/grammes/query/operator"
+	"github.com/northwesternmutual/grammes/query/order"
+	"github.com/northwesternmutual/grammes/query/predicate"
+	"github.com/northwesternmutual/grammes/query/scope"
+	"github.com/northwesternmutual/grammes/query/token"
+	"github.com/northwesternmutual/grammes/query/traversal"
+)
+
+// AddE (map/sideEffect) adds a new edge between two vertices on the
+// graph.
+func AddE(param interface{}) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().AddE(param)
+}
+
+// Property (sideEffect) unlike AddV() and AddE(), Property() is a full
+// sideEffect step in that it does not return the property that it
+// created, but the element that streamed into it.
+func Property(objOrCard interface{}, obj interface{}, params ...interface{}) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Property(objOrCard, obj, params...)
+}
+
+// AddV (map/sideEffect) is used to add vertices to the graph.
+func AddV(params ...interface{}) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().AddV(params...)
+}
+
+// Aggregate (sideEffect) is used to aggregate all the objects in a
+// particular point of traversal into a Collection.
+func Aggregate(str string) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Aggregate(str)
+}
+
+// And (filter) ensures that all provided traversals yield a result.
+func And(params ...traversal.Anonymous) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().And(params...)
+}
+
+// As (step modulator) similar to By() & Option().
+func As(labels ...string) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().As(labels...)
+}
+
+// AsString (map) turns the traverser into a string. With
+// the local scope the items of a list are turned into
+// strings instead. Added in TinkerPop 3.7.1.
+func AsString(scopes ...scope.Scope) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().AsString(scopes...)
+}
+
+// Barrier (barrier) turns the lazy traversal pipeline into a bulk-
+// synchronous pipeline.
+func Barrier(param ...interface{}) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Barrier(param...)
+}
+
+// Both moves to both the incoming and outgoing adjacent vertices given
+// the edge labels.
+func Both(labels ...string) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Both(labels...)
+}
+
+// BothE moves to both the incoming and outgoing incident edges given
+// the edge labels.
+func BothE(labels ...string) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().BothE(labels...)
+}
+
+// BothV moves to both vertices.
+func BothV() traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().BothV()
+}
+
+// By (step modulator) similar to As() & Option(). The
+// traversal is optionally followed by the order to sort by.
+func By(t traversal.Anonymous, orders ...order.Order) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().By(byParams(t, orders)...)
+}
+
+// ByKey is By() with the key of a property
+// instead of a traversal.
+func ByKey(key string, orders ...order.Order) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().By(byParams(key, orders)...)
+}
+
+// ByToken is By() with a token, such as T.id,
+// instead of a traversal.
+func ByToken(t token.Token, orders ...order.Order) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().By(byParams(t, orders)...)
+}
+
+func byParams(first interface{}, orders []order.Order) []interface{} {
+	params := []interface{}{first}
+	if len(orders) > 0 {
+		params = append(params, orders[0])
+	}
+	return params
+}
+
+// Call (map/flatMap) calls a service offered by the graph
+// database. The params are usually a map or an anonymous
+// traversal that gives the map. Added in TinkerPop 3.6.0.
+func Call(params ...interface{}) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Call(params...)
+}
+
+// Cap (barrier) iterates the traversal up to itself and emits the
+// sideEffect referenced by the provided key.
+func Cap(str string, optStrings ...string) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Cap(str, optStrings...)
+}
+
+// Choose (branch) routes the current traverser to a particular
+// traversal branch option.
+func Choose(t traversal.Anonymous, optTraversals ...traversal.Anonymous) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Choose(t, optTraversals...)
+}
+
+// ChoosePredicate is Choose() with a predicate instead of
+// a traversal. The first option is taken when it's true
+// and the optional second one when it's false.
+func ChoosePredicate(p *predicate.Predicate, trueChoice traversal.Anonymous, falseChoice ...traversal.Anonymous) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Choose(p, append([]traversal.Anonymous{trueChoice}, falseChoice...)...)
+}
+
+// Coalesce evaluates the provided traversals in order and returns the
+// first traversal that emits at least one element.
+func Coalesce(traversals ...traversal.Anonymous) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Coalesce(traversals...)
+}
+
+// Coin (filter) randomly filters out a traverser.
+func Coin(bias float32) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Coin(bias)
+}
+
+// Concat (map) joins the string of the traverser with the given
+// strings or the results of the given traversals. Added in
+// TinkerPop 3.7.1.
+func Concat(strsOrTraversals ...interface{}) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Concat(strsOrTraversals...)
+}
+
+// ConnectedComponent (map) finds the component that each vertex
+// belongs to with a graph computer. Added in TinkerPop 3.4.0.
+func ConnectedComponent() traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().ConnectedComponent()
+}
+
+// Constant (map) is used to specify a constant value for a traverser.
+func Constant(obj string) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Constant(obj)
+}
+
+// Count (map) counts the total number of represented traversers in the
+// stream.
+func Count(scope ...scope.Scope) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Count(scope...)
+}
+
+// CyclicPath (filter) analyzes the path of the traverser thus far and
+// if there are any repeats, the traverser is filtered out over the
+// traersal computation.
+func CyclicPath() traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().CyclicPath()
+}
+
+// DateAdd (map) adds the amount of the unit of time to
+// the date. Added in TinkerPop 3.7.1.
+func DateAdd(unit dt.DT, value int) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().DateAdd(unit, value)
+}
+
+// DateDiff (map) gives the number of seconds between the
+// date and the given date, or the date given by the
+// traversal. Added in TinkerPop 3.7.1.
+func DateDiff(dateOrTraversal interface{}) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().DateDiff(dateOrTraversal)
+}
+
+// Dedup (filter) repeatedly seen objects are removed from the
+// traversal stream.
+func Dedup(params ...interface{}) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Dedup(params...)
+}
+
+// Drop (filter/sideEffect) will remove an element and properties from
+// the graph.
+func Drop() traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Drop()
+}
+
+// E is to access the edges of the traversal.
+func E() traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().E()
+}
+
+// Element (map) moves from a property to the element
+// that holds it. Added in TinkerPop 3.6.0.
+func Element() traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Element()
+}
+
+// ElementMap (map) yields a map representation of the structure of an
+// element, with its id, label and properties. Edges also have their
+// incoming and outgoing vertices. Added in TinkerPop 3.4.4.
+func ElementMap(keys ...string) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().ElementMap(keys...)
+}
+
+// Emit (step modulator) for Repeat().
+func Emit(predOrTrav ...interface{}) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Emit(predOrTrav...)
+}
+
+// Fail (filter) stops the traversal with an error when a
+// traverser reaches it. Added in TinkerPop 3.6.0.
+func Fail(message ...string) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Fail(message...)
+}
+
+// Filter (filter) removes the traversers for
+// which the traversal has no results.
+func Filter(t traversal.Anonymous) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Filter(t)
+}
+
+// FlatMap (flatMap) replaces each traverser with
+// every result of the traversal.
+func FlatMap(t traversal.Anonymous) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().FlatMap(t)
+}
+
+// Fold (map) is used when the traversal stream needs a "barrier" to
+// aggregate all the objects and emite a computation that is a function
+// of the aggregate.
+func Fold(params ...interface{}) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Fold(params...)
+}
+
+// From (step-modulator) similar to As() and By().
+func From(param interface{}) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().From(param)
+}
+
+// V will return the vertices that belong to this graph traversal.
+func V(params ...interface{}) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().V(params...)
+}
+
+// Group (map/sideEffect) is one such sideEffect that organizes the
+// objects according to some function of the object.
+func Group(str ...string) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Group(str...)
+}
+
+// GroupCount (map/sideEffect) is the amount of times a particular
+// object has been at a particular part of a traversal.
+func GroupCount(str ...string) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().GroupCount(str...)
+}
+
+// Has (filter) filters vertices, edges, and vertex properties based on
+// their properties.
+func Has(first interface{}, params ...interface{}) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Has(first, params...)
+}
+
+// HasID (filter) filters vertices, edges, and vertex properties based
+// on their properties.
+func HasID(objOrP interface{}, objs ...string) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().HasID(objOrP, objs...)
+}
+
+// HasKey (filter) filters vertices, edges, and vertex properties based
+// on their properties.
+func HasKey(pOrStr interface{}, handledStrings ...string) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().HasKey(pOrStr, handledStrings...)
+}
+
+// HasLabel (filter) filters vertices, edges, and vertex properties
+// based on their properties.
+func HasLabel(pOrStr interface{}, handledStrings ...string) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().HasLabel(pOrStr, handledStrings...)
+}
+
+// HasNot (filter) filters vertices, edges, and vertex properties based
+// on their properties.
+func HasNot(str string) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().HasNot(str)
+}
+
+// HasValue (filter) filters vertices, edges, and vertex properties
+// based on their properties.
+func HasValue(objOrP interface{}, objs ...string) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().HasValue(objOrP, objs...)
+}
+
+// ID (map) takes an Element and extracts its identifier from it.
+func ID() traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().ID()
+}
+
+// Identity (map) is an identity function which maps the current object
+// to itself.
+func Identity() traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Identity()
+}
+
+// In moves to the incoming adjacent vertices given the edge labels.
+func In(labels ...string) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().In(labels...)
+}
+
+// InE moves to the incoming incident edges given the edge labels.
+func InE(labels ...string) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().InE(labels...)
+}
+
+// InV moves to the incoming vertex.
+func InV() traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().InV()
+}
+
+// Index (map) pairs each item of a collection with its
+// position in it. Added in TinkerPop 3.4.0.
+func Index() traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Index()
+}
+
+// Inject (sideEffect) makes it possible to insert objects arbitrarily
+// into a traversal stream.
+func Inject(obj string) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Inject(obj)
+}
+
+// Is (filter) makes it possible to filter scalar values.
+func Is(objOrP interface{}) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Is(objOrP)
+}
+
+// Key (map) takes a Property and extracts the key from it.
+func Key() traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Key()
+}
+
+// Label (map) takes an element and extracts its label from it.
+func Label() traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Label()
+}
+
+// Limit (analogous to range) except the minimum will always be 0 there
+// are two typical parameters for Limit.
+func Limit(params ...interface{}) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Limit(params...)
+}
+
+// Local (branch) allows for object-local traversal computations.
+func Local(t traversal.Anonymous) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Local(t)
+}
+
+// Loops (map) extracts the number of times the traverser has gone
+// through a current loop.
+func Loops() traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Loops()
+}
+
+// Map (map) replaces each traverser with the first
+// result of the traversal.
+func Map(t traversal.Anonymous) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Map(t)
+}
+
+// Match (map) provides a more declarative form of graph querying based
+// on the notion of pattern matching.
+func Match(traversals ...traversal.String) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Match(traversals...)
+}
+
+// Math (math) neables scientific calculator functionality within
+// Gremlin.
+func Math(str string) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Math(str)
+}
+
+// Max (map) operates on a stream of numbers and determines which is
+// the largest number in the stream.
+func Max(scopes ...scope.Scope) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Max(scopes...)
+}
+
+// Mean (map) operates on a stream of numbers and determines the
+// average of those two numbers.
+func Mean(scopes ...scope.Scope) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Mean(scopes...)
+}
+
+// MergeE (map/sideEffect) gets the edge that matches the map or
+// creates it when there is none. The map takes the label and the
+// Direction.OUT and Direction.IN vertices along with the properties.
+// Added in TinkerPop 3.6.0.
+func MergeE(searchCreate ...interface{}) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().MergeE(searchCreate...)
+}
+
+// MergeV (map/sideEffect) gets the vertex that matches the map or
+// creates it when there is none. The map can be given as a
+// map[string]interface{}, a map[interface{}]interface{} with Token
+// keys or an anonymous traversal. Added in TinkerPop 3.6.0.
+func MergeV(searchCreate ...interface{}) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().MergeV(searchCreate...)
+}
+
+// Min (map) operates on astream of numbers and determines which is the
+// smallest number in the stream.
+func Min(scopes ...scope.Scope) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Min(scopes...)
+}
+
+// None (filter) removes every traverser, which is used to
+// run a traversal only for its side effects. Added in
+// TinkerPop 3.5.0.
+func None() traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().None()
+}
+
+// Not (filter) removes objects from the traversal stream when the
+// traversal provided as an argument does not return any objects.
+func Not(t traversal.Anonymous) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Not(t)
+}
+
+// Option (step modulator) is an 'option' to a Branch() or Choose().
+func Option(params ...string) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Option(params...)
+}
+
+// Optional (branch/filterMap) returns the result of the specified
+// traversal if it yields a result else it returns the calling element
+// i.e.
+func Optional(t traversal.Anonymous) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Optional(t)
+}
+
+// Or (filter) ensures that at least of the provided traversals yield a
+// result.
+func Or(traversals ...traversal.Anonymous) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Or(traversals...)
+}
+
+// Order (map) sorts the objects of the traversal stream.
+func Order(scope ...scope.Scope) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Order(scope...)
+}
+
+// OtherV moves to the vertex that was not the vertex that was moved
+// from.
+func OtherV() traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().OtherV()
+}
+
+// Out moves to the outgoing adjacent vertices given the edge labels.
+func Out(labels ...string) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Out(labels...)
+}
+
+// OutE moves to the outgoing incident edges given the edge labels.
+func OutE(labels ...string) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().OutE(labels...)
+}
+
+// OutV moves to the outgoing vertex.
+func OutV() traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().OutV()
+}
+
+// PageRank (map/sideEffect) calculates PageRank using
+// PageRankVertexProgram.
+func PageRank(args ...float32) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().PageRank(args...)
+}
+
+// Path (map) realizes the history of the traverser and its path.
+func Path() traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Path()
+}
+
+// PeerPressure (map/sideEffect) clusters vertices using
+// PeerPressureVertexProgram.
+func PeerPressure() traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().PeerPressure()
+}
+
+// Profile (sideEffect) exists to allow developers to profile their
+// traversals to determine statistical information like step runtime,
+// counts, etc.
+func Profile(str ...string) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Profile(str...)
+}
+
+// Program (map/sideEffect) is the "lambda" step for GraphComputer
+// jobs.
+func Program(vertexProgram interface{}) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Program(vertexProgram)
+}
+
+// Project (map) projects the current object into a Map<string, object>
+// keyed by provided labels.
+func Project(str string, extraStrings ...string) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Project(str, extraStrings...)
+}
+
+// Properties (map) extracts properties from an Element in the
+// traversal stream.
+func Properties(str ...string) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Properties(str...)
+}
+
+// PropertyMap (map) extracts properties from an Element in the
+// traversal stream.
+func PropertyMap(str ...string) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().PropertyMap(str...)
+}
+
+// Range (filter) allows for filtering on a low-end and high-end when
+// objects are being iterated.
+func Range(params ...interface{}) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Range(params...)
+}
+
+// Repeat (branch) is used for loopping over a traversal given some
+// break predicate.
+func Repeat(t traversal.Anonymous) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Repeat(t)
+}
+
+// Sack (sideEffect or map) is used to read and write sacks.
+func Sack(operator ...operator.Operator) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Sack(operator...)
+}
+
+// Sample (step-modulator) is useful for sampling some number of
+// traversers previous in the traversal.
+func Sample(params ...interface{}) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Sample(params...)
+}
+
+// Select (map) Can go back in a traversal in the previously seen area
+// of computation.
+func Select(first interface{}, extras ...interface{}) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Select(first, extras...)
+}
+
+// ShortestPath (map) finds the shortest paths between vertices
+// with a graph computer. It's configured with With() and
+// options such as ShortestPath.target. Added in TinkerPop 3.4.0.
+func ShortestPath() traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().ShortestPath()
+}
+
+// SideEffect (sideEffect) runs the traversal for each traverser
+// and passes the traverser on without changing it.
+func SideEffect(t traversal.Anonymous) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().SideEffect(t)
+}
+
+// SimplePath (filter) should be used when it's important that the
+// traverser should not repeat its path through the graph.
+func SimplePath() traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().SimplePath()
+}
+
+// Skip (filter) is analogous to Range() save that the higher end range
+// is set to -1.
+func Skip(first interface{}, extraFloat ...float32) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Skip(first, extraFloat...)
+}
+
+// Split (map) splits the string into a list at every
+// separator. Added in TinkerPop 3.7.1.
+func Split(first interface{}, separator ...string) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Split(first, separator...)
+}
+
+// Store (sideEffect) should be bused over Aggregate() when lazy
+// aggregation is needed.
+func Store(str string) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Store(str)
+}
+
+// SubGraph (sideEffect) provides a way to produce an edge-induced
+// subgraph from virtually any traversal.
+func SubGraph(str string) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().SubGraph(str)
+}
+
+// Sum (map) operates on a stream of numbers and sums the numbers
+// together to yield a double.
+func Sum(scopes ...scope.Scope) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Sum(scopes...)
+}
+
+// Tail (filter) is analogous to Limit(), except that it emits the last
+// n-objects instead of the first n-objects.
+func Tail(first interface{}, extraFloat ...float32) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Tail(first, extraFloat...)
+}
+
+// TimeLimit (filter) should be used if you wish the traversal to
+// execute within a certain time.
+func TimeLimit(limit float32) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().TimeLimit(limit)
+}
+
+// To (step-modulator) similar to As() and By().
+func To(first interface{}, extraStrings ...string) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().To(first, extraStrings...)
+}
+
+// ToE (step-modulator) is a part of To().
+func ToE(dir direction.Direction, str string) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().ToE(dir, str)
+}
+
+// ToLower (map) turns the string to lower case.
+// Added in TinkerPop 3.7.1.
+func ToLower(scopes ...scope.Scope) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().ToLower(scopes...)
+}
+
+// ToUpper (map) turns the string to upper case.
+// Added in TinkerPop 3.7.1.
+func ToUpper(scopes ...scope.Scope) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().ToUpper(scopes...)
+}
+
+// ToV (step-modulator) is a part of To().
+func ToV(dir direction.Direction) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().ToV(dir)
+}
+
+// ToVId can be used to make a string query that will take a vertex id
+// as a parameter, and can be used to point an edge towards this vertex
+// ID.
+func ToVId(vertexID interface{}) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().ToVId(vertexID)
+}
+
+// Tree (sideEffect) is used when the emanating paths from an element
+// can be aggregated to form a tree.
+func Tree(str ...string) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Tree(str...)
+}
+
+// Unfold (flatMap) is an iterator, iterable, or map, then it is
+// unrolled into a linear form.
+func Unfold() traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Unfold()
+}
+
+// Union (branch) supports the merging of the results of an arbitrary
+// number of traversers.
+func Union(traversals ...traversal.Anonymous) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Union(traversals...)
+}
+
+// Until (step-modulator) for Repeat().
+func Until(predOrTrav interface{}) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Until(predOrTrav)
+}
+
+// Value (map) takes a property and extracts the value from it.
+func Value() traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Value()
+}
+
+// ValueMap (map) yields a map representation of the properties of an
+// element.
+func ValueMap(boolOrStrings ...interface{}) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().ValueMap(boolOrStrings...)
+}
+
+// Values (map) extracts the values of properties from an element in
+// the traversal stream.
+func Values(strs ...string) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Values(strs...)
+}
+
+// Where (filter) filters the current objects based on either the
+// object itself or the path history of the object.
+func Where(first interface{}, extra ...string) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().Where(first, extra...)
+}
+
+// With (step modulator) gives a configuration option to the
+// step before it, such as the target of ShortestPath(). Keys
+// that are constants such as ShortestPath.target should be
+// given as a Custom. Added in TinkerPop 3.4.0.
+func With(key interface{}, value ...interface{}) traversal.Anonymous {
+	return traversal.NewAnonymousTraversal().With(key, value...)
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package __
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/query/dt"
+	"github.com/northwesternmutual/grammes/query/order"
+	"github.com/northwesternmutual/grammes/query/predicate"
+	"github.com/northwesternmutual/grammes/query/token"
+	"github.com/northwesternmutual/grammes/query/traversal"
+)
+
+func TestOut(t *testing.T) {
+	Convey("When Out is called", t, func() {
+		result := Out("knows")
+		Convey("Then an anonymous traversal should be started", func() {
+			So(result.String(), ShouldEqual, `__.out("knows")`)
+		})
+	})
+}
+
+func TestIn(t *testing.T) {
+	Convey("When In is called and more steps are added", t, func() {
+		result := In().HasLabel("person").Values("name")
+		Convey("Then the steps should follow __", func() {
+			So(result.String(), ShouldEqual, `__.in().hasLabel("person").values("name")`)
+		})
+	})
+}
+
+func TestCoalesce(t *testing.T) {
+	Convey("Given a graph traversal", t, func() {
+		g := traversal.NewTraversal().V().Fold()
+		Convey("When Coalesce is called with anonymous traversals", func() {
+			result := g.Coalesce(Unfold(), AddV("person"))
+			Convey("Then they should be nested", func() {
+				So(result.String(), ShouldEqual, `g.V().fold().coalesce(__.unfold(),__.addV("person"))`)
+			})
+		})
+	})
+}
+
+func TestAnd(t *testing.T) {
+	Convey("When And is called with anonymous traversals", t, func() {
+		result := And(Has("name"), Out("knows"))
+		Convey("Then they should be nested", func() {
+			So(result.String(), ShouldEqual, `__.and(__.has("name"),__.out("knows"))`)
+		})
+	})
+}
+
+func TestBy(t *testing.T) {
+	Convey("When By is called with a traversal and an order", t, func() {
+		result := By(Values("age"), order.Desc)
+		Convey("Then both should be given to the step", func() {
+			So(result.String(), ShouldEqual, `__.by(__.values("age"),desc)`)
+		})
+	})
+	Convey("When ByKey is called", t, func() {
+		result := ByKey("name")
+		Convey("Then the key should be given to the step", func() {
+			So(result.String(), ShouldEqual, `__.by("name")`)
+		})
+	})
+	Convey("When ByToken is called", t, func() {
+		result := ByToken(token.ID, order.Asc)
+		Convey("Then the token should be given to the step", func() {
+			So(result.String(), ShouldEqual, `__.by(T.id,asc)`)
+		})
+	})
+}
+
+func TestChoosePredicate(t *testing.T) {
+	Convey("When ChoosePredicate is called with both choices", t, func() {
+		result := ChoosePredicate(predicate.GreaterThan(30), Values("name"), Label())
+		Convey("Then the predicate should come first", func() {
+			So(result.String(), ShouldEqual, `__.choose(gt(30),__.values("name"),__.label())`)
+		})
+	})
+}
+
+func TestDateAdd(t *testing.T) {
+	Convey("When DateAdd is called and a newer step follows", t, func() {
+		result := DateAdd(dt.Hour, 1).AsString()
+		Convey("Then the steps should follow __", func() {
+			So(result.String(), ShouldEqual, `__.dateAdd(DT.hour,1).asString()`)
+		})
+	})
+}
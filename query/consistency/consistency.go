@@ -0,0 +1,56 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+/*
+Package consistency contains the object to control how JanusGraph enforces consistency.
+
+See: https://docs.janusgraph.org/advanced-topics/eventual-consistency/
+
+ConsistencyModifier and its constant values are the string equivalent of
+JanusGraph's ConsistencyModifier when referencing them in a traversal/query.
+
+A note about ConsistencyModifier:
+
+This object implements the Parameter interfaces used by graph traversals.
+*/
+package consistency
+
+// JanusGraph:
+// https://javadoc.io/doc/org.janusgraph/janusgraph-core/latest/org/janusgraph/core/schema/ConsistencyModifier.html
+
+// ConsistencyModifier controls the consistency of a schema
+// element or index in an eventually consistent storage backend.
+type ConsistencyModifier string
+
+const (
+	// Default uses the default consistency of the storage backend.
+	Default ConsistencyModifier = "ConsistencyModifier.DEFAULT"
+	// Lock acquires locks on the element so
+	// that concurrent changes are consistent.
+	Lock ConsistencyModifier = "ConsistencyModifier.LOCK"
+	// Fork creates a new edge or property rather than
+	// changing the existing one. This avoids locking.
+	Fork ConsistencyModifier = "ConsistencyModifier.FORK"
+)
+
+// String will convert ConsistencyModifier to a string.
+func (c ConsistencyModifier) String() string {
+	return string(c)
+}
@@ -59,6 +59,15 @@ const (
 	Precision DataType = "Precision.class"
 	// Geoshape represents the class for Geoshape
 	Geoshape DataType = "Geoshape.class"
+	// Date represents the class for Date
+	Date DataType = "Date.class"
+	// Instant represents the class for Instant
+	Instant DataType = "java.time.Instant.class"
+	// UUID represents the class for UUID
+	UUID DataType = "UUID.class"
+	// Object represents the class for Object
+	// which allows a value of any type
+	Object DataType = "Object.class"
 )
 
 func (d DataType) String() string {
@@ -0,0 +1,39 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graph
+
+// AddConnection allows edges with the edge label to connect
+// vertices with the out vertex label to vertices with the
+// in vertex label. When 'schema.constraints' is enabled,
+// only edges between connected labels may be added.
+func (graph String) AddConnection(edgeLabel, outVertexLabel, inVertexLabel string) String {
+	mgmt := NewManagement()
+	graph = graph.append(".addConnection(" + mgmt.GetEdgeLabel(edgeLabel).String() + "," +
+		mgmt.GetVertexLabel(outVertexLabel).String() + "," + mgmt.GetVertexLabel(inVertexLabel).String() + ")")
+	return graph
+}
+
+// MappedConnections returns the connections
+// that have been added to an edge label.
+func (graph String) MappedConnections() String {
+	graph = graph.append(".mappedConnections()")
+	return graph
+}
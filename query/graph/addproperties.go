@@ -0,0 +1,43 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graph
+
+// AddProperties allows vertices with the label to have
+// properties with the given keys. When 'schema.constraints'
+// is enabled, only properties added this way are allowed.
+func (graph String) AddProperties(vertexLabel string, keys ...string) String {
+	graph = graph.append(".addProperties(" + NewManagement().GetVertexLabel(vertexLabel).String() + propertyKeys(keys) + ")")
+	return graph
+}
+
+// AddEdgeProperties allows edges with the
+// label to have properties with the given keys.
+func (graph String) AddEdgeProperties(edgeLabel string, keys ...string) String {
+	graph = graph.append(".addProperties(" + NewManagement().GetEdgeLabel(edgeLabel).String() + propertyKeys(keys) + ")")
+	return graph
+}
+
+// MappedProperties returns the property keys that
+// have been added to a vertex or edge label.
+func (graph String) MappedProperties() String {
+	graph = graph.append(".mappedProperties()")
+	return graph
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graph
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestAddProperties(t *testing.T) {
+	Convey("Given a *String that represents the management system", t, func() {
+		graph := NewManagement()
+		Convey("When 'AddProperties' is called", func() {
+			result := graph.AddProperties("person", "name", "age")
+			Convey("Then result should add the keys to the vertex label", func() {
+				So(result.String(), ShouldEqual, "mgmt.addProperties(mgmt.getVertexLabel(\"person\"),"+
+					"mgmt.getPropertyKey(\"name\"),mgmt.getPropertyKey(\"age\"))")
+			})
+		})
+		Convey("When 'AddEdgeProperties' is called", func() {
+			result := graph.AddEdgeProperties("knows", "since")
+			Convey("Then result should add the keys to the edge label", func() {
+				So(result.String(), ShouldEqual, "mgmt.addProperties(mgmt.getEdgeLabel(\"knows\"),mgmt.getPropertyKey(\"since\"))")
+			})
+		})
+	})
+}
+
+func TestMappedProperties(t *testing.T) {
+	Convey("Given a *String that represents a vertex label", t, func() {
+		graph := NewManagement().GetVertexLabel("person")
+		Convey("When 'MappedProperties' is called", func() {
+			result := graph.MappedProperties()
+			Convey("Then result should equal 'mgmt.getVertexLabel(\"person\").mappedProperties()'", func() {
+				So(result.String(), ShouldEqual, "mgmt.getVertexLabel(\"person\").mappedProperties()")
+			})
+		})
+	})
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graph
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/northwesternmutual/grammes/query/schemastatus"
+)
+
+// JanusGraph:
+// https://docs.janusgraph.org/schema/index-management/index-reindexing/
+
+// AwaitGraphIndexStatus starts a watcher that waits for the
+// keys of the graph index to reach a status. The watcher is
+// configured with Status and Timeout and started with Call.
+func AwaitGraphIndexStatus(name string) (graph String) {
+	graph = String("ManagementSystem.awaitGraphIndexStatus(graph," + quote(name) + ")")
+	return
+}
+
+// AwaitRelationIndexStatus starts a watcher that waits for the
+// vertex-centric index on the relation type to reach a status.
+func AwaitRelationIndexStatus(name, relationType string) (graph String) {
+	graph = String("ManagementSystem.awaitRelationIndexStatus(graph," + quote(name) + "," + quote(relationType) + ")")
+	return
+}
+
+// Status sets the statuses the watcher waits for.
+func (graph String) Status(statuses ...schemastatus.SchemaStatus) String {
+	graph = graph.append(".status(")
+	for i, s := range statuses {
+		if i > 0 {
+			graph = graph.append(",")
+		}
+		graph = graph.append(s.String())
+	}
+	graph = graph.append(")")
+	return graph
+}
+
+// Timeout sets how long the watcher waits before giving up.
+func (graph String) Timeout(timeout time.Duration) String {
+	graph = graph.append(".timeout(" + strconv.FormatInt(int64(timeout/time.Millisecond), 10) + ",java.time.temporal.ChronoUnit.MILLIS)")
+	return graph
+}
+
+// Call starts the watcher and blocks until it returns a report.
+func (graph String) Call() String {
+	graph = graph.append(".call()")
+	return graph
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graph
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/query/schemastatus"
+)
+
+func TestAwaitGraphIndexStatus(t *testing.T) {
+	Convey("When 'AwaitGraphIndexStatus' is called", t, func() {
+		result := AwaitGraphIndexStatus("byName").Status(schemastatus.Registered, schemastatus.Enabled).Timeout(time.Minute).Call()
+		Convey("Then result should configure and call the watcher", func() {
+			So(result.String(), ShouldEqual, "ManagementSystem.awaitGraphIndexStatus(graph,\"byName\")"+
+				".status(SchemaStatus.REGISTERED,SchemaStatus.ENABLED)"+
+				".timeout(60000,java.time.temporal.ChronoUnit.MILLIS).call()")
+		})
+	})
+}
+
+func TestAwaitRelationIndexStatus(t *testing.T) {
+	Convey("When 'AwaitRelationIndexStatus' is called", t, func() {
+		result := AwaitRelationIndexStatus("knowsBySince", "knows").Status(schemastatus.Enabled).Call()
+		Convey("Then result should reference the relation type", func() {
+			So(result.String(), ShouldEqual, "ManagementSystem.awaitRelationIndexStatus(graph,\"knowsBySince\",\"knows\")"+
+				".status(SchemaStatus.ENABLED).call()")
+		})
+	})
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graph
+
+import (
+	"strings"
+
+	"github.com/northwesternmutual/grammes/query/direction"
+	"github.com/northwesternmutual/grammes/query/order"
+)
+
+// JanusGraph:
+// https://docs.janusgraph.org/schema/index-management/index-performance/#vertex-centric-indexes
+
+// BuildEdgeIndex builds a vertex-centric index with the given
+// name on the edge label. Edges in the direction are sorted
+// by the keys in the given order.
+func (graph String) BuildEdgeIndex(edgeLabel, name string, dir direction.Direction, ord order.Order, keys ...string) String {
+	graph = graph.append(".buildEdgeIndex(" + NewManagement().GetEdgeLabel(edgeLabel).String() +
+		"," + quote(name) + "," + dir.String() + "," + ord.String() + propertyKeys(keys) + ")")
+	return graph
+}
+
+// BuildPropertyIndex builds a vertex-centric index with the
+// given name on the property key. Properties are sorted by
+// the keys in the given order.
+func (graph String) BuildPropertyIndex(propertyKey, name string, ord order.Order, keys ...string) String {
+	graph = graph.append(".buildPropertyIndex(" + NewManagement().GetPropertyKey(propertyKey).String() +
+		"," + quote(name) + "," + ord.String() + propertyKeys(keys) + ")")
+	return graph
+}
+
+// propertyKeys renders the property keys
+// as trailing parameters.
+func propertyKeys(keys []string) string {
+	var b strings.Builder
+	for _, k := range keys {
+		b.WriteString("," + NewManagement().GetPropertyKey(k).String())
+	}
+	return b.String()
+}
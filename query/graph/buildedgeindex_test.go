@@ -0,0 +1,56 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graph
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/query/direction"
+	"github.com/northwesternmutual/grammes/query/order"
+)
+
+func TestBuildEdgeIndex(t *testing.T) {
+	Convey("Given a *String that represents the management system", t, func() {
+		graph := NewManagement()
+		Convey("When 'BuildEdgeIndex' is called", func() {
+			result := graph.BuildEdgeIndex("knows", "knowsBySince", direction.Both, order.Desc, "since")
+			Convey("Then result should build the vertex-centric index", func() {
+				So(result.String(), ShouldEqual, "mgmt.buildEdgeIndex(mgmt.getEdgeLabel(\"knows\"),"+
+					"\"knowsBySince\",BOTH,desc,mgmt.getPropertyKey(\"since\"))")
+			})
+		})
+	})
+}
+
+func TestBuildPropertyIndex(t *testing.T) {
+	Convey("Given a *String that represents the management system", t, func() {
+		graph := NewManagement()
+		Convey("When 'BuildPropertyIndex' is called", func() {
+			result := graph.BuildPropertyIndex("location", "locationByTime", order.Asc, "startTime", "endTime")
+			Convey("Then result should build the vertex-centric index", func() {
+				So(result.String(), ShouldEqual, "mgmt.buildPropertyIndex(mgmt.getPropertyKey(\"location\"),"+
+					"\"locationByTime\",asc,mgmt.getPropertyKey(\"startTime\"),mgmt.getPropertyKey(\"endTime\"))")
+			})
+		})
+	})
+}
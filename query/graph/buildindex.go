@@ -0,0 +1,83 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graph
+
+import (
+	"github.com/northwesternmutual/grammes/query/mapping"
+)
+
+// JanusGraph:
+// https://docs.janusgraph.org/schema/index-management/index-performance/
+
+// BuildIndex starts building a graph index with the given
+// name on the element class. This is either Vertex.class
+// or Edge.class. Keys are added with AddKey and the index
+// is finished with BuildCompositeIndex or BuildMixedIndex.
+func (graph String) BuildIndex(name, elementClass string) String {
+	graph = graph.append(".buildIndex(" + quote(name) + "," + elementClass + ")")
+	return graph
+}
+
+// AddKey adds the property key to the index being built.
+// A mapping may be given for keys in a mixed index to
+// choose how strings are indexed by the backing index.
+func (graph String) AddKey(key string, mappings ...mapping.Mapping) String {
+	graph = graph.append(".addKey(" + NewManagement().GetPropertyKey(key).String())
+	for _, m := range mappings {
+		graph = graph.append("," + m.Parameter())
+	}
+	graph = graph.append(")")
+	return graph
+}
+
+// Unique makes the composite index being built enforce
+// that its keys are unique across the graph.
+func (graph String) Unique() String {
+	graph = graph.append(".unique()")
+	return graph
+}
+
+// IndexOnly restricts the index being built
+// to elements with the given vertex label.
+func (graph String) IndexOnly(label string) String {
+	graph = graph.append(".indexOnly(" + NewManagement().GetVertexLabel(label).String() + ")")
+	return graph
+}
+
+// IndexOnlyEdge restricts the index being built
+// to edges with the given edge label.
+func (graph String) IndexOnlyEdge(label string) String {
+	graph = graph.append(".indexOnly(" + NewManagement().GetEdgeLabel(label).String() + ")")
+	return graph
+}
+
+// BuildCompositeIndex finishes building a composite index.
+func (graph String) BuildCompositeIndex() String {
+	graph = graph.append(".buildCompositeIndex()")
+	return graph
+}
+
+// BuildMixedIndex finishes building a mixed index that
+// is stored in the given backing index, such as "search".
+func (graph String) BuildMixedIndex(backingIndex string) String {
+	graph = graph.append(".buildMixedIndex(" + quote(backingIndex) + ")")
+	return graph
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graph
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/query/mapping"
+)
+
+func TestBuildIndex(t *testing.T) {
+	Convey("Given a *String that represents the management system", t, func() {
+		graph := NewManagement()
+		Convey("When a unique composite index is built", func() {
+			result := graph.BuildIndex("byName", "Vertex.class").AddKey("name").Unique().IndexOnly("person").BuildCompositeIndex()
+			Convey("Then result should build the composite index", func() {
+				So(result.String(), ShouldEqual, "mgmt.buildIndex(\"byName\",Vertex.class)"+
+					".addKey(mgmt.getPropertyKey(\"name\")).unique()"+
+					".indexOnly(mgmt.getVertexLabel(\"person\")).buildCompositeIndex()")
+			})
+		})
+		Convey("When a mixed index is built with mappings", func() {
+			result := graph.BuildIndex("search", "Vertex.class").AddKey("bio", mapping.Text).AddKey("age").BuildMixedIndex("search")
+			Convey("Then result should build the mixed index", func() {
+				So(result.String(), ShouldEqual, "mgmt.buildIndex(\"search\",Vertex.class)"+
+					".addKey(mgmt.getPropertyKey(\"bio\"),Mapping.TEXT.asParameter())"+
+					".addKey(mgmt.getPropertyKey(\"age\")).buildMixedIndex(\"search\")")
+			})
+		})
+		Convey("When an edge index is restricted to an edge label", func() {
+			result := graph.BuildIndex("bySince", "Edge.class").AddKey("since").IndexOnlyEdge("knows").BuildCompositeIndex()
+			Convey("Then result should reference the edge label", func() {
+				So(result.String(), ShouldEqual, "mgmt.buildIndex(\"bySince\",Edge.class)"+
+					".addKey(mgmt.getPropertyKey(\"since\"))"+
+					".indexOnly(mgmt.getEdgeLabel(\"knows\")).buildCompositeIndex()")
+			})
+		})
+	})
+}
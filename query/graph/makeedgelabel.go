@@ -22,7 +22,14 @@ package graph
 
 // MakeEdgeLabel create a label for a new edge.
 func (graph String) MakeEdgeLabel(label string) String {
-	graph = graph.append(".makeEdgeLabel(\"" + label + "\")")
+	graph = graph.append(".makeEdgeLabel(" + quote(label) + ")")
 
 	return graph
 }
+
+// Unidirected makes the edge label being made unidirected
+// so its edges can only be traversed from the out vertex.
+func (graph String) Unidirected() String {
+	graph = graph.append(".unidirected()")
+	return graph
+}
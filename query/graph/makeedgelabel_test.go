@@ -37,3 +37,15 @@ func TestMakeEdgeLabel(t *testing.T) {
 		})
 	})
 }
+
+func TestUnidirected(t *testing.T) {
+	Convey("Given a *String that represents the verbose graph traversal", t, func() {
+		graph := NewManagement().MakeEdgeLabel("follows")
+		Convey("When 'Unidirected' is called", func() {
+			result := graph.Unidirected()
+			Convey("Then result should equal 'mgmt.makeEdgeLabel('follows').unidirected()'", func() {
+				So(result.String(), ShouldEqual, "mgmt.makeEdgeLabel(\"follows\").unidirected()")
+			})
+		})
+	})
+}
@@ -22,6 +22,20 @@ package graph
 
 // MakeVertexLabel will create a label for a vertex in the graph.
 func (graph String) MakeVertexLabel(name string) String {
-	graph = graph.append(".makeVertexLabel(\"" + name + "\")")
+	graph = graph.append(".makeVertexLabel(" + quote(name) + ")")
+	return graph
+}
+
+// SetStatic makes the vertex label being made static so
+// vertices with the label cannot be changed once created.
+func (graph String) SetStatic() String {
+	graph = graph.append(".setStatic()")
+	return graph
+}
+
+// Partition makes the vertex label being made partitioned
+// so its vertices are spread across the cluster.
+func (graph String) Partition() String {
+	graph = graph.append(".partition()")
 	return graph
 }
@@ -37,3 +37,15 @@ func TestMakeVertexLabel(t *testing.T) {
 		})
 	})
 }
+
+func TestSetStatic(t *testing.T) {
+	Convey("Given a *String that represents the verbose graph traversal", t, func() {
+		graph := NewManagement().MakeVertexLabel("tag")
+		Convey("When 'SetStatic' and 'Partition' are called", func() {
+			result := graph.SetStatic().Partition()
+			Convey("Then result should equal 'mgmt.makeVertexLabel('tag').setStatic().partition()'", func() {
+				So(result.String(), ShouldEqual, "mgmt.makeVertexLabel(\"tag\").setStatic().partition()")
+			})
+		})
+	})
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graph
+
+// managementVariable is the name of the variable that
+// holds the open management system in a schema script.
+const managementVariable = "mgmt"
+
+// NewManagement returns a reference to the management system
+// stored in the 'mgmt' variable. This is used in scripts that
+// open management once with 'mgmt = graph.openManagement()'
+// so that every change is made in the same transaction.
+func NewManagement() (graph String) {
+	graph = managementVariable
+	return
+}
+
+// GetPropertyKey returns the property key with the given name.
+func (graph String) GetPropertyKey(name string) String {
+	graph = graph.append(".getPropertyKey(" + quote(name) + ")")
+	return graph
+}
+
+// GetVertexLabel returns the vertex label with the given name.
+func (graph String) GetVertexLabel(name string) String {
+	graph = graph.append(".getVertexLabel(" + quote(name) + ")")
+	return graph
+}
+
+// GetEdgeLabel returns the edge label with the given name.
+func (graph String) GetEdgeLabel(name string) String {
+	graph = graph.append(".getEdgeLabel(" + quote(name) + ")")
+	return graph
+}
+
+// GetRelationType returns the edge label or
+// property key with the given name.
+func (graph String) GetRelationType(name string) String {
+	graph = graph.append(".getRelationType(" + quote(name) + ")")
+	return graph
+}
+
+// GetGraphIndex returns the graph index with the given name.
+func (graph String) GetGraphIndex(name string) String {
+	graph = graph.append(".getGraphIndex(" + quote(name) + ")")
+	return graph
+}
+
+// GetRelationIndex returns the vertex-centric index
+// with the given name that's built on the relation type.
+func (graph String) GetRelationIndex(relationType, name string) String {
+	graph = graph.append(".getRelationIndex(" + NewManagement().GetRelationType(relationType).String() + "," + quote(name) + ")")
+	return graph
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graph
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestNewManagement(t *testing.T) {
+	Convey("When 'NewManagement' is called", t, func() {
+		result := NewManagement()
+		Convey("Then result should equal 'mgmt'", func() {
+			So(result.String(), ShouldEqual, "mgmt")
+		})
+	})
+}
+
+func TestGetPropertyKey(t *testing.T) {
+	Convey("Given a *String that represents the management system", t, func() {
+		graph := NewManagement()
+		Convey("When 'GetPropertyKey' is called", func() {
+			result := graph.GetPropertyKey("name")
+			Convey("Then result should equal 'mgmt.getPropertyKey(\"name\")'", func() {
+				So(result.String(), ShouldEqual, "mgmt.getPropertyKey(\"name\")")
+			})
+		})
+	})
+}
+
+func TestGetVertexLabel(t *testing.T) {
+	Convey("Given a *String that represents the management system", t, func() {
+		graph := NewManagement()
+		Convey("When 'GetVertexLabel' is called", func() {
+			result := graph.GetVertexLabel("person")
+			Convey("Then result should equal 'mgmt.getVertexLabel(\"person\")'", func() {
+				So(result.String(), ShouldEqual, "mgmt.getVertexLabel(\"person\")")
+			})
+		})
+	})
+}
+
+func TestGetEdgeLabel(t *testing.T) {
+	Convey("Given a *String that represents the management system", t, func() {
+		graph := NewManagement()
+		Convey("When 'GetEdgeLabel' is called", func() {
+			result := graph.GetEdgeLabel("knows")
+			Convey("Then result should equal 'mgmt.getEdgeLabel(\"knows\")'", func() {
+				So(result.String(), ShouldEqual, "mgmt.getEdgeLabel(\"knows\")")
+			})
+		})
+	})
+}
+
+func TestGetGraphIndex(t *testing.T) {
+	Convey("Given a *String that represents the management system", t, func() {
+		graph := NewManagement()
+		Convey("When 'GetGraphIndex' is called", func() {
+			result := graph.GetGraphIndex("byName")
+			Convey("Then result should equal 'mgmt.getGraphIndex(\"byName\")'", func() {
+				So(result.String(), ShouldEqual, "mgmt.getGraphIndex(\"byName\")")
+			})
+		})
+	})
+}
+
+func TestGetRelationIndex(t *testing.T) {
+	Convey("Given a *String that represents the management system", t, func() {
+		graph := NewManagement()
+		Convey("When 'GetRelationIndex' is called", func() {
+			result := graph.GetRelationIndex("knows", "knowsBySince")
+			Convey("Then result should reference the relation type", func() {
+				So(result.String(), ShouldEqual, "mgmt.getRelationIndex(mgmt.getRelationType(\"knows\"),\"knowsBySince\")")
+			})
+		})
+	})
+}
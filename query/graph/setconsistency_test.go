@@ -0,0 +1,47 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graph
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/query/consistency"
+)
+
+func TestSetConsistency(t *testing.T) {
+	Convey("Given a *String that represents the management system", t, func() {
+		graph := NewManagement()
+		Convey("When 'SetConsistency' is called", func() {
+			result := graph.SetConsistency(NewManagement().GetPropertyKey("email"), consistency.Lock)
+			Convey("Then result should lock the property key", func() {
+				So(result.String(), ShouldEqual, "mgmt.setConsistency(mgmt.getPropertyKey(\"email\"),ConsistencyModifier.LOCK)")
+			})
+		})
+		Convey("When 'GetConsistency' is called", func() {
+			result := graph.GetConsistency(NewManagement().GetPropertyKey("email"))
+			Convey("Then result should equal 'mgmt.getConsistency(mgmt.getPropertyKey(\"email\"))'", func() {
+				So(result.String(), ShouldEqual, "mgmt.getConsistency(mgmt.getPropertyKey(\"email\"))")
+			})
+		})
+	})
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graph
+
+import (
+	"strconv"
+	"time"
+)
+
+// SetTTL sets how long vertices, edges, or properties with
+// the given schema element live before they're removed.
+// The element is referenced through the management system
+// such as with GetEdgeLabel. The TTL is rounded down to seconds.
+func (graph String) SetTTL(element String, ttl time.Duration) String {
+	graph = graph.append(".setTTL(" + element.String() + ",java.time.Duration.ofSeconds(" +
+		strconv.FormatInt(int64(ttl/time.Second), 10) + "))")
+	return graph
+}
+
+// GetTTL returns how long elements with the schema element live.
+func (graph String) GetTTL(element String) String {
+	graph = graph.append(".getTTL(" + element.String() + ")")
+	return graph
+}
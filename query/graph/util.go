@@ -20,6 +20,11 @@
 
 package graph
 
+import "github.com/northwesternmutual/grammes/query"
+
+// quote turns a string into an escaped string literal.
+var quote = query.Quote
+
 // NewGraph will return a new Graphstring ready to
 // be used in a client object.
 func NewGraph() (graph String) {
@@ -0,0 +1,245 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package query
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// BindingPrefix starts the names of the bindings made
+// by Parameterize and by the Bind method of traversals.
+const BindingPrefix = "_p"
+
+// Quote returns the string as a double quoted Groovy
+// literal. Backslashes, quotes, dollar signs and control
+// characters are escaped so the value can't end the
+// literal early or be interpolated by the server.
+func Quote(s string) string {
+	var b strings.Builder
+	b.Grow(len(s) + 2)
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '\\', '"', '$':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '\b':
+			b.WriteString(`\b`)
+		case '\f':
+			b.WriteString(`\f`)
+		default:
+			if r < 0x20 || r == 0x7f {
+				b.WriteString(`\u`)
+				hex := strconv.FormatInt(int64(r), 16)
+				b.WriteString(strings.Repeat("0", 4-len(hex)) + hex)
+				continue
+			}
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// Parameterize moves the double quoted string literals of a
+// hand written script into bindings named _p0, _p1 and so on,
+// so that user values never become part of the script and the
+// server can cache it. The returned bindings are a copy of the
+// given ones with the new bindings added, and names already in
+// use are skipped. Literals that interpolate values, property
+// names such as x."name", comments and single quoted strings
+// are left in the script. Slashy strings such as /x"/ aren't
+// understood, so scripts that use them shouldn't be given.
+func Parameterize(script string, bindings map[string]string) (string, map[string]string) {
+	bound := make(map[string]string, len(bindings))
+	for k, v := range bindings {
+		bound[k] = v
+	}
+
+	var (
+		b    strings.Builder
+		next int
+	)
+	b.Grow(len(script))
+
+	for i := 0; i < len(script); {
+		switch {
+		case strings.HasPrefix(script[i:], "//"):
+			end := strings.IndexByte(script[i:], '\n')
+			if end < 0 {
+				end = len(script) - i
+			}
+			b.WriteString(script[i : i+end])
+			i += end
+		case strings.HasPrefix(script[i:], "/*"):
+			end := strings.Index(script[i+2:], "*/")
+			if end < 0 {
+				end = len(script) - i
+			} else {
+				end += 4
+			}
+			b.WriteString(script[i : i+end])
+			i += end
+		case strings.HasPrefix(script[i:], `"""`), strings.HasPrefix(script[i:], "'''"):
+			end := strings.Index(script[i+3:], script[i:i+3])
+			if end < 0 {
+				end = len(script) - i
+			} else {
+				end += 6
+			}
+			b.WriteString(script[i : i+end])
+			i += end
+		case script[i] == '\'':
+			end := literalEnd(script, i)
+			b.WriteString(script[i:end])
+			i = end
+		case script[i] == '"':
+			end := literalEnd(script, i)
+			value, ok := unquote(script[i:end])
+			if !ok || precededBy(script, i, '.') {
+				b.WriteString(script[i:end])
+				i = end
+				continue
+			}
+
+			name := BindingPrefix + strconv.Itoa(next)
+			for _, taken := bound[name]; taken; _, taken = bound[name] {
+				next++
+				name = BindingPrefix + strconv.Itoa(next)
+			}
+			next++
+			bound[name] = value
+
+			// A bare name before a colon would be taken
+			// as the key itself in a Groovy map literal.
+			if followedBy(script, end, ':') {
+				name = "(" + name + ")"
+			}
+			b.WriteString(name)
+			i = end
+		default:
+			b.WriteByte(script[i])
+			i++
+		}
+	}
+
+	return b.String(), bound
+}
+
+// literalEnd returns the index after the quote
+// that ends the literal starting at start.
+func literalEnd(script string, start int) int {
+	quote := script[start]
+	for i := start + 1; i < len(script); i++ {
+		switch script[i] {
+		case '\\':
+			i++
+		case quote:
+			return i + 1
+		}
+	}
+	return len(script)
+}
+
+// unquote returns the value of a double quoted literal. It
+// isn't ok when the literal is unterminated, interpolates a
+// value or uses an escape that isn't understood.
+func unquote(literal string) (string, bool) {
+	if len(literal) < 2 || literal[len(literal)-1] != '"' {
+		return "", false
+	}
+	s := literal[1 : len(literal)-1]
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '$' {
+			return "", false
+		}
+		if c != '\\' {
+			b.WriteByte(c)
+			continue
+		}
+
+		i++
+		if i == len(s) {
+			return "", false
+		}
+		switch s[i] {
+		case '\\', '"', '\'', '$':
+			b.WriteByte(s[i])
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		case 't':
+			b.WriteByte('\t')
+		case 'b':
+			b.WriteByte('\b')
+		case 'f':
+			b.WriteByte('\f')
+		case 'u':
+			if i+5 > len(s) {
+				return "", false
+			}
+			r, err := strconv.ParseUint(s[i+1:i+5], 16, 32)
+			if err != nil || !utf8.ValidRune(rune(r)) {
+				return "", false
+			}
+			b.WriteRune(rune(r))
+			i += 4
+		default:
+			return "", false
+		}
+	}
+
+	return b.String(), true
+}
+
+// precededBy reports whether the last character
+// before i that isn't a space is c.
+func precededBy(script string, i int, c byte) bool {
+	for i--; i >= 0; i-- {
+		if script[i] != ' ' && script[i] != '\t' {
+			return script[i] == c
+		}
+	}
+	return false
+}
+
+// followedBy reports whether the first character
+// from i on that isn't a space is c.
+func followedBy(script string, i int, c byte) bool {
+	for ; i < len(script); i++ {
+		if script[i] != ' ' && script[i] != '\t' {
+			return script[i] == c
+		}
+	}
+	return false
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package query
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestQuote(t *testing.T) {
+	Convey("Given a string that would break out of a literal", t, func() {
+		s := "a\"b\\c${d}\n\x01"
+		Convey("When Quote is called", func() {
+			result := Quote(s)
+			Convey("Then every special character should be escaped", func() {
+				So(result, ShouldEqual, `"a\"b\\c\${d}\n\u0001"`)
+			})
+		})
+	})
+}
+
+func TestParameterize(t *testing.T) {
+	Convey("Given a script with string literals", t, func() {
+		script := `g.V().has("name",` + Quote("da\"mi$en") + `).out("knows")`
+		Convey("When Parameterize is called", func() {
+			result, bindings := Parameterize(script, nil)
+			Convey("Then the literals should become bindings", func() {
+				So(result, ShouldEqual, "g.V().has(_p0,_p1).out(_p2)")
+				So(bindings, ShouldResemble, map[string]string{
+					"_p0": "name", "_p1": "da\"mi$en", "_p2": "knows",
+				})
+			})
+		})
+	})
+
+	Convey("Given a script and bindings that use a generated name", t, func() {
+		script := `g.V(_p0).has("name","damien")`
+		Convey("When Parameterize is called", func() {
+			result, bindings := Parameterize(script, map[string]string{"_p0": "1"})
+			Convey("Then the name in use should be skipped", func() {
+				So(result, ShouldEqual, "g.V(_p0).has(_p1,_p2)")
+				So(bindings, ShouldResemble, map[string]string{
+					"_p0": "1", "_p1": "name", "_p2": "damien",
+				})
+			})
+		})
+	})
+
+	Convey("Given a script with map keys, property names and interpolation", t, func() {
+		script := `g.mergeV(["name":"x"]).map{it."name" + "${y}" + 'z'} // "c"`
+		Convey("When Parameterize is called", func() {
+			result, bindings := Parameterize(script, nil)
+			Convey("Then only the plain values should become bindings", func() {
+				So(result, ShouldEqual, `g.mergeV([(_p0):_p1]).map{it."name" + "${y}" + 'z'} // "c"`)
+				So(bindings, ShouldHaveLength, 2)
+			})
+		})
+	})
+}
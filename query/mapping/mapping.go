@@ -0,0 +1,64 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+/*
+Package mapping contains the object to choose how a key is indexed in a mixed index.
+
+See: https://docs.janusgraph.org/index-backend/text-search/
+
+Mapping and its constant values are the string equivalent of
+JanusGraph's Mapping when referencing them in a traversal/query.
+
+A note about Mapping:
+
+This object implements the Parameter interfaces used by graph traversals.
+*/
+package mapping
+
+// JanusGraph:
+// https://javadoc.io/doc/org.janusgraph/janusgraph-core/latest/org/janusgraph/core/schema/Mapping.html
+
+// Mapping designates how a string property key
+// is tokenized and stored in a mixed index.
+type Mapping string
+
+const (
+	// Default uses the default mapping for the data type of the key.
+	Default Mapping = "Mapping.DEFAULT"
+	// Text tokenizes the string for full-text search.
+	Text Mapping = "Mapping.TEXT"
+	// String indexes the string as a whole for exact and prefix search.
+	String Mapping = "Mapping.STRING"
+	// TextString indexes the string both as text and as a whole.
+	TextString Mapping = "Mapping.TEXTSTRING"
+	// PrefixTree indexes a geoshape using a prefix tree.
+	PrefixTree Mapping = "Mapping.PREFIX_TREE"
+)
+
+// String will convert Mapping to a string.
+func (m Mapping) String() string {
+	return string(m)
+}
+
+// Parameter returns the mapping in the form
+// that is given to addKey() when building an index.
+func (m Mapping) Parameter() string {
+	return string(m) + ".asParameter()"
+}
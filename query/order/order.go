@@ -0,0 +1,53 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+/*
+Package order contains the object to control how traversers are sorted.
+
+See: http://tinkerpop.apache.org/javadocs/3.3.3/core/org/apache/tinkerpop/gremlin/process/traversal/Order.html
+
+Order is used when sorting the objects of a traversal stream
+or the sort keys of a JanusGraph vertex-centric index.
+
+A note about Order:
+
+This object implements the Parameter interfaces used by graph traversals.
+*/
+package order
+
+// Tinkerpop:
+// http://tinkerpop.apache.org/javadocs/3.3.3/core/org/apache/tinkerpop/gremlin/process/traversal/Order.html
+
+// Order provides comparators for ordering traversers.
+type Order string
+
+const (
+	// Asc orders in ascending fashion.
+	Asc Order = "asc"
+	// Desc orders in descending fashion.
+	Desc Order = "desc"
+	// Shuffle orders in random fashion.
+	Shuffle Order = "shuffle"
+)
+
+// String will convert Order to a string.
+func (o Order) String() string {
+	return string(o)
+}
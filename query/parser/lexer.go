@@ -0,0 +1,244 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package parser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+)
+
+// kind is the kind of a token of a Gremlin script.
+type kind int
+
+const (
+	eof kind = iota
+	ident
+	number
+	text
+	// code is Groovy that runs on the server, which is
+	// a lambda or a string that interpolates values.
+	code
+	punct
+)
+
+// lexeme is a part of a Gremlin script. The value of text
+// is the string without its quotes and the value of code
+// describes it. raw is the token as it's written.
+type lexeme struct {
+	kind   kind
+	value  string
+	raw    string
+	offset int
+}
+
+func (t lexeme) String() string {
+	if t.kind == eof {
+		return "end of script"
+	}
+	return strconv.Quote(t.raw)
+}
+
+// lex splits the script into tokens, leaving out
+// the whitespace and comments between them.
+func lex(script string) ([]lexeme, error) {
+	var tokens []lexeme
+	for i := 0; i < len(script); {
+		c := script[i]
+		start := i
+
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+			continue
+		case strings.HasPrefix(script[i:], "//"):
+			for i < len(script) && script[i] != '\n' {
+				i++
+			}
+			continue
+		case strings.HasPrefix(script[i:], "/*"):
+			end := strings.Index(script[i+2:], "*/")
+			if end < 0 {
+				return nil, syntaxError(start, "unterminated comment")
+			}
+			i += end + 4
+			continue
+		case isLetter(c):
+			for i < len(script) && (isLetter(script[i]) || isDigit(script[i])) {
+				i++
+			}
+			tokens = append(tokens, lexeme{kind: ident, value: script[start:i]})
+		case isDigit(c) || c == '-' && i+1 < len(script) && isDigit(script[i+1]):
+			i = lexNumber(script, i+1)
+			tokens = append(tokens, lexeme{kind: number, value: script[start:i]})
+		case c == '"' || c == '\'':
+			t, end, err := lexString(script, i)
+			if err != nil {
+				return nil, err
+			}
+			i = end
+			tokens = append(tokens, t)
+		case c == '{':
+			end, err := lexLambda(script, i)
+			if err != nil {
+				return nil, err
+			}
+			i = end
+			tokens = append(tokens, lexeme{kind: code, value: "lambda"})
+		case strings.IndexByte("().,[]:;", c) >= 0:
+			i++
+			tokens = append(tokens, lexeme{kind: punct, value: string(c)})
+		default:
+			r, _ := utf8.DecodeRuneInString(script[i:])
+			return nil, syntaxError(start, fmt.Sprintf("unexpected %q", r))
+		}
+
+		t := &tokens[len(tokens)-1]
+		t.offset, t.raw = start, script[start:i]
+	}
+
+	return append(tokens, lexeme{kind: eof, offset: len(script)}), nil
+}
+
+// lexNumber returns the end of the number, with its
+// fraction, exponent and type suffix such as L.
+func lexNumber(script string, i int) int {
+	digits := func() {
+		for i < len(script) && (isDigit(script[i]) || script[i] == '_') {
+			i++
+		}
+	}
+
+	digits()
+	if i+1 < len(script) && script[i] == '.' && isDigit(script[i+1]) {
+		i++
+		digits()
+	}
+	if i < len(script) && (script[i] == 'e' || script[i] == 'E') {
+		i++
+		if i < len(script) && (script[i] == '-' || script[i] == '+') {
+			i++
+		}
+		digits()
+	}
+	if i < len(script) && strings.IndexByte("lLiIgGfFdD", script[i]) >= 0 {
+		i++
+	}
+	return i
+}
+
+// lexString reads the quoted string starting at i. Double quoted
+// strings with an unescaped $ interpolate values, so they're code.
+func lexString(script string, i int) (lexeme, int, error) {
+	quote := script[i]
+	start := i
+	interpolated := false
+
+	var b strings.Builder
+	for i++; i < len(script); i++ {
+		c := script[i]
+		switch {
+		case c == quote:
+			if interpolated {
+				return lexeme{kind: code, value: "interpolated string"}, i + 1, nil
+			}
+			return lexeme{kind: text, value: b.String()}, i + 1, nil
+		case c == '$' && quote == '"':
+			interpolated = true
+		case c == '\\':
+			if i+1 >= len(script) {
+				return lexeme{}, 0, syntaxError(i, "unterminated string")
+			}
+			i++
+			switch e := script[i]; e {
+			case 'n':
+				b.WriteByte('\n')
+			case 'r':
+				b.WriteByte('\r')
+			case 't':
+				b.WriteByte('\t')
+			case 'b':
+				b.WriteByte('\b')
+			case 'f':
+				b.WriteByte('\f')
+			case 'u':
+				if i+5 > len(script) {
+					return lexeme{}, 0, syntaxError(i, "invalid escape")
+				}
+				r, err := strconv.ParseUint(script[i+1:i+5], 16, 32)
+				if err != nil {
+					return lexeme{}, 0, syntaxError(i, "invalid escape")
+				}
+				b.WriteRune(rune(r))
+				i += 4
+			case '\\', '\'', '"', '$':
+				b.WriteByte(e)
+			default:
+				return lexeme{}, 0, syntaxError(i, "invalid escape")
+			}
+		default:
+			b.WriteByte(c)
+		}
+	}
+
+	return lexeme{}, 0, syntaxError(start, "unterminated string")
+}
+
+// lexLambda returns the end of the closure starting at i,
+// skipping the braces inside of its strings.
+func lexLambda(script string, i int) (int, error) {
+	start := i
+	depth := 0
+	for ; i < len(script); i++ {
+		switch script[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return i + 1, nil
+			}
+		case '"', '\'':
+			_, end, err := lexString(script, i)
+			if err != nil {
+				return 0, err
+			}
+			i = end - 1
+		}
+	}
+	return 0, syntaxError(start, "unterminated lambda")
+}
+
+func isLetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '_'
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// syntaxError returns a ParseError at the offset wrapping ErrSyntax.
+func syntaxError(offset int, msg string) error {
+	return gremerror.NewParseError(offset, fmt.Errorf("%s: %w", msg, gremerror.ErrSyntax))
+}
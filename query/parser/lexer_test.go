@@ -0,0 +1,67 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package parser
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+)
+
+func TestLex(t *testing.T) {
+	Convey("Given a Gremlin script", t, func() {
+		Convey("When it has every kind of token", func() {
+			tokens, err := lex(`g.V(-1.5e3d, 'a\'b' /* c */) // d` + "\n" + `.map{ "}" }`)
+			Convey("Then they should be split apart", func() {
+				So(err, ShouldBeNil)
+				var kinds []kind
+				for _, t := range tokens {
+					kinds = append(kinds, t.kind)
+				}
+				So(kinds, ShouldResemble, []kind{
+					ident, punct, ident, punct, number, punct, text, punct, punct, ident, code, eof,
+				})
+				So(tokens[4].raw, ShouldEqual, "-1.5e3d")
+				So(tokens[6].value, ShouldEqual, "a'b")
+				So(tokens[10].raw, ShouldEqual, `{ "}" }`)
+			})
+		})
+
+		Convey("When a string uses escapes", func() {
+			tokens, err := lex(`"a\né\$"`)
+			Convey("Then they should be unescaped", func() {
+				So(err, ShouldBeNil)
+				So(tokens[0].kind, ShouldEqual, text)
+				So(tokens[0].value, ShouldEqual, "a\né$")
+			})
+		})
+
+		Convey("When it ends in the middle of a token", func() {
+			for _, script := range []string{`"abc`, `{ it`, `/* c`, `"\q"`, `g#`} {
+				_, err := lex(script)
+				So(errors.Is(err, gremerror.ErrSyntax), ShouldBeTrue)
+			}
+		})
+	})
+}
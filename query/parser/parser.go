@@ -0,0 +1,402 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+/*
+Package parser reads Gremlin scripts as traversals.
+
+It reads the Gremlin that the traversal package builds, so scripts
+from operators or config files can be checked before they're sent.
+Lambdas, interpolated strings and steps that traversals aren't built
+with are refused unless the Policy allows them, and the error is a
+*gremerror.ParseError with the offset in the script.
+*/
+package parser
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/query/traversal"
+)
+
+// Policy is what scripts may use besides the steps and
+// values that traversals are built with. The zero Policy
+// refuses lambdas and unknown steps.
+type Policy struct {
+	// Lambdas keeps lambdas and interpolated
+	// strings as Custom values.
+	Lambdas bool
+	// UnknownSteps keeps the steps that
+	// traversals aren't built with.
+	UnknownSteps bool
+}
+
+// Parse reads the script, such as g.V().out("knows"), as
+// a traversal, refusing lambdas and unknown steps.
+func Parse(script string) (traversal.String, error) {
+	return Policy{}.Parse(script)
+}
+
+// Format returns the script with every step on its own line,
+// the same as traversal.String.Pretty. Lambdas and unknown
+// steps are kept since the script is only formatted.
+func Format(script string) (string, error) {
+	g, err := Policy{Lambdas: true, UnknownSteps: true}.Parse(script)
+	if err != nil {
+		return "", err
+	}
+	return g.Pretty(), nil
+}
+
+// Parse reads the script as a traversal that
+// starts from g, following the policy.
+func (p Policy) Parse(script string) (traversal.String, error) {
+	tokens, err := lex(script)
+	if err != nil {
+		return traversal.String{}, err
+	}
+
+	ps := &parser{policy: p, tokens: tokens}
+
+	if t := ps.next(); t.kind != ident || t.value != "g" {
+		return traversal.String{}, ps.syntax(t, "a traversal starts with g")
+	}
+	g := traversal.NewTraversal()
+	if err := ps.chain(&g); err != nil {
+		return traversal.String{}, err
+	}
+
+	if ps.peekIs(";") {
+		ps.next()
+	}
+	if t := ps.next(); t.kind != eof {
+		return traversal.String{}, ps.syntax(t, "unexpected "+t.String())
+	}
+
+	return g, nil
+}
+
+// stepper is a traversal that steps are added to,
+// which is a traversal.String or a traversal.Anonymous.
+type stepper interface {
+	AddStep(step string, params ...interface{})
+}
+
+type parser struct {
+	policy Policy
+	tokens []lexeme
+	pos    int
+}
+
+func (p *parser) next() lexeme {
+	t := p.tokens[p.pos]
+	if t.kind != eof {
+		p.pos++
+	}
+	return t
+}
+
+// peekAt returns the token n tokens after the next one.
+func (p *parser) peekAt(n int) lexeme {
+	if p.pos+n >= len(p.tokens) {
+		return p.tokens[len(p.tokens)-1]
+	}
+	return p.tokens[p.pos+n]
+}
+
+// peekIs returns whether the next lexeme is the punctuation.
+func (p *parser) peekIs(s string) bool {
+	t := p.peekAt(0)
+	return t.kind == punct && t.value == s
+}
+
+func (p *parser) expect(s string) error {
+	if t := p.next(); t.kind != punct || t.value != s {
+		return p.syntax(t, fmt.Sprintf("expected %q instead of %s", s, t))
+	}
+	return nil
+}
+
+func (p *parser) syntax(t lexeme, msg string) error {
+	return syntaxError(t.offset, msg)
+}
+
+func (p *parser) error(t lexeme, msg string, err error) error {
+	return gremerror.NewParseError(t.offset, fmt.Errorf("%s: %w", msg, err))
+}
+
+// chain adds the steps that follow, such as .out().in(), to s.
+func (p *parser) chain(s stepper) error {
+	for p.peekIs(".") {
+		p.next()
+		name := p.next()
+		if name.kind != ident {
+			return p.syntax(name, "expected a step instead of "+name.String())
+		}
+		if err := p.step(s, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// step adds the step and its arguments to s.
+func (p *parser) step(s stepper, name lexeme) error {
+	if !traversal.IsStep(name.value) && !p.policy.UnknownSteps {
+		return p.error(name, name.value, gremerror.ErrUnknownStep)
+	}
+
+	// Groovy steps can be given a lambda without parentheses.
+	if p.peekAt(0).kind == code {
+		lambda, err := p.value()
+		if err != nil {
+			return err
+		}
+		s.AddStep(name.value, lambda)
+		return nil
+	}
+
+	if err := p.expect("("); err != nil {
+		return err
+	}
+	args, err := p.args(")")
+	if err != nil {
+		return err
+	}
+
+	s.AddStep(name.value, args...)
+	return nil
+}
+
+// args reads values separated by commas up to the closing token.
+func (p *parser) args(end string) ([]interface{}, error) {
+	var args []interface{}
+	if p.peekIs(end) {
+		p.next()
+		return args, nil
+	}
+
+	for {
+		v, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, v)
+
+		switch t := p.next(); {
+		case t.kind == punct && t.value == ",":
+		case t.kind == punct && t.value == end:
+			return args, nil
+		default:
+			return nil, p.syntax(t, fmt.Sprintf("expected \",\" or %q instead of %s", end, t))
+		}
+	}
+}
+
+func (p *parser) value() (interface{}, error) {
+	t := p.next()
+	switch t.kind {
+	case text:
+		return t.value, nil
+	case number:
+		return numberValue(t.raw), nil
+	case code:
+		if !p.policy.Lambdas {
+			return nil, p.error(t, t.value, gremerror.ErrLambda)
+		}
+		return traversal.Custom(t.raw), nil
+	case ident:
+		return p.name(t)
+	case punct:
+		if t.value == "[" {
+			return p.collection()
+		}
+	}
+	return nil, p.syntax(t, "unexpected "+t.String())
+}
+
+// numberValue returns the number as an int when it's written
+// as one, and as it's written otherwise so that its type,
+// such as the L of 1L, is kept.
+func numberValue(raw string) interface{} {
+	if i, err := strconv.Atoi(raw); err == nil {
+		return i
+	}
+	return traversal.Custom(raw)
+}
+
+// name reads the value that starts with a name, such as a
+// nested traversal, a predicate, an enum or a variable.
+func (p *parser) name(t lexeme) (interface{}, error) {
+	switch t.value {
+	case "true", "false":
+		return t.value == "true", nil
+	case "null":
+		return nil, nil
+	case "g":
+		g := traversal.NewTraversal()
+		return g, p.chain(&g)
+	case "__":
+		a := traversal.NewAnonymousTraversal()
+		if !p.peekIs(".") {
+			return nil, p.syntax(p.peekAt(0), "expected a step after __")
+		}
+		return a, p.chain(&a)
+	}
+
+	// qualified names, such as T.label or P.gt.
+	parts := []string{t.value}
+	for p.peekIs(".") && p.peekAt(1).kind == ident {
+		p.next()
+		parts = append(parts, p.next().value)
+		if p.peekIs("(") {
+			break
+		}
+	}
+	class, name := strings.Join(parts[:len(parts)-1], "."), parts[len(parts)-1]
+
+	if p.peekIs("(") {
+		return p.call(t, class, name)
+	}
+
+	if v, ok := enums[strings.Join(parts, ".")]; ok {
+		return v, nil
+	}
+	if class == "" {
+		// variables are given to the script as bindings.
+		return traversal.Custom(name), nil
+	}
+	return nil, p.syntax(t, "unknown name "+strings.Join(parts, "."))
+}
+
+// call reads a call such as gt(29), Geoshape.point(1, 2)
+// or out("knows"), which starts a nested traversal.
+func (p *parser) call(t lexeme, class, name string) (interface{}, error) {
+	switch {
+	case strings.HasSuffix(class, "Strategy"):
+		return p.strategy(t, class, name)
+	case class == "Geoshape":
+		p.next()
+		args, err := p.args(")")
+		if err != nil {
+			return nil, err
+		}
+		return geoshape(name, args), nil
+	case predicates[name] && (class == "" || predicateClasses[class]):
+		return p.predicate(name)
+	case class != "":
+		return nil, p.error(t, class+"."+name, gremerror.ErrUnknownStep)
+	}
+
+	a := traversal.NewAnonymousTraversal()
+	if name == "not" {
+		// not is a step with a traversal and a predicate with a predicate.
+		p.next()
+		args, err := p.args(")")
+		if err != nil {
+			return nil, err
+		}
+		if len(args) == 1 && isPredicate(args[0]) {
+			return p.combine(negate(args[0]))
+		}
+		a.AddStep(name, args...)
+	} else if err := p.step(&a, lexeme{kind: ident, value: name, offset: t.offset}); err != nil {
+		return nil, err
+	}
+
+	return a, p.chain(&a)
+}
+
+// collection reads a list, such as [1, 2], or a
+// map, such as [name: "marko", (T.label): "person"].
+func (p *parser) collection() (interface{}, error) {
+	if p.peekIs(":") {
+		p.next()
+		return map[interface{}]interface{}{}, p.expect("]")
+	}
+	if p.peekIs("]") {
+		p.next()
+		return []interface{}{}, nil
+	}
+
+	var (
+		list []interface{}
+		m    map[interface{}]interface{}
+	)
+	for {
+		t := p.peekAt(0)
+		k, err := p.key()
+		if err != nil {
+			return nil, err
+		}
+
+		if list == nil && m == nil && p.peekIs(":") {
+			m = map[interface{}]interface{}{}
+		}
+		if m != nil {
+			if err := p.expect(":"); err != nil {
+				return nil, err
+			}
+			if k != nil && !reflect.TypeOf(k).Comparable() {
+				return nil, p.syntax(t, "invalid map key")
+			}
+			v, err := p.value()
+			if err != nil {
+				return nil, err
+			}
+			m[k] = v
+		} else {
+			list = append(list, k)
+		}
+
+		switch t := p.next(); {
+		case t.kind == punct && t.value == ",":
+		case t.kind == punct && t.value == "]":
+			if m != nil {
+				return m, nil
+			}
+			return list, nil
+		default:
+			return nil, p.syntax(t, "expected \",\" or \"]\" instead of "+t.String())
+		}
+	}
+}
+
+// key reads a value or the key of a map, which is a name
+// used as a string or a value in parentheses.
+func (p *parser) key() (interface{}, error) {
+	t := p.peekAt(0)
+	switch {
+	case t.kind == ident && p.peekAt(1).kind == punct && p.peekAt(1).value == ":":
+		p.next()
+		return t.value, nil
+	case t.kind == punct && t.value == "(":
+		p.next()
+		v, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		return v, p.expect(")")
+	}
+	return p.value()
+}
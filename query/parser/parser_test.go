@@ -0,0 +1,191 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package parser
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/query/predicate"
+	"github.com/northwesternmutual/grammes/query/scope"
+	"github.com/northwesternmutual/grammes/query/traversal"
+)
+
+func TestParse(t *testing.T) {
+	Convey("Given a Gremlin script", t, func() {
+		Convey("When it's a traversal with steps and values", func() {
+			g, err := Parse(`g.V(1).has('name', "marko").out("knows").range(0, 10).valueMap(true)`)
+			Convey("Then it should be read as the traversal it was written as", func() {
+				So(err, ShouldBeNil)
+				So(g.String(), ShouldEqual, `g.V(1).has("name","marko").out("knows").range(0,10).valueMap(true)`)
+			})
+
+			Convey("Then the steps should hold the values", func() {
+				steps := g.Steps()
+				So(steps, ShouldHaveLength, 5)
+				So(steps[1], ShouldResemble, traversal.Step{Name: "has", Args: []interface{}{"name", "marko"}})
+			})
+		})
+
+		Convey("When it has nested traversals", func() {
+			g, err := Parse(`g.V().where(__.out("knows")).project("a").by(values("name").fold())`)
+			Convey("Then they should be anonymous traversals", func() {
+				So(err, ShouldBeNil)
+				So(g.String(), ShouldEqual, `g.V().where(__.out("knows")).project("a").by(__.values("name").fold())`)
+				_, ok := g.Steps()[1].Args[0].(traversal.Anonymous)
+				So(ok, ShouldBeTrue)
+			})
+		})
+
+		Convey("When it has predicates and enums", func() {
+			g, err := Parse(`g.V().has("age", P.gt(29).and(lt(40))).order().by("age", Order.desc).count(local)`)
+			Convey("Then they should have the types the builders use", func() {
+				So(err, ShouldBeNil)
+				So(g.String(), ShouldEqual, `g.V().has("age",gt(29).and(lt(40))).order().by("age",desc).count(local)`)
+				_, ok := g.Steps()[1].Args[1].(*predicate.Predicate)
+				So(ok, ShouldBeTrue)
+				So(g.Steps()[4].Args[0], ShouldEqual, scope.Local)
+			})
+		})
+
+		Convey("When not is given a predicate or a traversal", func() {
+			g, err := Parse(`g.V().where(not(out())).values("age").is(not(eq(3)))`)
+			Convey("Then it should be a predicate or a step", func() {
+				So(err, ShouldBeNil)
+				So(g.String(), ShouldEqual, `g.V().where(__.not(__.out())).values("age").is(not(eq(3)))`)
+			})
+		})
+
+		Convey("When it has lists, maps and typed numbers", func() {
+			g, err := Parse(`g.V(1L).has("age", within([1, 2])).mergeV([name: "marko", (T.label): "person"]).inject([:])`)
+			Convey("Then they should be kept", func() {
+				So(err, ShouldBeNil)
+				So(g.String(), ShouldEqual, `g.V(1L).has("age",within([1,2])).mergeV(["name":"marko",(T.label):"person"]).inject([:])`)
+			})
+		})
+
+		Convey("When it configures the traversal source", func() {
+			g, err := Parse(`g.withStrategies(PartitionStrategy.build().partitionKey("p").readPartitions("a", "b").create(), ReadOnlyStrategy.instance()).V()`)
+			Convey("Then the strategies should be read as their structs", func() {
+				So(err, ShouldBeNil)
+				So(g.Steps()[0].Args, ShouldResemble, []interface{}{
+					traversal.PartitionStrategy{PartitionKey: "p", ReadPartitions: []string{"a", "b"}},
+					traversal.ReadOnlyStrategy{},
+				})
+			})
+		})
+
+		Convey("When it uses a variable", func() {
+			g, err := Parse(`g.V(id).as("a");`)
+			Convey("Then it should be kept for the bindings", func() {
+				So(err, ShouldBeNil)
+				So(g.String(), ShouldEqual, `g.V(id).as("a")`)
+			})
+		})
+	})
+}
+
+func TestParseRefused(t *testing.T) {
+	Convey("Given a Gremlin script that isn't allowed", t, func() {
+		Convey("When it uses a lambda", func() {
+			_, err := Parse(`g.V().map{ it.get().value("name") }`)
+			Convey("Then ErrLambda should be returned with the offset", func() {
+				var parseErr *gremerror.ParseError
+				So(errors.As(err, &parseErr), ShouldBeTrue)
+				So(parseErr.Offset(), ShouldEqual, 9)
+				So(errors.Is(err, gremerror.ErrLambda), ShouldBeTrue)
+			})
+		})
+
+		Convey("When it interpolates a string", func() {
+			_, err := Parse(`g.V().has("name", "${System.exit(0)}")`)
+			Convey("Then ErrLambda should be returned", func() {
+				So(errors.Is(err, gremerror.ErrLambda), ShouldBeTrue)
+			})
+		})
+
+		Convey("When it uses a step traversals aren't built with", func() {
+			_, err := Parse(`g.V().out().toList()`)
+			Convey("Then ErrUnknownStep should be returned", func() {
+				So(errors.Is(err, gremerror.ErrUnknownStep), ShouldBeTrue)
+				So(err.Error(), ShouldContainSubstring, "toList")
+			})
+		})
+
+		Convey("When it isn't a traversal", func() {
+			for _, script := range []string{
+				`graph.openManagement()`,
+				`g.V(`,
+				`g.V().has("name" "marko")`,
+				`g.V() g.E()`,
+				`g.V().has(Foo.bar)`,
+			} {
+				_, err := Parse(script)
+				So(errors.Is(err, gremerror.ErrSyntax), ShouldBeTrue)
+			}
+		})
+	})
+}
+
+func TestPolicy(t *testing.T) {
+	Convey("Given a policy that allows lambdas and unknown steps", t, func() {
+		p := Policy{Lambdas: true, UnknownSteps: true}
+
+		Convey("When Parse is called with both", func() {
+			g, err := p.Parse(`g.V().map{ it.get() }.toList()`)
+			Convey("Then they should be kept", func() {
+				So(err, ShouldBeNil)
+				So(g.String(), ShouldEqual, `g.V().map({ it.get() }).toList()`)
+			})
+		})
+	})
+}
+
+func TestFormat(t *testing.T) {
+	Convey("Given a long Gremlin script", t, func() {
+		script := `g.V().hasLabel("person").repeat(out("knows").hasLabel("person").simplePath()).emit().map{ it.get() }`
+
+		Convey("When Format is called", func() {
+			f, err := Format(script)
+			Convey("Then every step should be on its own line", func() {
+				So(err, ShouldBeNil)
+				So(f, ShouldEqual, "g\n"+
+					"  .V()\n"+
+					"  .hasLabel(\"person\")\n"+
+					"  .repeat(__.out(\"knows\")\n"+
+					"    .hasLabel(\"person\")\n"+
+					"    .simplePath())\n"+
+					"  .emit()\n"+
+					"  .map({ it.get() })")
+			})
+		})
+
+		Convey("When Format is called with invalid syntax", func() {
+			_, err := Format(`g.V(`)
+			Convey("Then ErrSyntax should be returned", func() {
+				So(errors.Is(err, gremerror.ErrSyntax), ShouldBeTrue)
+			})
+		})
+	})
+}
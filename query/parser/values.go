@@ -0,0 +1,274 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package parser
+
+import (
+	"fmt"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/query/cardinality"
+	"github.com/northwesternmutual/grammes/query/column"
+	"github.com/northwesternmutual/grammes/query/consumer"
+	"github.com/northwesternmutual/grammes/query/direction"
+	"github.com/northwesternmutual/grammes/query/dt"
+	"github.com/northwesternmutual/grammes/query/operator"
+	"github.com/northwesternmutual/grammes/query/order"
+	"github.com/northwesternmutual/grammes/query/pop"
+	"github.com/northwesternmutual/grammes/query/predicate"
+	"github.com/northwesternmutual/grammes/query/scope"
+	"github.com/northwesternmutual/grammes/query/token"
+	"github.com/northwesternmutual/grammes/query/traversal"
+)
+
+// enums are the enum values by the names they're
+// written with, both with and without their class.
+var enums = enumNames()
+
+func enumNames() map[string]interface{} {
+	m := map[string]interface{}{}
+
+	// these are always written with their class.
+	for _, v := range []fmt.Stringer{
+		token.ID, token.Key, token.Label, token.Value,
+		dt.Second, dt.Minute, dt.Hour, dt.Day,
+	} {
+		m[v.String()] = v
+	}
+
+	add := func(classes []string, values ...fmt.Stringer) {
+		for _, v := range values {
+			m[v.String()] = v
+			for _, c := range classes {
+				m[c+"."+v.String()] = v
+			}
+		}
+	}
+	add([]string{"Direction"}, direction.In, direction.Out, direction.Both)
+	add([]string{"Scope"}, scope.Local, scope.Global)
+	add([]string{"Order"}, order.Asc, order.Desc, order.Shuffle)
+	add([]string{"Column"}, column.Keys, column.Values)
+	add([]string{"Pop"}, pop.First, pop.Last, pop.All, pop.Mixed)
+	add([]string{"Cardinality", "VertexProperty.Cardinality"},
+		cardinality.List, cardinality.Set, cardinality.Single)
+	add([]string{"Operator"}, operator.AddAll, operator.And, operator.Assign,
+		operator.Div, operator.Max, operator.Min, operator.Minus, operator.Mult,
+		operator.Or, operator.Sum, operator.SumLong)
+	add([]string{"Barrier", "SackFunctions.Barrier"}, consumer.NormSack)
+
+	return m
+}
+
+// predicates are the names of the predicates
+// of TinkerPop and JanusGraph.
+var predicates = map[string]bool{
+	// P
+	"eq": true, "neq": true, "lt": true, "lte": true, "gt": true,
+	"gte": true, "inside": true, "outside": true, "between": true,
+	"within": true, "without": true,
+	// TextP
+	"containing": true, "notContaining": true, "startingWith": true,
+	"notStartingWith": true, "endingWith": true, "notEndingWith": true,
+	"regex": true, "notRegex": true,
+	// Text
+	"textContains": true, "textNotContains": true, "textContainsPrefix": true,
+	"textNotContainsPrefix": true, "textContainsRegex": true,
+	"textNotContainsRegex": true, "textContainsFuzzy": true,
+	"textNotContainsFuzzy": true, "textContainsPhrase": true,
+	"textNotContainsPhrase": true, "textPrefix": true, "textNotPrefix": true,
+	"textRegex": true, "textNotRegex": true, "textFuzzy": true,
+	"textNotFuzzy": true,
+	// Geo
+	"geoWithin": true, "geoIntersect": true, "geoDisjoint": true, "geoContains": true,
+}
+
+// predicateClasses are the classes the predicates
+// are written with, such as the P of P.gt(29).
+var predicateClasses = map[string]bool{
+	"P": true, "TextP": true, "Text": true, "Geo": true,
+}
+
+// predicate reads the arguments of the predicate
+// along with the predicates it's combined with.
+func (p *parser) predicate(name string) (interface{}, error) {
+	p.next()
+	args, err := p.args(")")
+	if err != nil {
+		return nil, err
+	}
+
+	pr := predicate.Predicate(groovy(name, args))
+	return p.combine(&pr)
+}
+
+// combine reads the and() and or() calls that follow the predicate.
+func (p *parser) combine(pr *predicate.Predicate) (interface{}, error) {
+	for p.peekIs(".") && p.peekAt(1).kind == ident &&
+		(p.peekAt(1).value == "and" || p.peekAt(1).value == "or") {
+		p.next()
+		op := p.next()
+		if err := p.expect("("); err != nil {
+			return nil, err
+		}
+		t := p.peekAt(0)
+		args, err := p.args(")")
+		if err != nil {
+			return nil, err
+		}
+		if len(args) != 1 || !isPredicate(args[0]) {
+			return nil, p.syntax(t, op.value+" takes a predicate")
+		}
+
+		if op.value == "and" {
+			pr = pr.And(args[0].(*predicate.Predicate))
+		} else {
+			pr = pr.Or(args[0].(*predicate.Predicate))
+		}
+	}
+	return pr, nil
+}
+
+func isPredicate(v interface{}) bool {
+	_, ok := v.(*predicate.Predicate)
+	return ok
+}
+
+func negate(v interface{}) *predicate.Predicate {
+	return predicate.Not(v.(*predicate.Predicate))
+}
+
+func geoshape(name string, args []interface{}) predicate.Geoshape {
+	return predicate.Geoshape("Geoshape." + groovy(name, args))
+}
+
+// groovy writes the call with its arguments
+// the same way the steps of a traversal are.
+func groovy(name string, args []interface{}) string {
+	g := traversal.NewTraversal()
+	g.AddStep(name, args...)
+	return g.Raw().String()
+}
+
+// strategy reads a strategy, such as ReadOnlyStrategy.instance()
+// or PartitionStrategy.build().partitionKey("p").create().
+func (p *parser) strategy(t lexeme, class, call string) (interface{}, error) {
+	var s traversal.Strategy
+	switch class {
+	case "ReadOnlyStrategy":
+		if call == "instance" {
+			p.next()
+			return traversal.ReadOnlyStrategy{}, p.expect(")")
+		}
+	case "PartitionStrategy":
+		s = traversal.PartitionStrategy{}
+	case "SubgraphStrategy":
+		s = traversal.SubgraphStrategy{}
+	case "EdgeLabelVerificationStrategy":
+		s = traversal.EdgeLabelVerificationStrategy{}
+	}
+	if s == nil || call != "build" {
+		return nil, p.error(t, class+"."+call, gremerror.ErrUnknownStep)
+	}
+
+	p.next()
+	if err := p.expect(")"); err != nil {
+		return nil, err
+	}
+
+	for {
+		if err := p.expect("."); err != nil {
+			return nil, err
+		}
+		opt := p.next()
+		if err := p.expect("("); err != nil {
+			return nil, err
+		}
+		args, err := p.args(")")
+		if err != nil {
+			return nil, err
+		}
+		if opt.value == "create" && len(args) == 0 {
+			return s, nil
+		}
+
+		if s, err = option(s, opt.value, args); err != nil {
+			return nil, p.syntax(opt, err.Error())
+		}
+	}
+}
+
+// option sets the option of the strategy's builder.
+func option(s traversal.Strategy, name string, args []interface{}) (traversal.Strategy, error) {
+	invalid := fmt.Errorf("invalid option %s of %s", name, s.Name())
+
+	one := func() interface{} {
+		if len(args) != 1 {
+			return nil
+		}
+		return args[0]
+	}
+	str := func() (string, bool) { v, ok := one().(string); return v, ok }
+	boolean := func() (bool, bool) { v, ok := one().(bool); return v, ok }
+	anonymous := func() (traversal.Anonymous, bool) { v, ok := one().(traversal.Anonymous); return v, ok }
+
+	var ok bool
+	switch t := s.(type) {
+	case traversal.PartitionStrategy:
+		switch name {
+		case "partitionKey":
+			t.PartitionKey, ok = str()
+		case "writePartition":
+			t.WritePartition, ok = str()
+		case "readPartitions":
+			ok = len(args) > 0
+			for _, a := range args {
+				v, isStr := a.(string)
+				ok = ok && isStr
+				t.ReadPartitions = append(t.ReadPartitions, v)
+			}
+		case "includeMetaProperties":
+			t.IncludeMetaProperties, ok = boolean()
+		}
+		s = t
+	case traversal.SubgraphStrategy:
+		switch name {
+		case "vertices":
+			t.Vertices, ok = anonymous()
+		case "edges":
+			t.Edges, ok = anonymous()
+		case "vertexProperties":
+			t.VertexProperties, ok = anonymous()
+		}
+		s = t
+	case traversal.EdgeLabelVerificationStrategy:
+		switch name {
+		case "logWarning":
+			t.LogWarning, ok = boolean()
+		case "throwException":
+			t.ThrowException, ok = boolean()
+		}
+		s = t
+	}
+
+	if !ok {
+		return s, invalid
+	}
+	return s, nil
+}
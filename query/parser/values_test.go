@@ -0,0 +1,82 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package parser
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/query/cardinality"
+	"github.com/northwesternmutual/grammes/query/direction"
+	"github.com/northwesternmutual/grammes/query/token"
+	"github.com/northwesternmutual/grammes/query/traversal"
+)
+
+func TestEnums(t *testing.T) {
+	Convey("Given the names of enums", t, func() {
+		Convey("When they're looked up", func() {
+			Convey("Then they should be found with and without their class", func() {
+				So(enums["T.label"], ShouldEqual, token.Label)
+				So(enums["OUT"], ShouldEqual, direction.Out)
+				So(enums["Direction.OUT"], ShouldEqual, direction.Out)
+				So(enums["VertexProperty.Cardinality.list"], ShouldEqual, cardinality.List)
+				So(enums["label"], ShouldBeNil)
+			})
+		})
+	})
+}
+
+func TestStrategies(t *testing.T) {
+	Convey("Given scripts with strategies", t, func() {
+		Convey("When a SubgraphStrategy is read", func() {
+			g, err := Parse(`g.withStrategies(SubgraphStrategy.build().vertices(hasLabel("person")).create()).V()`)
+			Convey("Then its traversals should be set", func() {
+				So(err, ShouldBeNil)
+				s := g.Steps()[0].Args[0].(traversal.SubgraphStrategy)
+				So(s.Vertices.String(), ShouldEqual, `__.hasLabel("person")`)
+			})
+		})
+
+		Convey("When an EdgeLabelVerificationStrategy is read", func() {
+			g, err := Parse(`g.withStrategies(EdgeLabelVerificationStrategy.build().throwException(true).create()).V()`)
+			Convey("Then its options should be set", func() {
+				So(err, ShouldBeNil)
+				So(g.Steps()[0].Args[0], ShouldResemble, traversal.EdgeLabelVerificationStrategy{ThrowException: true})
+			})
+		})
+
+		Convey("When an option has the wrong type", func() {
+			_, err := Parse(`g.withStrategies(PartitionStrategy.build().partitionKey(1).create()).V()`)
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "partitionKey")
+			})
+		})
+
+		Convey("When the strategy isn't known", func() {
+			_, err := Parse(`g.withStrategies(LazyBarrierStrategy.instance()).V()`)
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,179 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package query
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/query/datatype"
+)
+
+// Placeholder is a value of a query template that's given
+// when the query is executed, as the binding with the name.
+// The value is checked against the type and turned into
+// it on the server, so the script stays the same.
+type Placeholder struct {
+	Name string
+	Type datatype.DataType
+}
+
+// placeholderBits are the types placeholders can have with the
+// size of their integers. Float and Double take any number.
+var placeholderBits = map[datatype.DataType]int{
+	datatype.String:  0,
+	datatype.Boolean: 0,
+	datatype.Byte:    8,
+	datatype.Short:   16,
+	datatype.Integer: 32,
+	datatype.Long:    64,
+	datatype.Float:   0,
+	datatype.Double:  0,
+}
+
+// reservedNames are the bindings the server already has.
+// A placeholder with one of the names would hide it.
+var reservedNames = map[string]bool{
+	"g":     true,
+	"graph": true,
+	"__":    true,
+}
+
+// placeholderPattern finds the placeholders of a script.
+var placeholderPattern = regexp.MustCompile(`\b([A-Z]\w*)\.valueOf\(([A-Za-z_]\w*)\)`)
+
+// String returns the Groovy that reads the binding
+// as the type of the placeholder, such as Long.valueOf(age).
+func (p Placeholder) String() string {
+	return strings.TrimSuffix(p.Type.String(), ".class") + ".valueOf(" + p.Name + ")"
+}
+
+// GoString returns the same as String, so the
+// placeholder is written the same with %#v.
+func (p Placeholder) GoString() string {
+	return p.String()
+}
+
+// Placeholders returns the placeholders of the script. String
+// literals are skipped, so a value such as "Long.valueOf(x)"
+// isn't a placeholder. The placeholders are checked with
+// CheckPlaceholders.
+func Placeholders(script string) ([]Placeholder, error) {
+	var found []Placeholder
+	for i := 0; i < len(script); {
+		end := strings.IndexAny(script[i:], `"'`)
+		if end < 0 {
+			end = len(script)
+		} else {
+			end += i
+		}
+
+		for _, m := range placeholderPattern.FindAllStringSubmatch(script[i:end], -1) {
+			found = append(found, Placeholder{Name: m[2], Type: datatype.DataType(m[1] + ".class")})
+		}
+
+		if end == len(script) {
+			break
+		}
+		i = literalEnd(script, end)
+	}
+
+	return CheckPlaceholders(found)
+}
+
+// CheckPlaceholders returns the placeholders with every name
+// once. A name given two types, a type that a binding can't be
+// turned into, or a name that's reserved by the server or starts
+// with BindingPrefix returns ErrInvalidParameter.
+func CheckPlaceholders(placeholders []Placeholder) ([]Placeholder, error) {
+	var res []Placeholder
+	types := map[string]datatype.DataType{}
+
+	for _, p := range placeholders {
+		if reservedNames[p.Name] || strings.HasPrefix(p.Name, BindingPrefix) {
+			return nil, fmt.Errorf("%s is a reserved name: %w", p.Name, gremerror.ErrInvalidParameter)
+		}
+		if _, ok := placeholderBits[p.Type]; !ok {
+			return nil, fmt.Errorf("%s has the type %s: %w", p.Name, p.Type, gremerror.ErrInvalidParameter)
+		}
+
+		if t, ok := types[p.Name]; ok {
+			if t != p.Type {
+				return nil, fmt.Errorf("%s has the types %s and %s: %w", p.Name, t, p.Type, gremerror.ErrInvalidParameter)
+			}
+			continue
+		}
+		types[p.Name] = p.Type
+		res = append(res, p)
+	}
+
+	return res, nil
+}
+
+// Bind checks that the value has the type of the placeholder
+// and returns it as a binding. Numbers must fit in the type.
+func (p Placeholder) Bind(value interface{}) (string, error) {
+	invalid := fmt.Errorf("%s is %T instead of %s: %w", p.Name, value, p.Type, gremerror.ErrInvalidParameter)
+
+	v := reflect.ValueOf(value)
+	switch p.Type {
+	case datatype.String:
+		if s, ok := value.(string); ok {
+			return s, nil
+		}
+	case datatype.Boolean:
+		if b, ok := value.(bool); ok {
+			return strconv.FormatBool(b), nil
+		}
+	case datatype.Float, datatype.Double:
+		switch v.Kind() {
+		case reflect.Float32, reflect.Float64:
+			return strconv.FormatFloat(v.Float(), 'g', -1, 64), nil
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return strconv.FormatInt(v.Int(), 10), nil
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return strconv.FormatUint(v.Uint(), 10), nil
+		}
+	default:
+		bits := placeholderBits[p.Type]
+		min, max := int64(-1)<<(bits-1), int64(math.MaxInt64>>(64-bits))
+
+		switch v.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			if i := v.Int(); i >= min && i <= max {
+				return strconv.FormatInt(i, 10), nil
+			}
+			return "", fmt.Errorf("%s is out of the range of %s: %w", p.Name, p.Type, gremerror.ErrInvalidParameter)
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			if u := v.Uint(); u <= uint64(max) {
+				return strconv.FormatUint(u, 10), nil
+			}
+			return "", fmt.Errorf("%s is out of the range of %s: %w", p.Name, p.Type, gremerror.ErrInvalidParameter)
+		}
+	}
+
+	return "", invalid
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package query
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/query/datatype"
+)
+
+func TestPlaceholderString(t *testing.T) {
+	Convey("Given a placeholder", t, func() {
+		p := Placeholder{Name: "age", Type: datatype.Long}
+		Convey("When String is called", func() {
+			Convey("Then the binding should be read as the type", func() {
+				So(p.String(), ShouldEqual, "Long.valueOf(age)")
+				So(p.GoString(), ShouldEqual, "Long.valueOf(age)")
+			})
+		})
+	})
+}
+
+func TestPlaceholders(t *testing.T) {
+	Convey("Given a script with placeholders", t, func() {
+		Convey("When Placeholders is called", func() {
+			res, err := Placeholders(`g.V().has("age",gt(Long.valueOf(age))).has("name",String.valueOf(name)).has("age",lt(Long.valueOf(age)))`)
+			Convey("Then every name should be returned once", func() {
+				So(err, ShouldBeNil)
+				So(res, ShouldResemble, []Placeholder{
+					{Name: "age", Type: datatype.Long},
+					{Name: "name", Type: datatype.String},
+				})
+			})
+		})
+
+		Convey("When a string literal looks like a placeholder", func() {
+			res, err := Placeholders(`g.V().has("note","Long.valueOf(x) \"String.valueOf(y)").has('a','Long.valueOf(z)').has("n",String.valueOf(n))`)
+			Convey("Then it should be skipped", func() {
+				So(err, ShouldBeNil)
+				So(res, ShouldResemble, []Placeholder{{Name: "n", Type: datatype.String}})
+			})
+		})
+
+		Convey("When a name has two types", func() {
+			_, err := Placeholders(`g.V().has("age",Long.valueOf(age)).has("x",String.valueOf(age))`)
+			Convey("Then an error should be returned", func() {
+				So(errors.Is(err, gremerror.ErrInvalidParameter), ShouldBeTrue)
+			})
+		})
+
+		Convey("When a name is reserved", func() {
+			_, err1 := Placeholders(`g.V().has("x",String.valueOf(g))`)
+			_, err2 := Placeholders(`g.V().has("x",String.valueOf(graph))`)
+			_, err3 := Placeholders(`g.V().has("x",String.valueOf(_p0))`)
+			Convey("Then an error should be returned", func() {
+				So(errors.Is(err1, gremerror.ErrInvalidParameter), ShouldBeTrue)
+				So(errors.Is(err2, gremerror.ErrInvalidParameter), ShouldBeTrue)
+				So(errors.Is(err3, gremerror.ErrInvalidParameter), ShouldBeTrue)
+			})
+		})
+
+		Convey("When a type can't be bound", func() {
+			_, err := Placeholders(`g.V().has("at",Date.valueOf(at))`)
+			Convey("Then an error should be returned", func() {
+				So(errors.Is(err, gremerror.ErrInvalidParameter), ShouldBeTrue)
+			})
+		})
+	})
+}
+
+func TestPlaceholderBind(t *testing.T) {
+	Convey("Given placeholders of each kind of type", t, func() {
+		Convey("When values of the types are bound", func() {
+			s, err1 := Placeholder{Name: "s", Type: datatype.String}.Bind("x")
+			b, err2 := Placeholder{Name: "b", Type: datatype.Boolean}.Bind(true)
+			i, err3 := Placeholder{Name: "i", Type: datatype.Integer}.Bind(-42)
+			u, err4 := Placeholder{Name: "u", Type: datatype.Byte}.Bind(uint8(127))
+			f, err5 := Placeholder{Name: "f", Type: datatype.Double}.Bind(1.5)
+			Convey("Then the bindings should be returned", func() {
+				So(err1, ShouldBeNil)
+				So(err2, ShouldBeNil)
+				So(err3, ShouldBeNil)
+				So(err4, ShouldBeNil)
+				So(err5, ShouldBeNil)
+				So([]string{s, b, i, u, f}, ShouldResemble, []string{"x", "true", "-42", "127", "1.5"})
+			})
+		})
+
+		Convey("When a value has another type", func() {
+			_, err := Placeholder{Name: "age", Type: datatype.Long}.Bind("42")
+			Convey("Then an error should be returned", func() {
+				So(errors.Is(err, gremerror.ErrInvalidParameter), ShouldBeTrue)
+			})
+		})
+
+		Convey("When a number doesn't fit in the type", func() {
+			_, err1 := Placeholder{Name: "n", Type: datatype.Short}.Bind(1 << 15)
+			_, err2 := Placeholder{Name: "n", Type: datatype.Byte}.Bind(uint(128))
+			_, err3 := Placeholder{Name: "n", Type: datatype.Byte}.Bind(-128)
+			Convey("Then an error should be returned", func() {
+				So(errors.Is(err1, gremerror.ErrInvalidParameter), ShouldBeTrue)
+				So(errors.Is(err2, gremerror.ErrInvalidParameter), ShouldBeTrue)
+				So(err3, ShouldBeNil)
+			})
+		})
+	})
+}
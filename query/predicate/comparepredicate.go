@@ -21,14 +21,15 @@
 package predicate
 
 import (
-	"bytes"
-	"fmt"
+	"strings"
+
+	"github.com/northwesternmutual/grammes/query"
 )
 
 // Equal checks if this value is
 // exactly equal to the querying value.
 func Equal(val interface{}) *Predicate {
-	s := fmt.Sprintf("eq(%#v)", val)
+	s := "eq(" + query.Literal(val) + ")"
 	a := Predicate(s)
 	return &a
 }
@@ -36,7 +37,7 @@ func Equal(val interface{}) *Predicate {
 // NotEqual check if this value is
 // NOT equal to the query value.
 func NotEqual(val interface{}) *Predicate {
-	s := fmt.Sprintf("neq(%#v)", val)
+	s := "neq(" + query.Literal(val) + ")"
 	a := Predicate(s)
 	return &a
 }
@@ -44,7 +45,7 @@ func NotEqual(val interface{}) *Predicate {
 // LessThan checks if this value is
 // less than the querying value.
 func LessThan(val interface{}) *Predicate {
-	s := fmt.Sprintf("lt(%#v)", val)
+	s := "lt(" + query.Literal(val) + ")"
 	a := Predicate(s)
 	return &a
 }
@@ -52,7 +53,7 @@ func LessThan(val interface{}) *Predicate {
 // LessThanOrEqual checks if this value is
 // less than or equal to the querying value.
 func LessThanOrEqual(val interface{}) *Predicate {
-	s := fmt.Sprintf("lte(%#v)", val)
+	s := "lte(" + query.Literal(val) + ")"
 	a := Predicate(s)
 	return &a
 }
@@ -60,7 +61,7 @@ func LessThanOrEqual(val interface{}) *Predicate {
 // GreaterThan checks if this value is
 // greater than the querying value.
 func GreaterThan(val interface{}) *Predicate {
-	s := fmt.Sprintf("gt(%#v)", val)
+	s := "gt(" + query.Literal(val) + ")"
 	a := Predicate(s)
 	return &a
 }
@@ -68,7 +69,7 @@ func GreaterThan(val interface{}) *Predicate {
 // GreaterThanOrEqual checks if this value is
 // greater than or equal to the querying value.
 func GreaterThanOrEqual(val interface{}) *Predicate {
-	s := fmt.Sprintf("gte(%#v)", val)
+	s := "gte(" + query.Literal(val) + ")"
 	a := Predicate(s)
 	return &a
 }
@@ -76,28 +77,44 @@ func GreaterThanOrEqual(val interface{}) *Predicate {
 // Inside checks if this value is
 // within the minimum and maximum querying values.
 func Inside(min, max interface{}) *Predicate {
-	s := fmt.Sprintf("inside(%#v, %#v)", min, max)
+	s := "inside(" + query.Literal(min) + "," + query.Literal(max) + ")"
+	a := Predicate(s)
+	return &a
+}
+
+// Between checks if this value is at least the
+// minimum value and less than the maximum value.
+func Between(min, max interface{}) *Predicate {
+	s := "between(" + query.Literal(min) + "," + query.Literal(max) + ")"
+	a := Predicate(s)
+	return &a
+}
+
+// Outside checks if this value is less than the
+// minimum value or greater than the maximum value.
+func Outside(min, max interface{}) *Predicate {
+	s := "outside(" + query.Literal(min) + "," + query.Literal(max) + ")"
 	a := Predicate(s)
 	return &a
 }
 
 // Within checks if this value is within the array values.
 func Within(params ...interface{}) *Predicate {
-	buffer := bytes.NewBufferString("within(")
-	sep := ""
-	for _, p := range params {
-		buffer.WriteString(sep)
-		switch t := p.(type) {
-		case string:
-			buffer.WriteString("\"" + t + "\"")
-		default:
-			buffer.WriteString(fmt.Sprintf("%v", t))
-		}
-
-		sep = ","
+	return list("within", params)
+}
+
+// Without checks if this value is not within the array values.
+func Without(params ...interface{}) *Predicate {
+	return list("without", params)
+}
+
+// list makes a predicate that takes any number of values.
+func list(name string, params []interface{}) *Predicate {
+	values := make([]string, len(params))
+	for i, p := range params {
+		values[i] = query.Literal(p)
 	}
 
-	buffer.WriteString(")")
-	a := Predicate(buffer.String())
+	a := Predicate(name + "(" + strings.Join(values, ",") + ")")
 	return &a
 }
@@ -20,4 +20,33 @@
 
 package predicate
 
-//!TODO - Add Geoshapes and Geoshape predicates to Grammes.
+// Geo predicates from JanusGraph which match against
+// geo properties using a Geoshape.
+
+// GeoWithin finds if the geo value is within the shape.
+func GeoWithin(shape Geoshape) *Predicate {
+	return geo("geoWithin", shape)
+}
+
+// GeoIntersect finds if the geo value
+// intersects with the shape.
+func GeoIntersect(shape Geoshape) *Predicate {
+	return geo("geoIntersect", shape)
+}
+
+// GeoDisjoint finds if the geo value doesn't
+// have anything in common with the shape.
+func GeoDisjoint(shape Geoshape) *Predicate {
+	return geo("geoDisjoint", shape)
+}
+
+// GeoContains finds if the geo value contains the shape.
+func GeoContains(shape Geoshape) *Predicate {
+	return geo("geoContains", shape)
+}
+
+// geo makes a predicate that takes a shape.
+func geo(name string, shape Geoshape) *Predicate {
+	a := Predicate(name + "(" + shape.String() + ")")
+	return &a
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package predicate
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Geoshape is a JanusGraph shape used by the geo predicates
+// and as the value of geo properties. It implements the
+// Parameter interface used by graph traversals.
+type Geoshape string
+
+func (g Geoshape) String() string {
+	return string(g)
+}
+
+// Coordinate is a point on the earth in degrees.
+type Coordinate struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// Point is the shape of a single coordinate.
+func Point(latitude, longitude float64) Geoshape {
+	return shape("point", latitude, longitude)
+}
+
+// Circle is the shape of the area within the
+// radius in kilometers around the center.
+func Circle(latitude, longitude, radius float64) Geoshape {
+	return shape("circle", latitude, longitude, radius)
+}
+
+// Box is the shape of the area between the
+// south west and north east corners.
+func Box(southWest, northEast Coordinate) Geoshape {
+	return shape("box", southWest.Latitude, southWest.Longitude, northEast.Latitude, northEast.Longitude)
+}
+
+// Line is the shape of the line going through the coordinates.
+func Line(coordinates ...Coordinate) Geoshape {
+	return Geoshape("Geoshape.line(" + coordinateList(coordinates) + ")")
+}
+
+// Polygon is the shape of the area enclosed by the
+// coordinates. The last coordinate should be the
+// same as the first one to close the polygon.
+func Polygon(coordinates ...Coordinate) Geoshape {
+	return Geoshape("Geoshape.polygon(" + coordinateList(coordinates) + ")")
+}
+
+func shape(name string, values ...float64) Geoshape {
+	params := make([]string, len(values))
+	for i, v := range values {
+		params[i] = formatFloat(v)
+	}
+	return Geoshape("Geoshape." + name + "(" + strings.Join(params, ",") + ")")
+}
+
+// coordinateList renders the coordinates as the list of
+// longitude and latitude arrays that JanusGraph expects.
+func coordinateList(coordinates []Coordinate) string {
+	points := make([]string, len(coordinates))
+	for i, c := range coordinates {
+		points[i] = "[" + formatFloat(c.Longitude) + "," + formatFloat(c.Latitude) + "] as double[]"
+	}
+	return "[" + strings.Join(points, ",") + "]"
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
@@ -24,6 +24,10 @@ Package predicate contains the object to control the searching predicates of a g
 Predicates are used for when you're trying to narrow the search of a vertex or vertices
 on the graph without having to perform multiple searches.
 
+It has the P and TextP predicates of TinkerPop, along with the
+Text and Geo predicates of JanusGraph and the Geoshape values
+used by them.
+
 A note about Predicate:
 
 This object implements the Parameter interfaces used by graph traversals.
@@ -38,3 +42,23 @@ type Predicate string
 func (p *Predicate) String() string {
 	return string(*p)
 }
+
+// And combines this predicate with another one so
+// that a value must match both of them.
+func (p *Predicate) And(other *Predicate) *Predicate {
+	a := Predicate(p.String() + ".and(" + other.String() + ")")
+	return &a
+}
+
+// Or combines this predicate with another one so
+// that a value must match at least one of them.
+func (p *Predicate) Or(other *Predicate) *Predicate {
+	a := Predicate(p.String() + ".or(" + other.String() + ")")
+	return &a
+}
+
+// Not negates the predicate.
+func Not(p *Predicate) *Predicate {
+	a := Predicate("not(" + p.String() + ")")
+	return &a
+}
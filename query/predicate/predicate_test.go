@@ -0,0 +1,113 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package predicate
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestComparePredicates(t *testing.T) {
+	Convey("Given compare predicates", t, func() {
+		Convey("When Between and Outside are called", func() {
+			Convey("Then the range should be rendered", func() {
+				So(Between(1, 5).String(), ShouldEqual, "between(1,5)")
+				So(Outside(1, 5).String(), ShouldEqual, "outside(1,5)")
+			})
+		})
+		Convey("When Within and Without are called with strings", func() {
+			Convey("Then the strings should be escaped", func() {
+				So(Within("a\"b", 2).String(), ShouldEqual, `within("a\"b",2)`)
+				So(Without("$x").String(), ShouldEqual, `without("\$x")`)
+			})
+		})
+		Convey("When Equal is called with a string", func() {
+			Convey("Then the string should be escaped", func() {
+				So(Equal("a\\b").String(), ShouldEqual, `eq("a\\b")`)
+			})
+		})
+		Convey("When they are called with nil, times and slices", func() {
+			Convey("Then the values should be Groovy literals", func() {
+				So(Equal(nil).String(), ShouldEqual, "eq(null)")
+				So(Between(time.Unix(1, 0), time.Unix(2, 0)).String(), ShouldEqual,
+					"between(new Date(1000L),new Date(2000L))")
+				So(Within([]int{1, 2}, "a").String(), ShouldEqual, `within([1,2],"a")`)
+			})
+		})
+	})
+}
+
+func TestComposition(t *testing.T) {
+	Convey("Given two predicates", t, func() {
+		gt, lt := GreaterThan(1), LessThan(5)
+		Convey("When they are combined", func() {
+			Convey("Then And, Or and Not should be rendered", func() {
+				So(gt.And(lt).String(), ShouldEqual, "gt(1).and(lt(5))")
+				So(gt.Or(lt).String(), ShouldEqual, "gt(1).or(lt(5))")
+				So(Not(gt.And(lt)).String(), ShouldEqual, "not(gt(1).and(lt(5)))")
+			})
+		})
+	})
+}
+
+func TestTextPredicates(t *testing.T) {
+	Convey("Given a string with a quote", t, func() {
+		str := `o"k`
+		Convey("When the text predicates are called", func() {
+			Convey("Then each should have its own name and a quoted argument", func() {
+				So(StartingWith(str).String(), ShouldEqual, `startingWith("o\"k")`)
+				So(NotContaining(str).String(), ShouldEqual, `notContaining("o\"k")`)
+				So(TextContainsPrefix(str).String(), ShouldEqual, `textContainsPrefix("o\"k")`)
+				So(TextContainsRegex(str).String(), ShouldEqual, `textContainsRegex("o\"k")`)
+				So(TextContainsFuzzy(str).String(), ShouldEqual, `textContainsFuzzy("o\"k")`)
+				So(TextNotContainsPhrase(str).String(), ShouldEqual, `textNotContainsPhrase("o\"k")`)
+				So(TextPrefix(str).String(), ShouldEqual, `textPrefix("o\"k")`)
+			})
+		})
+	})
+}
+
+func TestGeoPredicates(t *testing.T) {
+	Convey("Given geoshapes", t, func() {
+		Convey("When the shapes are made", func() {
+			Convey("Then they should be rendered as JanusGraph geoshapes", func() {
+				So(Point(37.97, 23.72).String(), ShouldEqual, "Geoshape.point(37.97,23.72)")
+				So(Circle(37.97, 23.72, 50).String(), ShouldEqual, "Geoshape.circle(37.97,23.72,50)")
+				So(Box(Coordinate{37, 23}, Coordinate{38.5, 24}).String(), ShouldEqual, "Geoshape.box(37,23,38.5,24)")
+				So(Line(Coordinate{59, 119}, Coordinate{58, 110}).String(), ShouldEqual,
+					"Geoshape.line([[119,59] as double[],[110,58] as double[]])")
+				So(Polygon(Coordinate{1, 2}, Coordinate{3, 4}, Coordinate{1, 2}).String(), ShouldEqual,
+					"Geoshape.polygon([[2,1] as double[],[4,3] as double[],[2,1] as double[]])")
+			})
+		})
+		Convey("When the geo predicates are called", func() {
+			shape := Circle(37.97, 23.72, 50)
+			Convey("Then the shape should be their argument", func() {
+				So(GeoWithin(shape).String(), ShouldEqual, "geoWithin(Geoshape.circle(37.97,23.72,50))")
+				So(GeoIntersect(shape).String(), ShouldEqual, "geoIntersect(Geoshape.circle(37.97,23.72,50))")
+				So(GeoDisjoint(shape).String(), ShouldEqual, "geoDisjoint(Geoshape.circle(37.97,23.72,50))")
+				So(GeoContains(Point(1, 2)).String(), ShouldEqual, "geoContains(Geoshape.point(1,2))")
+			})
+		})
+	})
+}
@@ -20,29 +20,49 @@
 
 package predicate
 
-// String search predicates which match
-// against the entire string value.
+import "github.com/northwesternmutual/grammes/query"
+
+// String search predicates from JanusGraph which
+// match against the entire string value.
 
 // TextPrefix finds if the string value starts
 // with the given string.
 func TextPrefix(str string) *Predicate {
-	s := "textPrefix(" + str + ")"
-	a := Predicate(s)
-	return &a
+	return text("textPrefix", str)
+}
+
+// TextNotPrefix finds if the string value doesn't
+// start with the given string.
+func TextNotPrefix(str string) *Predicate {
+	return text("textNotPrefix", str)
 }
 
 // TextRegex finds if the string value matches
 // the given regular expression in its entirety.
 func TextRegex(str string) *Predicate {
-	s := "textRegex(" + str + ")"
-	a := Predicate(s)
-	return &a
+	return text("textRegex", str)
+}
+
+// TextNotRegex finds if the string value doesn't
+// match the given regular expression in its entirety.
+func TextNotRegex(str string) *Predicate {
+	return text("textNotRegex", str)
 }
 
 // TextFuzzy finds if the string value is
 // similar to the given query string.
 func TextFuzzy(str string) *Predicate {
-	s := "textFuzzy(" + str + ")"
-	a := Predicate(s)
+	return text("textFuzzy", str)
+}
+
+// TextNotFuzzy finds if the string value isn't
+// similar to the given query string.
+func TextNotFuzzy(str string) *Predicate {
+	return text("textNotFuzzy", str)
+}
+
+// text makes a predicate that takes a single string.
+func text(name, str string) *Predicate {
+	a := Predicate(name + "(" + query.Quote(str) + ")")
 	return &a
 }
@@ -20,37 +20,67 @@
 
 package predicate
 
-// Text search predicates which match against the individual words inside a text
-// string after it has been tokenized. These predicates are not case sensitive.
+// Text search predicates from JanusGraph which match against the individual words
+// inside a text string after it has been tokenized. These predicates are not case sensitive.
 
 // TextContains finds if at least one word inside
 // the text string matches the query string.
 func TextContains(str string) *Predicate {
-	s := "textContains(" + str + ")"
-	a := Predicate(s)
-	return &a
+	return text("textContains", str)
+}
+
+// TextNotContains finds if no word inside the
+// text string matches the query string.
+func TextNotContains(str string) *Predicate {
+	return text("textNotContains", str)
 }
 
 // TextContainsPrefix finds if one word inside
 // the text string begins with the query string.
 func TextContainsPrefix(str string) *Predicate {
-	s := "textContains(" + str + ")"
-	a := Predicate(s)
-	return &a
+	return text("textContainsPrefix", str)
+}
+
+// TextNotContainsPrefix finds if no word inside
+// the text string begins with the query string.
+func TextNotContainsPrefix(str string) *Predicate {
+	return text("textNotContainsPrefix", str)
 }
 
 // TextContainsRegex finds if one word inside
 // the text string matches the given regular expression.
 func TextContainsRegex(str string) *Predicate {
-	s := "textContains(" + str + ")"
-	a := Predicate(s)
-	return &a
+	return text("textContainsRegex", str)
+}
+
+// TextNotContainsRegex finds if no word inside
+// the text string matches the given regular expression.
+func TextNotContainsRegex(str string) *Predicate {
+	return text("textNotContainsRegex", str)
 }
 
 // TextContainsFuzzy finds if one word inside
 // the text string is similar to the query string.
 func TextContainsFuzzy(str string) *Predicate {
-	s := "textContains(" + str + ")"
-	a := Predicate(s)
-	return &a
+	return text("textContainsFuzzy", str)
+}
+
+// TextNotContainsFuzzy finds if no word inside
+// the text string is similar to the query string.
+func TextNotContainsFuzzy(str string) *Predicate {
+	return text("textNotContainsFuzzy", str)
+}
+
+// TextContainsPhrase finds if the words inside the
+// text string include the words of the query string
+// next to each other and in the same order.
+func TextContainsPhrase(str string) *Predicate {
+	return text("textContainsPhrase", str)
+}
+
+// TextNotContainsPhrase finds if the words inside
+// the text string don't include the words of the
+// query string next to each other and in order.
+func TextNotContainsPhrase(str string) *Predicate {
+	return text("textNotContainsPhrase", str)
 }
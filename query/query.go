@@ -29,3 +29,12 @@ package query
 type Query interface {
 	String() string
 }
+
+// Validator is a Query that keeps the errors
+// made while it was built, such as a step given
+// too many arguments. Err returns nil when the
+// query can be sent to the server.
+type Validator interface {
+	Query
+	Err() error
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+/*
+Package schemaaction contains the object to change the state of a JanusGraph index.
+
+See: https://docs.janusgraph.org/index-management/index-reindexing/
+
+SchemaAction and its constant values are the string equivalent of
+JanusGraph's SchemaAction when referencing them in a traversal/query.
+
+A note about SchemaAction:
+
+This object implements the Parameter interfaces used by graph traversals.
+*/
+package schemaaction
+
+// JanusGraph:
+// https://javadoc.io/doc/org.janusgraph/janusgraph-core/latest/org/janusgraph/core/schema/SchemaAction.html
+
+// SchemaAction is an action that is performed
+// on an index to move it through its lifecycle.
+type SchemaAction string
+
+const (
+	// RegisterIndex registers the index with all instances
+	// in the cluster. This moves an index to REGISTERED.
+	RegisterIndex SchemaAction = "SchemaAction.REGISTER_INDEX"
+	// Reindex rebuilds the index from scratch using the
+	// existing data. This moves an index to ENABLED.
+	Reindex SchemaAction = "SchemaAction.REINDEX"
+	// EnableIndex enables the index so it is used by
+	// queries. This moves an index to ENABLED.
+	EnableIndex SchemaAction = "SchemaAction.ENABLE_INDEX"
+	// DisableIndex disables the index so it is no longer
+	// used. This moves an index to DISABLED.
+	DisableIndex SchemaAction = "SchemaAction.DISABLE_INDEX"
+	// RemoveIndex removes a disabled index from the graph.
+	RemoveIndex SchemaAction = "SchemaAction.REMOVE_INDEX"
+)
+
+// String will convert SchemaAction to a string.
+func (s SchemaAction) String() string {
+	return string(s)
+}
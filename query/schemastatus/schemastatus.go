@@ -0,0 +1,58 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+/*
+Package schemastatus contains the object to describe the state of a JanusGraph index.
+
+See: https://docs.janusgraph.org/index-management/index-lifecycle/
+
+SchemaStatus and its constant values are the string equivalent of
+JanusGraph's SchemaStatus when referencing them in a traversal/query.
+
+A note about SchemaStatus:
+
+This object implements the Parameter interfaces used by graph traversals.
+*/
+package schemastatus
+
+// JanusGraph:
+// https://javadoc.io/doc/org.janusgraph/janusgraph-core/latest/org/janusgraph/core/schema/SchemaStatus.html
+
+// SchemaStatus designates the state an index is in
+// while it's being built, enabled, or disabled.
+type SchemaStatus string
+
+const (
+	// Installed means the index is installed in the system but
+	// not yet registered with all instances in the cluster.
+	Installed SchemaStatus = "SchemaStatus.INSTALLED"
+	// Registered means the index is registered with all instances
+	// in the cluster but not (yet) enabled.
+	Registered SchemaStatus = "SchemaStatus.REGISTERED"
+	// Enabled means the index is enabled and in use.
+	Enabled SchemaStatus = "SchemaStatus.ENABLED"
+	// Disabled means the index is disabled and no longer in use.
+	Disabled SchemaStatus = "SchemaStatus.DISABLED"
+)
+
+// String will convert SchemaStatus to a string.
+func (s SchemaStatus) String() string {
+	return string(s)
+}
@@ -29,10 +29,8 @@ package traversal
 // AddE(*String)
 func (g String) AddE(param interface{}) String {
 	switch param.(type) {
-	case String:
-		g = g.append(".addE(" + param.(String).Raw().String() + ")")
-	case string:
-		g = g.append(".addE(\"" + param.(string) + "\")")
+	case Anonymous, String, string:
+		g.AddStep("addE", param)
 	default:
 		g.AddStep("addE")
 	}
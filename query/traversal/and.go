@@ -26,21 +26,15 @@ package traversal
 // traversals yield a result.
 // Signatures:
 // And()
-// And(...*String (Traversal))
-func (g String) And(params ...String) String {
-	g = g.append(".and(")
+// And(...Anonymous (Traversal))
+func (g String) And(params ...Anonymous) String {
+	var p []interface{}
 
-	if len(params) > 0 {
-		g = g.append(params[0].String())
+	for _, v := range params {
+		p = append(p, v)
 	}
 
-	if len(params) > 1 {
-		for _, v := range params[1:] {
-			g = g.append("," + v.String())
-		}
-	}
-
-	g = g.append(")")
+	g.AddStep("and", p...)
 
 	return g
 }
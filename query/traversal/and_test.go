@@ -37,18 +37,18 @@ func TestAnd(t *testing.T) {
 		})
 
 		Convey("When 'And' is called with a traversal", func() {
-			result := g.And(NewTraversal().Label().Raw())
-			Convey("Then result should equal 'g.and(label())'", func() {
-				So(result.String(), ShouldEqual, "g.and(label())")
+			result := g.And(NewAnonymousTraversal().Label())
+			Convey("Then result should equal 'g.and(__.label())'", func() {
+				So(result.String(), ShouldEqual, "g.and(__.label())")
 			})
 		})
 
 		Convey("When 'And' is called with multiple params", func() {
-			obj1 := NewTraversal().Label().Raw()
-			obj2 := NewTraversal().Key().Raw()
+			obj1 := NewAnonymousTraversal().Label()
+			obj2 := NewAnonymousTraversal().Key()
 			result := g.And(obj1, obj2)
-			Convey("Then result should equal 'g.and(label(),key())'", func() {
-				So(result.String(), ShouldEqual, "g.and(label(),key())")
+			Convey("Then result should equal 'g.and(__.label(),__.key())'", func() {
+				So(result.String(), ShouldEqual, "g.and(__.label(),__.key())")
 			})
 		})
 	})
@@ -0,0 +1,729 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package traversal
+
+import (
+	"github.com/northwesternmutual/grammes/query/direction"
+	"github.com/northwesternmutual/grammes/query/dt"
+	"github.com/northwesternmutual/grammes/query/operator"
+	"github.com/northwesternmutual/grammes/query/scope"
+)
+
+// Anonymous is a traversal without a source that is
+// nested inside of the steps of another traversal,
+// such as Repeat() and Where(). It's usually started
+// with one of the functions of the __ package.
+type Anonymous struct {
+	steps String
+}
+
+// NewAnonymousTraversal returns an anonymous traversal
+// without any steps, which renders as __.
+func NewAnonymousTraversal() Anonymous {
+	return Anonymous{NewCustomTraversal("__")}
+}
+
+func (a Anonymous) String() string {
+	return a.steps.String()
+}
+
+// AddStep will add a new step to the anonymous
+// traversal using a list of parameters.
+func (a *Anonymous) AddStep(step string, params ...interface{}) {
+	a.steps.AddStep(step, params...)
+}
+
+// Steps returns a copy of the steps of the anonymous traversal.
+func (a Anonymous) Steps() []Step {
+	return a.steps.Steps()
+}
+
+// Replace returns the anonymous traversal with the step
+// at the index replaced by the given steps.
+func (a Anonymous) Replace(index int, steps ...Step) Anonymous {
+	return Anonymous{a.steps.Replace(index, steps...)}
+}
+
+// AddE (map/sideEffect) adds a new edge between two vertices on the
+// graph.
+func (a Anonymous) AddE(param interface{}) Anonymous {
+	return Anonymous{a.steps.AddE(param)}
+}
+
+// Property (sideEffect) unlike AddV() and AddE(), Property() is a full
+// sideEffect step in that it does not return the property that it
+// created, but the element that streamed into it.
+func (a Anonymous) Property(objOrCard interface{}, obj interface{}, params ...interface{}) Anonymous {
+	return Anonymous{a.steps.Property(objOrCard, obj, params...)}
+}
+
+// AddV (map/sideEffect) is used to add vertices to the graph.
+func (a Anonymous) AddV(params ...interface{}) Anonymous {
+	return Anonymous{a.steps.AddV(params...)}
+}
+
+// Aggregate (sideEffect) is used to aggregate all the objects in a
+// particular point of traversal into a Collection.
+func (a Anonymous) Aggregate(str string) Anonymous {
+	return Anonymous{a.steps.Aggregate(str)}
+}
+
+// And (filter) ensures that all provided traversals yield a result.
+func (a Anonymous) And(params ...Anonymous) Anonymous {
+	return Anonymous{a.steps.And(params...)}
+}
+
+// As (step modulator) similar to By() & Option().
+func (a Anonymous) As(labels ...string) Anonymous {
+	return Anonymous{a.steps.As(labels...)}
+}
+
+// AsString (map) turns the traverser into a string. With
+// the local scope the items of a list are turned into
+// strings instead. Added in TinkerPop 3.7.1.
+func (a Anonymous) AsString(scopes ...scope.Scope) Anonymous {
+	return Anonymous{a.steps.AsString(scopes...)}
+}
+
+// Barrier (barrier) turns the lazy traversal pipeline into a bulk-
+// synchronous pipeline.
+func (a Anonymous) Barrier(param ...interface{}) Anonymous {
+	return Anonymous{a.steps.Barrier(param...)}
+}
+
+// Both moves to both the incoming and outgoing adjacent vertices given
+// the edge labels.
+func (a Anonymous) Both(labels ...string) Anonymous {
+	return Anonymous{a.steps.Both(labels...)}
+}
+
+// BothE moves to both the incoming and outgoing incident edges given
+// the edge labels.
+func (a Anonymous) BothE(labels ...string) Anonymous {
+	return Anonymous{a.steps.BothE(labels...)}
+}
+
+// BothV moves to both vertices.
+func (a Anonymous) BothV() Anonymous {
+	return Anonymous{a.steps.BothV()}
+}
+
+// By (step modulator) similar to As() & Option().
+func (a Anonymous) By(params ...interface{}) Anonymous {
+	return Anonymous{a.steps.By(params...)}
+}
+
+// Call (map/flatMap) calls a service offered by the graph
+// database. The params are usually a map or an anonymous
+// traversal that gives the map. Added in TinkerPop 3.6.0.
+func (a Anonymous) Call(params ...interface{}) Anonymous {
+	return Anonymous{a.steps.Call(params...)}
+}
+
+// Cap (barrier) iterates the traversal up to itself and emits the
+// sideEffect referenced by the provided key.
+func (a Anonymous) Cap(str string, optStrings ...string) Anonymous {
+	return Anonymous{a.steps.Cap(str, optStrings...)}
+}
+
+// Choose (branch) routes the current traverser to a particular
+// traversal branch option.
+func (a Anonymous) Choose(first interface{}, optTraversals ...Anonymous) Anonymous {
+	return Anonymous{a.steps.Choose(first, optTraversals...)}
+}
+
+// Coalesce evaluates the provided traversals in order and returns the
+// first traversal that emits at least one element.
+func (a Anonymous) Coalesce(traversals ...Anonymous) Anonymous {
+	return Anonymous{a.steps.Coalesce(traversals...)}
+}
+
+// Coin (filter) randomly filters out a traverser.
+func (a Anonymous) Coin(bias float32) Anonymous {
+	return Anonymous{a.steps.Coin(bias)}
+}
+
+// Concat (map) joins the string of the traverser with the given
+// strings or the results of the given traversals. Added in
+// TinkerPop 3.7.1.
+func (a Anonymous) Concat(strsOrTraversals ...interface{}) Anonymous {
+	return Anonymous{a.steps.Concat(strsOrTraversals...)}
+}
+
+// ConnectedComponent (map) finds the component that each vertex
+// belongs to with a graph computer. Added in TinkerPop 3.4.0.
+func (a Anonymous) ConnectedComponent() Anonymous {
+	return Anonymous{a.steps.ConnectedComponent()}
+}
+
+// Constant (map) is used to specify a constant value for a traverser.
+func (a Anonymous) Constant(obj string) Anonymous {
+	return Anonymous{a.steps.Constant(obj)}
+}
+
+// Count (map) counts the total number of represented traversers in the
+// stream.
+func (a Anonymous) Count(scope ...scope.Scope) Anonymous {
+	return Anonymous{a.steps.Count(scope...)}
+}
+
+// CyclicPath (filter) analyzes the path of the traverser thus far and
+// if there are any repeats, the traverser is filtered out over the
+// traersal computation.
+func (a Anonymous) CyclicPath() Anonymous {
+	return Anonymous{a.steps.CyclicPath()}
+}
+
+// DateAdd (map) adds the amount of the unit of time to
+// the date. Added in TinkerPop 3.7.1.
+func (a Anonymous) DateAdd(unit dt.DT, value int) Anonymous {
+	return Anonymous{a.steps.DateAdd(unit, value)}
+}
+
+// DateDiff (map) gives the number of seconds between the
+// date and the given date, or the date given by the
+// traversal. Added in TinkerPop 3.7.1.
+func (a Anonymous) DateDiff(dateOrTraversal interface{}) Anonymous {
+	return Anonymous{a.steps.DateDiff(dateOrTraversal)}
+}
+
+// Dedup (filter) repeatedly seen objects are removed from the
+// traversal stream.
+func (a Anonymous) Dedup(params ...interface{}) Anonymous {
+	return Anonymous{a.steps.Dedup(params...)}
+}
+
+// Drop (filter/sideEffect) will remove an element and properties from
+// the graph.
+func (a Anonymous) Drop() Anonymous {
+	return Anonymous{a.steps.Drop()}
+}
+
+// E is to access the edges of the traversal.
+func (a Anonymous) E() Anonymous {
+	return Anonymous{a.steps.E()}
+}
+
+// Element (map) moves from a property to the element
+// that holds it. Added in TinkerPop 3.6.0.
+func (a Anonymous) Element() Anonymous {
+	return Anonymous{a.steps.Element()}
+}
+
+// ElementMap (map) yields a map representation of the structure of an
+// element, with its id, label and properties. Edges also have their
+// incoming and outgoing vertices. Added in TinkerPop 3.4.4.
+func (a Anonymous) ElementMap(keys ...string) Anonymous {
+	return Anonymous{a.steps.ElementMap(keys...)}
+}
+
+// Emit (step modulator) for Repeat().
+func (a Anonymous) Emit(predOrTrav ...interface{}) Anonymous {
+	return Anonymous{a.steps.Emit(predOrTrav...)}
+}
+
+// Fail (filter) stops the traversal with an error when a
+// traverser reaches it. Added in TinkerPop 3.6.0.
+func (a Anonymous) Fail(message ...string) Anonymous {
+	return Anonymous{a.steps.Fail(message...)}
+}
+
+// Filter (filter) removes the traversers for
+// which the traversal has no results.
+func (a Anonymous) Filter(traversal Anonymous) Anonymous {
+	return Anonymous{a.steps.Filter(traversal)}
+}
+
+// FlatMap (flatMap) replaces each traverser with
+// every result of the traversal.
+func (a Anonymous) FlatMap(traversal Anonymous) Anonymous {
+	return Anonymous{a.steps.FlatMap(traversal)}
+}
+
+// Fold (map) is used when the traversal stream needs a "barrier" to
+// aggregate all the objects and emite a computation that is a function
+// of the aggregate.
+func (a Anonymous) Fold(params ...interface{}) Anonymous {
+	return Anonymous{a.steps.Fold(params...)}
+}
+
+// From (step-modulator) similar to As() and By().
+func (a Anonymous) From(param interface{}) Anonymous {
+	return Anonymous{a.steps.From(param)}
+}
+
+// V will return the vertices that belong to this graph traversal.
+func (a Anonymous) V(params ...interface{}) Anonymous {
+	return Anonymous{a.steps.V(params...)}
+}
+
+// Group (map/sideEffect) is one such sideEffect that organizes the
+// objects according to some function of the object.
+func (a Anonymous) Group(str ...string) Anonymous {
+	return Anonymous{a.steps.Group(str...)}
+}
+
+// GroupCount (map/sideEffect) is the amount of times a particular
+// object has been at a particular part of a traversal.
+func (a Anonymous) GroupCount(str ...string) Anonymous {
+	return Anonymous{a.steps.GroupCount(str...)}
+}
+
+// Has (filter) filters vertices, edges, and vertex properties based on
+// their properties.
+func (a Anonymous) Has(first interface{}, params ...interface{}) Anonymous {
+	return Anonymous{a.steps.Has(first, params...)}
+}
+
+// HasID (filter) filters vertices, edges, and vertex properties based
+// on their properties.
+func (a Anonymous) HasID(objOrP interface{}, objs ...string) Anonymous {
+	return Anonymous{a.steps.HasID(objOrP, objs...)}
+}
+
+// HasKey (filter) filters vertices, edges, and vertex properties based
+// on their properties.
+func (a Anonymous) HasKey(pOrStr interface{}, handledStrings ...string) Anonymous {
+	return Anonymous{a.steps.HasKey(pOrStr, handledStrings...)}
+}
+
+// HasLabel (filter) filters vertices, edges, and vertex properties
+// based on their properties.
+func (a Anonymous) HasLabel(pOrStr interface{}, handledStrings ...string) Anonymous {
+	return Anonymous{a.steps.HasLabel(pOrStr, handledStrings...)}
+}
+
+// HasNot (filter) filters vertices, edges, and vertex properties based
+// on their properties.
+func (a Anonymous) HasNot(str string) Anonymous {
+	return Anonymous{a.steps.HasNot(str)}
+}
+
+// HasValue (filter) filters vertices, edges, and vertex properties
+// based on their properties.
+func (a Anonymous) HasValue(objOrP interface{}, objs ...string) Anonymous {
+	return Anonymous{a.steps.HasValue(objOrP, objs...)}
+}
+
+// ID (map) takes an Element and extracts its identifier from it.
+func (a Anonymous) ID() Anonymous {
+	return Anonymous{a.steps.ID()}
+}
+
+// Identity (map) is an identity function which maps the current object
+// to itself.
+func (a Anonymous) Identity() Anonymous {
+	return Anonymous{a.steps.Identity()}
+}
+
+// In moves to the incoming adjacent vertices given the edge labels.
+func (a Anonymous) In(labels ...string) Anonymous {
+	return Anonymous{a.steps.In(labels...)}
+}
+
+// InE moves to the incoming incident edges given the edge labels.
+func (a Anonymous) InE(labels ...string) Anonymous {
+	return Anonymous{a.steps.InE(labels...)}
+}
+
+// InV moves to the incoming vertex.
+func (a Anonymous) InV() Anonymous {
+	return Anonymous{a.steps.InV()}
+}
+
+// Index (map) pairs each item of a collection with its
+// position in it. Added in TinkerPop 3.4.0.
+func (a Anonymous) Index() Anonymous {
+	return Anonymous{a.steps.Index()}
+}
+
+// Inject (sideEffect) makes it possible to insert objects arbitrarily
+// into a traversal stream.
+func (a Anonymous) Inject(obj string) Anonymous {
+	return Anonymous{a.steps.Inject(obj)}
+}
+
+// Is (filter) makes it possible to filter scalar values.
+func (a Anonymous) Is(objOrP interface{}) Anonymous {
+	return Anonymous{a.steps.Is(objOrP)}
+}
+
+// Key (map) takes a Property and extracts the key from it.
+func (a Anonymous) Key() Anonymous {
+	return Anonymous{a.steps.Key()}
+}
+
+// Label (map) takes an element and extracts its label from it.
+func (a Anonymous) Label() Anonymous {
+	return Anonymous{a.steps.Label()}
+}
+
+// Limit (analogous to range) except the minimum will always be 0 there
+// are two typical parameters for Limit.
+func (a Anonymous) Limit(params ...interface{}) Anonymous {
+	return Anonymous{a.steps.Limit(params...)}
+}
+
+// Local (branch) allows for object-local traversal computations.
+func (a Anonymous) Local(traversal Anonymous) Anonymous {
+	return Anonymous{a.steps.Local(traversal)}
+}
+
+// Loops (map) extracts the number of times the traverser has gone
+// through a current loop.
+func (a Anonymous) Loops() Anonymous {
+	return Anonymous{a.steps.Loops()}
+}
+
+// Map (map) replaces each traverser with the first
+// result of the traversal.
+func (a Anonymous) Map(traversal Anonymous) Anonymous {
+	return Anonymous{a.steps.Map(traversal)}
+}
+
+// Match (map) provides a more declarative form of graph querying based
+// on the notion of pattern matching.
+func (a Anonymous) Match(traversals ...String) Anonymous {
+	return Anonymous{a.steps.Match(traversals...)}
+}
+
+// Math (math) neables scientific calculator functionality within
+// Gremlin.
+func (a Anonymous) Math(str string) Anonymous {
+	return Anonymous{a.steps.Math(str)}
+}
+
+// Max (map) operates on a stream of numbers and determines which is
+// the largest number in the stream.
+func (a Anonymous) Max(scopes ...scope.Scope) Anonymous {
+	return Anonymous{a.steps.Max(scopes...)}
+}
+
+// Mean (map) operates on a stream of numbers and determines the
+// average of those two numbers.
+func (a Anonymous) Mean(scopes ...scope.Scope) Anonymous {
+	return Anonymous{a.steps.Mean(scopes...)}
+}
+
+// MergeE (map/sideEffect) gets the edge that matches the map or
+// creates it when there is none. The map takes the label and the
+// Direction.OUT and Direction.IN vertices along with the properties.
+// Added in TinkerPop 3.6.0.
+func (a Anonymous) MergeE(searchCreate ...interface{}) Anonymous {
+	return Anonymous{a.steps.MergeE(searchCreate...)}
+}
+
+// MergeV (map/sideEffect) gets the vertex that matches the map or
+// creates it when there is none. The map can be given as a
+// map[string]interface{}, a map[interface{}]interface{} with Token
+// keys or an anonymous traversal. Added in TinkerPop 3.6.0.
+func (a Anonymous) MergeV(searchCreate ...interface{}) Anonymous {
+	return Anonymous{a.steps.MergeV(searchCreate...)}
+}
+
+// Min (map) operates on astream of numbers and determines which is the
+// smallest number in the stream.
+func (a Anonymous) Min(scopes ...scope.Scope) Anonymous {
+	return Anonymous{a.steps.Min(scopes...)}
+}
+
+// None (filter) removes every traverser, which is used to
+// run a traversal only for its side effects. Added in
+// TinkerPop 3.5.0.
+func (a Anonymous) None() Anonymous {
+	return Anonymous{a.steps.None()}
+}
+
+// Not (filter) removes objects from the traversal stream when the
+// traversal provided as an argument does not return any objects.
+func (a Anonymous) Not(traversal Anonymous) Anonymous {
+	return Anonymous{a.steps.Not(traversal)}
+}
+
+// Option (step modulator) is an 'option' to a Branch() or Choose().
+func (a Anonymous) Option(params ...string) Anonymous {
+	return Anonymous{a.steps.Option(params...)}
+}
+
+// Optional (branch/filterMap) returns the result of the specified
+// traversal if it yields a result else it returns the calling element
+// i.e.
+func (a Anonymous) Optional(traversal Anonymous) Anonymous {
+	return Anonymous{a.steps.Optional(traversal)}
+}
+
+// Or (filter) ensures that at least of the provided traversals yield a
+// result.
+func (a Anonymous) Or(traversals ...Anonymous) Anonymous {
+	return Anonymous{a.steps.Or(traversals...)}
+}
+
+// Order (map) sorts the objects of the traversal stream.
+func (a Anonymous) Order(scope ...scope.Scope) Anonymous {
+	return Anonymous{a.steps.Order(scope...)}
+}
+
+// OtherV moves to the vertex that was not the vertex that was moved
+// from.
+func (a Anonymous) OtherV() Anonymous {
+	return Anonymous{a.steps.OtherV()}
+}
+
+// Out moves to the outgoing adjacent vertices given the edge labels.
+func (a Anonymous) Out(labels ...string) Anonymous {
+	return Anonymous{a.steps.Out(labels...)}
+}
+
+// OutE moves to the outgoing incident edges given the edge labels.
+func (a Anonymous) OutE(labels ...string) Anonymous {
+	return Anonymous{a.steps.OutE(labels...)}
+}
+
+// OutV moves to the outgoing vertex.
+func (a Anonymous) OutV() Anonymous {
+	return Anonymous{a.steps.OutV()}
+}
+
+// PageRank (map/sideEffect) calculates PageRank using
+// PageRankVertexProgram.
+func (a Anonymous) PageRank(args ...float32) Anonymous {
+	return Anonymous{a.steps.PageRank(args...)}
+}
+
+// Path (map) realizes the history of the traverser and its path.
+func (a Anonymous) Path() Anonymous {
+	return Anonymous{a.steps.Path()}
+}
+
+// PeerPressure (map/sideEffect) clusters vertices using
+// PeerPressureVertexProgram.
+func (a Anonymous) PeerPressure() Anonymous {
+	return Anonymous{a.steps.PeerPressure()}
+}
+
+// Profile (sideEffect) exists to allow developers to profile their
+// traversals to determine statistical information like step runtime,
+// counts, etc.
+func (a Anonymous) Profile(str ...string) Anonymous {
+	return Anonymous{a.steps.Profile(str...)}
+}
+
+// Program (map/sideEffect) is the "lambda" step for GraphComputer
+// jobs.
+func (a Anonymous) Program(vertexProgram interface{}) Anonymous {
+	return Anonymous{a.steps.Program(vertexProgram)}
+}
+
+// Project (map) projects the current object into a Map<string, object>
+// keyed by provided labels.
+func (a Anonymous) Project(str string, extraStrings ...string) Anonymous {
+	return Anonymous{a.steps.Project(str, extraStrings...)}
+}
+
+// Properties (map) extracts properties from an Element in the
+// traversal stream.
+func (a Anonymous) Properties(str ...string) Anonymous {
+	return Anonymous{a.steps.Properties(str...)}
+}
+
+// PropertyMap (map) extracts properties from an Element in the
+// traversal stream.
+func (a Anonymous) PropertyMap(str ...string) Anonymous {
+	return Anonymous{a.steps.PropertyMap(str...)}
+}
+
+// Range (filter) allows for filtering on a low-end and high-end when
+// objects are being iterated.
+func (a Anonymous) Range(params ...interface{}) Anonymous {
+	return Anonymous{a.steps.Range(params...)}
+}
+
+// Repeat (branch) is used for loopping over a traversal given some
+// break predicate.
+func (a Anonymous) Repeat(traversal Anonymous) Anonymous {
+	return Anonymous{a.steps.Repeat(traversal)}
+}
+
+// Sack (sideEffect or map) is used to read and write sacks.
+func (a Anonymous) Sack(operator ...operator.Operator) Anonymous {
+	return Anonymous{a.steps.Sack(operator...)}
+}
+
+// Sample (step-modulator) is useful for sampling some number of
+// traversers previous in the traversal.
+func (a Anonymous) Sample(params ...interface{}) Anonymous {
+	return Anonymous{a.steps.Sample(params...)}
+}
+
+// Select (map) Can go back in a traversal in the previously seen area
+// of computation.
+func (a Anonymous) Select(first interface{}, extras ...interface{}) Anonymous {
+	return Anonymous{a.steps.Select(first, extras...)}
+}
+
+// ShortestPath (map) finds the shortest paths between vertices
+// with a graph computer. It's configured with With() and
+// options such as ShortestPath.target. Added in TinkerPop 3.4.0.
+func (a Anonymous) ShortestPath() Anonymous {
+	return Anonymous{a.steps.ShortestPath()}
+}
+
+// SideEffect (sideEffect) runs the traversal for each traverser
+// and passes the traverser on without changing it.
+func (a Anonymous) SideEffect(traversal Anonymous) Anonymous {
+	return Anonymous{a.steps.SideEffect(traversal)}
+}
+
+// SimplePath (filter) should be used when it's important that the
+// traverser should not repeat its path through the graph.
+func (a Anonymous) SimplePath() Anonymous {
+	return Anonymous{a.steps.SimplePath()}
+}
+
+// Skip (filter) is analogous to Range() save that the higher end range
+// is set to -1.
+func (a Anonymous) Skip(first interface{}, extraFloat ...float32) Anonymous {
+	return Anonymous{a.steps.Skip(first, extraFloat...)}
+}
+
+// Split (map) splits the string into a list at every
+// separator. Added in TinkerPop 3.7.1.
+func (a Anonymous) Split(first interface{}, separator ...string) Anonymous {
+	return Anonymous{a.steps.Split(first, separator...)}
+}
+
+// Store (sideEffect) should be bused over Aggregate() when lazy
+// aggregation is needed.
+func (a Anonymous) Store(str string) Anonymous {
+	return Anonymous{a.steps.Store(str)}
+}
+
+// SubGraph (sideEffect) provides a way to produce an edge-induced
+// subgraph from virtually any traversal.
+func (a Anonymous) SubGraph(str string) Anonymous {
+	return Anonymous{a.steps.SubGraph(str)}
+}
+
+// Sum (map) operates on a stream of numbers and sums the numbers
+// together to yield a double.
+func (a Anonymous) Sum(scopes ...scope.Scope) Anonymous {
+	return Anonymous{a.steps.Sum(scopes...)}
+}
+
+// Tail (filter) is analogous to Limit(), except that it emits the last
+// n-objects instead of the first n-objects.
+func (a Anonymous) Tail(first interface{}, extraFloat ...float32) Anonymous {
+	return Anonymous{a.steps.Tail(first, extraFloat...)}
+}
+
+// TimeLimit (filter) should be used if you wish the traversal to
+// execute within a certain time.
+func (a Anonymous) TimeLimit(limit float32) Anonymous {
+	return Anonymous{a.steps.TimeLimit(limit)}
+}
+
+// To (step-modulator) similar to As() and By().
+func (a Anonymous) To(first interface{}, extraStrings ...string) Anonymous {
+	return Anonymous{a.steps.To(first, extraStrings...)}
+}
+
+// ToE (step-modulator) is a part of To().
+func (a Anonymous) ToE(dir direction.Direction, str string) Anonymous {
+	return Anonymous{a.steps.ToE(dir, str)}
+}
+
+// ToLower (map) turns the string to lower case.
+// Added in TinkerPop 3.7.1.
+func (a Anonymous) ToLower(scopes ...scope.Scope) Anonymous {
+	return Anonymous{a.steps.ToLower(scopes...)}
+}
+
+// ToUpper (map) turns the string to upper case.
+// Added in TinkerPop 3.7.1.
+func (a Anonymous) ToUpper(scopes ...scope.Scope) Anonymous {
+	return Anonymous{a.steps.ToUpper(scopes...)}
+}
+
+// ToV (step-modulator) is a part of To().
+func (a Anonymous) ToV(dir direction.Direction) Anonymous {
+	return Anonymous{a.steps.ToV(dir)}
+}
+
+// ToVId can be used to make a string query that will take a vertex id
+// as a parameter, and can be used to point an edge towards this vertex
+// ID.
+func (a Anonymous) ToVId(vertexID interface{}) Anonymous {
+	return Anonymous{a.steps.ToVId(vertexID)}
+}
+
+// Tree (sideEffect) is used when the emanating paths from an element
+// can be aggregated to form a tree.
+func (a Anonymous) Tree(str ...string) Anonymous {
+	return Anonymous{a.steps.Tree(str...)}
+}
+
+// Unfold (flatMap) is an iterator, iterable, or map, then it is
+// unrolled into a linear form.
+func (a Anonymous) Unfold() Anonymous {
+	return Anonymous{a.steps.Unfold()}
+}
+
+// Union (branch) supports the merging of the results of an arbitrary
+// number of traversers.
+func (a Anonymous) Union(traversals ...Anonymous) Anonymous {
+	return Anonymous{a.steps.Union(traversals...)}
+}
+
+// Until (step-modulator) for Repeat().
+func (a Anonymous) Until(predOrTrav interface{}) Anonymous {
+	return Anonymous{a.steps.Until(predOrTrav)}
+}
+
+// Value (map) takes a property and extracts the value from it.
+func (a Anonymous) Value() Anonymous {
+	return Anonymous{a.steps.Value()}
+}
+
+// ValueMap (map) yields a map representation of the properties of an
+// element.
+func (a Anonymous) ValueMap(boolOrStrings ...interface{}) Anonymous {
+	return Anonymous{a.steps.ValueMap(boolOrStrings...)}
+}
+
+// Values (map) extracts the values of properties from an element in
+// the traversal stream.
+func (a Anonymous) Values(strs ...string) Anonymous {
+	return Anonymous{a.steps.Values(strs...)}
+}
+
+// Where (filter) filters the current objects based on either the
+// object itself or the path history of the object.
+func (a Anonymous) Where(first interface{}, extra ...string) Anonymous {
+	return Anonymous{a.steps.Where(first, extra...)}
+}
+
+// With (step modulator) gives a configuration option to the
+// step before it, such as the target of ShortestPath(). Keys
+// that are constants such as ShortestPath.target should be
+// given as a Custom. Added in TinkerPop 3.4.0.
+func (a Anonymous) With(key interface{}, value ...interface{}) Anonymous {
+	return Anonymous{a.steps.With(key, value...)}
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package traversal
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/query/predicate"
+)
+
+func TestNewAnonymousTraversal(t *testing.T) {
+	Convey("Given an anonymous traversal", t, func() {
+		a := NewAnonymousTraversal()
+		Convey("When steps are added", func() {
+			result := a.Out("knows").HasLabel("person")
+			Convey("Then it should start from __", func() {
+				So(result.String(), ShouldEqual, `__.out("knows").hasLabel("person")`)
+			})
+		})
+		Convey("When AddStep is called", func() {
+			a.AddStep("hasId", 1, 2)
+			Convey("Then the step should be added", func() {
+				So(a.String(), ShouldEqual, "__.hasId(1,2)")
+			})
+		})
+	})
+}
+
+func TestAnonymousNested(t *testing.T) {
+	Convey("Given a graph traversal", t, func() {
+		g := NewTraversal().V()
+		Convey("When Where is called with an anonymous traversal", func() {
+			result := g.Where(NewAnonymousTraversal().OutE("knows"))
+			Convey("Then the anonymous traversal should be nested", func() {
+				So(result.String(), ShouldEqual, `g.V().where(__.outE("knows"))`)
+			})
+		})
+		Convey("When Emit and Until are called with anonymous traversals", func() {
+			result := g.Repeat(NewAnonymousTraversal().Out()).
+				Emit(NewAnonymousTraversal().HasLabel("person")).
+				Until(NewAnonymousTraversal().Has("age", predicate.GreaterThan(30)))
+			Convey("Then the anonymous traversals should be nested", func() {
+				So(result.String(), ShouldEqual,
+					`g.V().repeat(__.out()).emit(__.hasLabel("person")).until(__.has("age",gt(30)))`)
+			})
+		})
+		Convey("When By and To are called with anonymous traversals", func() {
+			result := g.AddE("knows").To(NewAnonymousTraversal().V(2)).Project("a").By(NewAnonymousTraversal().Identity())
+			Convey("Then the anonymous traversals should be nested", func() {
+				So(result.String(), ShouldEqual, `g.V().addE("knows").to(__.V(2)).project("a").by(__.identity())`)
+			})
+		})
+	})
+}
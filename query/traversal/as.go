@@ -20,8 +20,6 @@
 
 package traversal
 
-import "fmt"
-
 // http://tinkerpop.apache.org/docs/current/reference/#as-step
 
 // As (step modulator) similar to By() & Option(). With As(), it is
@@ -31,23 +29,11 @@ import "fmt"
 // As(string)
 // As(string, string...)
 func (g String) As(labels ...string) String {
-	g = g.append(".as(")
-
 	if len(labels) < 1 {
-		fmt.Println("Not enough parameters to use As()")
-	}
-
-	if len(labels) > 0 {
-		g = g.append("\"" + labels[0] + "\"")
-	}
-
-	if len(labels) > 1 {
-		for _, v := range labels[1:] {
-			g = g.append(",\"" + v + "\"")
-		}
+		g.argumentCount("as", "labels")
 	}
 
-	g = g.append(")")
+	g.AddStep("as", stringParams(labels)...)
 
 	return g
 }
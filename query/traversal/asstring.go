@@ -0,0 +1,50 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package traversal
+
+import "github.com/northwesternmutual/grammes/query/scope"
+
+// https://tinkerpop.apache.org/docs/current/reference/#asString-step
+
+// AsString (map) turns the traverser into a string. With
+// the local scope the items of a list are turned into
+// strings instead. Added in TinkerPop 3.7.1.
+// Signatures:
+// AsString()
+// AsString(Scope)
+func (g String) AsString(scopes ...scope.Scope) String {
+	return g.scopedStep("asString", scopes)
+}
+
+// scopedStep adds a step that takes an optional scope.
+func (g String) scopedStep(step string, scopes []scope.Scope) String {
+	if len(scopes) > 1 {
+		g.argumentCount(step, "scope")
+	}
+
+	if len(scopes) > 0 {
+		g.AddStep(step, scopes[0])
+	} else {
+		g.AddStep(step)
+	}
+
+	return g
+}
@@ -20,8 +20,6 @@
 
 package traversal
 
-import "fmt"
-
 // http://tinkerpop.apache.org/docs/current/reference/#barrier-step
 
 // Barrier (barrier) turns the lazy traversal pipeline into
@@ -32,13 +30,19 @@ import "fmt"
 // Barrier(int)
 func (g String) Barrier(param ...interface{}) String {
 	if len(param) < 1 {
-		g = g.append(".barrier()")
+		g.AddStep("barrier")
 		return g
 	} else if len(param) > 1 {
-		fmt.Println("Too many parameters to call Barrier()")
+		g.argumentCount("barrier", "param")
+	}
+
+	// Consumers are given by name so they aren't quoted.
+	if s, ok := param[0].(string); ok {
+		g.AddStep("barrier", Custom(s))
+		return g
 	}
 
-	g = g.append(fmtStr(".barrier(%v)", param[0]))
+	g.AddStep("barrier", param[0])
 
 	return g
 }
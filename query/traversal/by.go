@@ -32,7 +32,7 @@ package traversal
 // By(...interface{})
 func (g String) By(params ...interface{}) String {
 	if len(params) < 1 {
-		g = g.append(".by()")
+		g.AddStep("by")
 		return g
 	}
 	g.AddStep("by", params...)
@@ -0,0 +1,204 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package traversal
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/query/cardinality"
+	"github.com/northwesternmutual/grammes/query/column"
+	"github.com/northwesternmutual/grammes/query/consumer"
+	"github.com/northwesternmutual/grammes/query/direction"
+	"github.com/northwesternmutual/grammes/query/dt"
+	"github.com/northwesternmutual/grammes/query/operator"
+	"github.com/northwesternmutual/grammes/query/order"
+	"github.com/northwesternmutual/grammes/query/pop"
+	"github.com/northwesternmutual/grammes/query/scope"
+	"github.com/northwesternmutual/grammes/query/token"
+)
+
+// Bytecode is the language independent form of a traversal
+// that is sent to the server instead of a Groovy script.
+// It marshals to GraphSON 3.
+type Bytecode struct {
+	// Source are the instructions that configure
+	// the traversal source, such as withStrategies.
+	Source [][]interface{}
+	Steps  [][]interface{}
+}
+
+// sourceSteps are the steps of the TraversalSource, which
+// bytecode keeps apart from the steps of the traversal.
+var sourceSteps = map[string]bool{
+	"withStrategies": true,
+	"withSideEffect": true,
+	"withBulk":       true,
+	"withComputer":   true,
+	"withSack":       true,
+}
+
+// Enum is an enum argument of a step, such as T.id or OUT.
+type Enum struct {
+	Type  string
+	Value string
+}
+
+// typed is a value with its GraphSON type.
+type typed struct {
+	Type  string      `json:"@type"`
+	Value interface{} `json:"@value"`
+}
+
+// MarshalJSON writes the bytecode as a g:Bytecode value.
+func (b Bytecode) MarshalJSON() ([]byte, error) {
+	value := map[string]interface{}{"step": instructions(b.Steps)}
+	if len(b.Source) > 0 {
+		value["source"] = instructions(b.Source)
+	}
+
+	return json.Marshal(typed{
+		Type:  "g:Bytecode",
+		Value: value,
+	})
+}
+
+// instructions gives the arguments of the
+// instructions their GraphSON types.
+func instructions(insts [][]interface{}) [][]interface{} {
+	res := make([][]interface{}, len(insts))
+	for i, s := range insts {
+		res[i] = make([]interface{}, len(s))
+		for j, arg := range s {
+			res[i][j] = graphson(arg)
+		}
+	}
+	return res
+}
+
+// graphson gives numbers and enums their GraphSON type.
+func graphson(arg interface{}) interface{} {
+	switch t := arg.(type) {
+	case Enum:
+		return typed{Type: "g:" + t.Type, Value: t.Value}
+	case int8, int16, int32, uint16:
+		return typed{Type: "g:Int32", Value: t}
+	case int, int64, uint, uint32, uint64:
+		return typed{Type: "g:Int64", Value: t}
+	case float32:
+		return typed{Type: "g:Float", Value: t}
+	case float64:
+		return typed{Type: "g:Double", Value: t}
+	default:
+		return t
+	}
+}
+
+// Bytecode returns the traversal as bytecode. Values that can
+// only be written as Groovy, such as Custom parameters and
+// predicates, return an error wrapping ErrNoBytecode.
+func (g String) Bytecode() (Bytecode, error) {
+	var b Bytecode
+
+	switch g.source {
+	case "", "g", "__":
+	default:
+		return b, fmt.Errorf("source %s: %w", g.source, gremerror.ErrNoBytecode)
+	}
+
+	for _, s := range g.steps {
+		inst := []interface{}{s.Name}
+		for _, arg := range s.Args {
+			v, err := bytecodeArg(arg)
+			if err != nil {
+				return Bytecode{}, fmt.Errorf("step %s: %w", s.Name, err)
+			}
+			inst = append(inst, v)
+		}
+		// source steps only come before the other steps.
+		if sourceSteps[s.Name] && len(b.Steps) == 0 {
+			b.Source = append(b.Source, inst)
+			continue
+		}
+		b.Steps = append(b.Steps, inst)
+	}
+
+	return b, nil
+}
+
+func bytecodeArg(arg interface{}) (interface{}, error) {
+	switch t := arg.(type) {
+	case String:
+		return t.Bytecode()
+	case Anonymous:
+		return t.steps.Bytecode()
+	case Strategy:
+		return strategyBytecode(t)
+	case cardinality.Cardinality:
+		return Enum{"Cardinality", t.String()}, nil
+	case column.Column:
+		return Enum{"Column", t.String()}, nil
+	case consumer.BarrierConsumer:
+		return Enum{"Barrier", t.String()}, nil
+	case direction.Direction:
+		return Enum{"Direction", t.String()}, nil
+	case dt.DT:
+		return Enum{"DT", strings.TrimPrefix(t.String(), "DT.")}, nil
+	case operator.Operator:
+		return Enum{"Operator", t.String()}, nil
+	case order.Order:
+		return Enum{"Order", t.String()}, nil
+	case pop.Pop:
+		return Enum{"Pop", t.String()}, nil
+	case scope.Scope:
+		return Enum{"Scope", t.String()}, nil
+	case token.Token:
+		return Enum{"T", strings.TrimPrefix(t.String(), "T.")}, nil
+	case nil, bool, string,
+		int, int8, int16, int32, int64,
+		uint, uint16, uint32, uint64,
+		float32, float64:
+		return t, nil
+	default:
+		return nil, fmt.Errorf("%T: %w", arg, gremerror.ErrNoBytecode)
+	}
+}
+
+// strategyBytecode returns the strategy as a GraphSON
+// value typed with its name, holding its configuration.
+func strategyBytecode(s Strategy) (interface{}, error) {
+	c := s.Configuration()
+	for k, v := range c {
+		switch t := v.(type) {
+		case []string:
+			c[k] = typed{Type: "g:List", Value: t}
+		default:
+			arg, err := bytecodeArg(t)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", s.Name(), err)
+			}
+			c[k] = arg
+		}
+	}
+	return typed{Type: "g:" + s.Name(), Value: c}, nil
+}
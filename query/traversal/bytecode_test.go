@@ -0,0 +1,112 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package traversal
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/query/direction"
+	"github.com/northwesternmutual/grammes/query/dt"
+	"github.com/northwesternmutual/grammes/query/predicate"
+	"github.com/northwesternmutual/grammes/query/token"
+)
+
+func TestBytecode(t *testing.T) {
+	Convey("Given a traversal with steps", t, func() {
+		g := NewTraversal().V().Has("age", int32(29)).ToV(direction.Out).Values("name")
+
+		Convey("When Bytecode is called", func() {
+			b, err := g.Bytecode()
+			Convey("Then every step should be an instruction", func() {
+				So(err, ShouldBeNil)
+				So(b.Steps, ShouldResemble, [][]interface{}{
+					{"V"},
+					{"has", "age", int32(29)},
+					{"toV", Enum{"Direction", "OUT"}},
+					{"values", "name"},
+				})
+			})
+
+			Convey("Then it should marshal to GraphSON", func() {
+				j, err := json.Marshal(b)
+				So(err, ShouldBeNil)
+				So(string(j), ShouldEqual, `{"@type":"g:Bytecode","@value":{"step":[["V"],`+
+					`["has","age",{"@type":"g:Int32","@value":29}],`+
+					`["toV",{"@type":"g:Direction","@value":"OUT"}],["values","name"]]}}`)
+			})
+		})
+
+		Convey("When a step has a nested traversal", func() {
+			b, err := NewTraversal().V().Where(NewAnonymousTraversal().Has(token.Label, "person")).Bytecode()
+			Convey("Then it should be nested bytecode", func() {
+				So(err, ShouldBeNil)
+				So(b.Steps[1][1], ShouldResemble, Bytecode{Steps: [][]interface{}{
+					{"has", Enum{"T", "label"}, "person"},
+				}})
+			})
+		})
+
+		Convey("When the traversal is spawned from a configured source", func() {
+			b, err := NewTraversalSource().
+				WithStrategies(PartitionStrategy{PartitionKey: "p", ReadPartitions: []string{"a"}}).
+				WithBulk(false).V().Bytecode()
+			Convey("Then the configuration should be source instructions", func() {
+				So(err, ShouldBeNil)
+				So(b.Steps, ShouldResemble, [][]interface{}{{"V"}})
+				So(b.Source, ShouldHaveLength, 2)
+				So(b.Source[1], ShouldResemble, []interface{}{"withBulk", false})
+
+				j, err := json.Marshal(b)
+				So(err, ShouldBeNil)
+				So(string(j), ShouldEqual, `{"@type":"g:Bytecode","@value":{"source":[["withStrategies",`+
+					`{"@type":"g:PartitionStrategy","@value":{"partitionKey":"p","readPartitions":{"@type":"g:List","@value":["a"]}}}],`+
+					`["withBulk",false]],"step":[["V"]]}}`)
+			})
+		})
+
+		Convey("When a step has a DT unit", func() {
+			b, err := NewTraversal().V().DateAdd(dt.Day, 2).Bytecode()
+			Convey("Then it should be a DT enum", func() {
+				So(err, ShouldBeNil)
+				So(b.Steps[1], ShouldResemble, []interface{}{"dateAdd", Enum{"DT", "day"}, 2})
+			})
+		})
+
+		Convey("When a step has a Groovy only value", func() {
+			_, err := NewTraversal().V().Has("age", predicate.GreaterThan(29)).Bytecode()
+			Convey("Then it should return ErrNoBytecode", func() {
+				So(errors.Is(err, gremerror.ErrNoBytecode), ShouldBeTrue)
+			})
+		})
+
+		Convey("When the traversal has a custom source", func() {
+			_, err := NewCustomTraversal("graph.traversal()").V().Bytecode()
+			Convey("Then it should return ErrNoBytecode", func() {
+				So(errors.Is(err, gremerror.ErrNoBytecode), ShouldBeTrue)
+			})
+		})
+	})
+}
@@ -20,7 +20,7 @@
 
 package traversal
 
-import "fmt"
+import "github.com/northwesternmutual/grammes/query/predicate"
 
 // http://tinkerpop.apache.org/docs/current/reference/#choose-step
 
@@ -29,33 +29,36 @@ import "fmt"
 // Note:
 // Signatures:
 // Choose(string (Function))
-// Choose(string (Predicate), *String (Traversal))
-// Choose(string (Predicate), *String (Traversal), *String (Traversal))
-// Choose(*String (Traversal), *String (Traversal))
-// Choose(*String (Traversal), *String (Traversal), *String (Traversal))
-// Choose(*String (Traversal))
-func (g String) Choose(first interface{}, optTraversals ...String) String {
-	g = g.append(".choose(")
-
-	switch first.(type) {
+// Choose(string (Predicate), Anonymous (Traversal))
+// Choose(string (Predicate), Anonymous (Traversal), Anonymous (Traversal))
+// Choose(*predicate.Predicate, Anonymous (Traversal), Anonymous (Traversal))
+// Choose(Anonymous (Traversal), Anonymous (Traversal))
+// Choose(Anonymous (Traversal), Anonymous (Traversal), Anonymous (Traversal))
+// Choose(Anonymous (Traversal))
+func (g String) Choose(first interface{}, optTraversals ...Anonymous) String {
+	var p []interface{}
+
+	switch t := first.(type) {
 	case string:
 		// Choose(string (Function)...
 		// Choose(string (Predicate)...
-		g = g.append(first.(string))
-	case String:
+		p = append(p, Custom(t))
+	case *predicate.Predicate:
+		// Choose(*predicate.Predicate...
+		p = append(p, t)
+	case Anonymous, String:
+		// Choose(Anonymous (Traversal)...
 		// Choose(*String (Traversal)...
-		g = g.append(first.(String).String())
+		p = append(p, t)
 	default:
-		fmt.Println("mismatching type used in Choose()")
+		g.argumentType("choose", "first", first)
 	}
 
-	if len(optTraversals) > 0 {
-		for _, v := range optTraversals {
-			g = g.append("," + v.String())
-		}
+	for _, v := range optTraversals {
+		p = append(p, v)
 	}
 
-	g = g.append(")")
+	g.AddStep("choose", p...)
 
 	return g
 }
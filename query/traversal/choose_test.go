@@ -24,6 +24,8 @@ import (
 	"testing"
 
 	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/query/predicate"
 )
 
 func TestChoose(t *testing.T) {
@@ -37,18 +39,27 @@ func TestChoose(t *testing.T) {
 		})
 
 		Convey("When 'Choose' is called with a traversal", func() {
-			result := g.Choose(NewTraversal().Label().Raw())
+			result := g.Choose(NewAnonymousTraversal().Label())
 			Convey("Then result should equal 'g.choose(label())'", func() {
-				So(result.String(), ShouldEqual, "g.choose(label())")
+				So(result.String(), ShouldEqual, "g.choose(__.label())")
 			})
 		})
 
 		Convey("When 'Choose' is called with multiple traversals", func() {
-			result := g.Choose(NewTraversal().Label().Raw(),
-				NewTraversal().ID().Raw(),
-				NewTraversal().Identity().Raw())
+			result := g.Choose(NewAnonymousTraversal().Label(),
+				NewAnonymousTraversal().ID(),
+				NewAnonymousTraversal().Identity())
 			Convey("Then result should equal 'g.choose(label())'", func() {
-				So(result.String(), ShouldEqual, "g.choose(label(),id(),identity())")
+				So(result.String(), ShouldEqual, "g.choose(__.label(),__.id(),__.identity())")
+			})
+		})
+
+		Convey("When 'Choose' is called with a predicate", func() {
+			result := g.Choose(predicate.GreaterThan(30),
+				NewAnonymousTraversal().Values("name"),
+				NewAnonymousTraversal().Label())
+			Convey("Then result should equal 'g.choose(gt(30),__.values(\"name\"),__.label())'", func() {
+				So(result.String(), ShouldEqual, `g.choose(gt(30),__.values("name"),__.label())`)
 			})
 		})
 
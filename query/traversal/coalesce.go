@@ -25,21 +25,15 @@ package traversal
 // Coalesce evaluates the provided traversals in order and
 // returns the first traversal that emits at least one element.
 // Signatures:
-// Coalesce(...*String (Traversal))
-func (g String) Coalesce(traversals ...String) String {
-	g = g.append(".coalesce(")
+// Coalesce(...Anonymous (Traversal))
+func (g String) Coalesce(traversals ...Anonymous) String {
+	var p []interface{}
 
-	if len(traversals) > 0 {
-		g = g.append(traversals[0].String())
+	for _, v := range traversals {
+		p = append(p, v)
 	}
 
-	if len(traversals) > 1 {
-		for _, v := range traversals[1:] {
-			g = g.append("," + v.String())
-		}
-	}
-
-	g = g.append(")")
+	g.AddStep("coalesce", p...)
 
 	return g
 }
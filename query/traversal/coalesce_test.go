@@ -30,11 +30,11 @@ func TestCoalesce(t *testing.T) {
 	Convey("Given a ) String { that represents the graph's traversal", t, func() {
 		g := NewTraversal()
 		Convey("When 'Coalesce' is called with multiple traversals", func() {
-			result := g.Coalesce(NewTraversal().Label().Raw(),
-				NewTraversal().ID().Raw(),
-				NewTraversal().Identity().Raw())
+			result := g.Coalesce(NewAnonymousTraversal().Label(),
+				NewAnonymousTraversal().ID(),
+				NewAnonymousTraversal().Identity())
 			Convey("Then result should equal 'g.coalesce(label(),id(),identity())'", func() {
-				So(result.String(), ShouldEqual, "g.coalesce(label(),id(),identity())")
+				So(result.String(), ShouldEqual, "g.coalesce(__.label(),__.id(),__.identity())")
 			})
 		})
 	})
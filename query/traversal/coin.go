@@ -26,7 +26,7 @@ package traversal
 // Signatures:
 // Coin(float32)
 func (g String) Coin(bias float32) String {
-	g = g.append(fmtStr(".coin(%v)", bias))
+	g.AddStep("coin", bias)
 
 	return g
 }
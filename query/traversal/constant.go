@@ -26,7 +26,7 @@ package traversal
 // Signatures:
 // Constant(string (Object))
 func (g String) Constant(obj string) String {
-	g = g.append(".constant(" + obj + ")")
+	g.AddStep("constant", Custom(obj))
 
 	return g
 }
@@ -21,8 +21,6 @@
 package traversal
 
 import (
-	"fmt"
-
 	"github.com/northwesternmutual/grammes/query/scope"
 )
 
@@ -34,13 +32,13 @@ import (
 // Count(Scope)
 func (g String) Count(scope ...scope.Scope) String {
 	if len(scope) < 1 {
-		g = g.append(".count()")
+		g.AddStep("count")
 		return g
 	} else if len(scope) > 1 {
-		fmt.Println("Too many parameters to call Count()")
+		g.argumentCount("count", "scope")
 	}
 
-	g = g.append(fmtStr(".count(%v)", scope[0]))
+	g.AddStep("count", scope[0])
 
 	return g
 }
@@ -27,7 +27,7 @@ package traversal
 // Signatures:
 // CyclicPath()
 func (g String) CyclicPath() String {
-	g = g.append(".cyclicPath()")
+	g.AddStep("cyclicPath")
 
 	return g
 }
@@ -0,0 +1,45 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package traversal
+
+import "time"
+
+// https://tinkerpop.apache.org/docs/current/reference/#dateDiff-step
+
+// DateDiff (map) gives the number of seconds between the
+// date and the given date, or the date given by the
+// traversal. Added in TinkerPop 3.7.1.
+// Signatures:
+// DateDiff(time.Time)
+// DateDiff(Anonymous (Traversal))
+func (g String) DateDiff(dateOrTraversal interface{}) String {
+	switch t := dateOrTraversal.(type) {
+	case time.Time:
+		g.AddStep("dateDiff", t)
+	case Anonymous:
+		g.AddStep("dateDiff", t)
+	default:
+		g.argumentType("dateDiff", "dateOrTraversal", dateOrTraversal)
+		g.AddStep("dateDiff")
+	}
+
+	return g
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package traversal
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDateDiff(t *testing.T) {
+	Convey("Given a string that represents the graph's traversal", t, func() {
+		g := NewTraversal()
+
+		Convey("When 'DateDiff' is called with a time", func() {
+			result := g.V().DateDiff(time.Unix(1, 0))
+			Convey("Then result should equal 'g.V().dateDiff(new Date(1000L))'", func() {
+				So(result.String(), ShouldEqual, "g.V().dateDiff(new Date(1000L))")
+			})
+		})
+
+		Convey("When 'DateDiff' is called with a traversal", func() {
+			result := g.V().DateDiff(NewAnonymousTraversal().Constant("x"))
+			Convey("Then result should equal 'g.V().dateDiff(__.constant(x))'", func() {
+				So(result.String(), ShouldEqual, "g.V().dateDiff(__.constant(x))")
+			})
+		})
+
+		Convey("When 'DateDiff' is called with a string", func() {
+			result := g.V().DateDiff("x")
+			Convey("Then result should equal 'g.V().dateDiff()'", func() {
+				So(result.String(), ShouldEqual, "g.V().dateDiff()")
+				So(errors.Is(result.Err(), gremerror.ErrArgumentType), ShouldBeTrue)
+			})
+		})
+	})
+}
@@ -20,17 +20,31 @@
 
 package traversal
 
+import (
+	"github.com/northwesternmutual/grammes/query/predicate"
+)
+
 // http://tinkerpop.apache.org/docs/current/reference/#emit-step
 
 // Emit (step modulator) for Repeat()
 // Signatures:
 // Emit()
-// Emit(string (Predicate))
-// Emit(*String (Traversal))
+// Emit(*predicate.Predicate)
+// Emit(Anonymous (Traversal))
 func (g String) Emit(predOrTrav ...interface{}) String {
-	if len(predOrTrav) > 0 {
-		g.AddStep("emit", predOrTrav[0].(String).Raw())
-	} else {
+	if len(predOrTrav) > 1 {
+		g.argumentCount("emit", "predOrTrav")
+	}
+	if len(predOrTrav) == 0 {
+		g.AddStep("emit")
+		return g
+	}
+
+	switch t := predOrTrav[0].(type) {
+	case *predicate.Predicate, Anonymous:
+		g.AddStep("emit", t)
+	default:
+		g.argumentType("emit", "predOrTrav", t)
 		g.AddStep("emit")
 	}
 
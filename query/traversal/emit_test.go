@@ -21,8 +21,12 @@
 package traversal
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/query/predicate"
+
 	. "github.com/smartystreets/goconvey/convey"
 )
 
@@ -30,10 +34,33 @@ func TestEmit(t *testing.T) {
 	Convey("Given a ) String { that represents the graph's traversal", t, func() {
 		g := NewTraversal()
 
-		Convey("When 'Emit' is called with a traversal", func() {
-			result := g.Emit(NewTraversal().Label().Raw())
-			Convey("Then result should equal 'g.emit(label())'", func() {
-				So(result.String(), ShouldEqual, "g.emit(label())")
+		Convey("When 'Emit' is called with an anonymous traversal", func() {
+			result := g.Emit(NewAnonymousTraversal().Label())
+			Convey("Then result should equal 'g.emit(__.label())'", func() {
+				So(result.String(), ShouldEqual, "g.emit(__.label())")
+				So(result.Err(), ShouldBeNil)
+			})
+		})
+
+		Convey("When 'Emit' is called with a predicate", func() {
+			result := g.Emit(predicate.GreaterThan(2))
+			Convey("Then result should equal 'g.emit(gt(2))'", func() {
+				So(result.String(), ShouldEqual, "g.emit(gt(2))")
+			})
+		})
+
+		Convey("When 'Emit' is called with a string", func() {
+			result := g.Emit("x")
+			Convey("Then the result should have a build error", func() {
+				So(result.String(), ShouldEqual, "g.emit()")
+				So(errors.Is(result.Err(), gremerror.ErrArgumentType), ShouldBeTrue)
+			})
+		})
+
+		Convey("When 'Emit' is called with two arguments", func() {
+			result := g.Emit(predicate.GreaterThan(2), NewAnonymousTraversal().Label())
+			Convey("Then the result should have a build error", func() {
+				So(errors.Is(result.Err(), gremerror.ErrArgumentCount), ShouldBeTrue)
 			})
 		})
 
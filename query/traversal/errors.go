@@ -0,0 +1,62 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package traversal
+
+import (
+	"fmt"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+)
+
+// Err returns the first error made while building the
+// traversal, such as a step given too many arguments.
+// It's a *gremerror.BuildError that names the step and
+// argument. A traversal with an error isn't executed.
+func (g String) Err() error {
+	if len(g.errs) == 0 {
+		return nil
+	}
+	return g.errs[0]
+}
+
+// Errs returns every error made while building the traversal.
+func (g String) Errs() []error {
+	return append([]error(nil), g.errs...)
+}
+
+// Err returns the first error made while
+// building the anonymous traversal.
+func (a Anonymous) Err() error {
+	return a.steps.Err()
+}
+
+// argumentCount records that the step was given
+// too many or too few values for the argument.
+func (g *String) argumentCount(step, argument string) {
+	g.addError(gremerror.NewBuildError(step, argument, gremerror.ErrArgumentCount))
+}
+
+// argumentType records that the step was given a
+// value of a type it doesn't take for the argument.
+func (g *String) argumentType(step, argument string, value interface{}) {
+	g.addError(gremerror.NewBuildError(step, argument,
+		fmt.Errorf("%T: %w", value, gremerror.ErrArgumentType)))
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package traversal
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+)
+
+func TestErr(t *testing.T) {
+	Convey("Given a graph traversal", t, func() {
+		g := NewTraversal()
+
+		Convey("When it is built correctly", func() {
+			result := g.V().Has("name", "marko").Limit(1)
+			Convey("Then Err should be nil", func() {
+				So(result.Err(), ShouldBeNil)
+				So(result.Errs(), ShouldBeEmpty)
+			})
+		})
+
+		Convey("When a step is given too many arguments", func() {
+			result := g.V().Has("a", "b", "c", "d")
+			Convey("Then Err should name the step and argument", func() {
+				var err *gremerror.BuildError
+				So(errors.As(result.Err(), &err), ShouldBeTrue)
+				So(err.Step(), ShouldEqual, "has")
+				So(err.Argument(), ShouldEqual, "params")
+				So(errors.Is(err, gremerror.ErrArgumentCount), ShouldBeTrue)
+			})
+		})
+
+		Convey("When a step is given the wrong type", func() {
+			result := g.V().Where(1234)
+			Convey("Then Err should be an ErrArgumentType", func() {
+				var err *gremerror.BuildError
+				So(errors.As(result.Err(), &err), ShouldBeTrue)
+				So(err.Step(), ShouldEqual, "where")
+				So(err.Argument(), ShouldEqual, "first")
+				So(errors.Is(err, gremerror.ErrArgumentType), ShouldBeTrue)
+			})
+		})
+
+		Convey("When several steps have errors", func() {
+			result := g.V().Range(1).Sample().Limit()
+			Convey("Then Errs should return all of them in order", func() {
+				errs := result.Errs()
+				So(len(errs), ShouldEqual, 3)
+				So(errs[0].(*gremerror.BuildError).Step(), ShouldEqual, "range")
+				So(errs[2].(*gremerror.BuildError).Step(), ShouldEqual, "limit")
+			})
+		})
+
+		Convey("When a nested traversal has an error", func() {
+			result := g.V().Where(NewAnonymousTraversal().As())
+			Convey("Then the outer traversal should have it", func() {
+				So(result.Err(), ShouldNotBeNil)
+				So(result.Err().(*gremerror.BuildError).Step(), ShouldEqual, "as")
+			})
+		})
+
+		Convey("When two traversals are built from one with an error", func() {
+			base := g.V()
+			bad := base.Limit()
+			good := base.Limit(1)
+			Convey("Then only the one with the error should have it", func() {
+				So(bad.Err(), ShouldNotBeNil)
+				So(good.Err(), ShouldBeNil)
+			})
+		})
+	})
+}
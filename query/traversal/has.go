@@ -20,8 +20,6 @@
 
 package traversal
 
-import "fmt"
-
 // http://tinkerpop.apache.org/docs/current/reference/#has-step
 
 // Has (filter) filters vertices, edges, and vertex properties
@@ -46,7 +44,7 @@ func (g String) Has(first interface{}, params ...interface{}) String {
 	}
 
 	if len(newParams) > 3 {
-		fmt.Println("ERROR: Too many parameters to call Has()")
+		g.argumentCount("has", "params")
 	}
 
 	g.AddStep("has", newParams...)
@@ -79,20 +77,7 @@ func (g String) HasID(objOrP interface{}, objs ...string) String {
 // HasKey(string (Predicate))
 // HasKey(string, ...string)
 func (g String) HasKey(pOrStr interface{}, handledStrings ...string) String {
-	switch pOrStr.(type) {
-	case string:
-		g = g.append(".hasKey(\"" + pOrStr.(string) + "\"")
-	default:
-		g = g.append(fmtStr(".hasKey(%v", pOrStr))
-	}
-
-	if len(handledStrings) > 0 {
-		for _, v := range handledStrings {
-			g = g.append(",\"" + v + "\"")
-		}
-	}
-
-	g = g.append(")")
+	g.AddStep("hasKey", append([]interface{}{pOrStr}, stringParams(handledStrings)...)...)
 
 	return g
 }
@@ -103,20 +88,7 @@ func (g String) HasKey(pOrStr interface{}, handledStrings ...string) String {
 // HasLabel(string (Predicate))
 // HasLabel(string, ...string)
 func (g String) HasLabel(pOrStr interface{}, handledStrings ...string) String {
-	switch pOrStr.(type) {
-	case string:
-		g = g.append(".hasLabel(\"" + pOrStr.(string) + "\"")
-	default:
-		g = g.append(fmtStr(".hasLabel(%v", pOrStr))
-	}
-
-	if len(handledStrings) > 0 {
-		for _, v := range handledStrings {
-			g = g.append(",\"" + v + "\"")
-		}
-	}
-
-	g = g.append(")")
+	g.AddStep("hasLabel", append([]interface{}{pOrStr}, stringParams(handledStrings)...)...)
 
 	return g
 }
@@ -137,20 +109,7 @@ func (g String) HasNot(str string) String {
 // HasValue(string (Object), ...string (Object))
 // HasValue(string (P))
 func (g String) HasValue(objOrP interface{}, objs ...string) String {
-	switch objOrP.(type) {
-	case string:
-		g = g.append(".hasValue(\"" + objOrP.(string) + "\"")
-	default:
-		g = g.append(fmtStr(".hasValue(%v)", objOrP))
-	}
-
-	if len(objs) > 0 {
-		for _, v := range objs {
-			g = g.append(",\"" + v + "\"")
-		}
-	}
-
-	g = g.append(")")
+	g.AddStep("hasValue", append([]interface{}{objOrP}, stringParams(objs)...)...)
 
 	return g
 }
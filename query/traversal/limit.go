@@ -20,10 +20,6 @@
 
 package traversal
 
-import (
-	"fmt"
-)
-
 // http://tinkerpop.apache.org/docs/current/reference/#limit-step
 
 // Limit (analogous to range) except the minimum will always be 0
@@ -32,10 +28,8 @@ import (
 // Limit(int)
 // Limit(Scope, int)
 func (g String) Limit(params ...interface{}) String {
-	if len(params) < 1 {
-		fmt.Println("no parameters given to Limit(params ...interface{})")
-	} else if len(params) > 2 {
-		fmt.Println("too many parameters given to Limit(params ...interface{})")
+	if len(params) < 1 || len(params) > 2 {
+		g.argumentCount("limit", "params")
 	}
 
 	g.AddStep("limit", params...)
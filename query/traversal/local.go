@@ -24,8 +24,8 @@ package traversal
 
 // Local (branch) allows for object-local traversal computations.
 // Signatures:
-// Local(*String (Traversal))
-func (g String) Local(traversal String) String {
+// Local(Anonymous (Traversal))
+func (g String) Local(traversal Anonymous) String {
 	g.AddStep("local", traversal)
 
 	return g
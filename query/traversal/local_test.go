@@ -31,9 +31,9 @@ func TestLocal(t *testing.T) {
 		g := NewTraversal()
 
 		Convey("When 'Local' is called with a traversal", func() {
-			result := g.Local(NewTraversal().Label())
+			result := g.Local(NewAnonymousTraversal().Label())
 			Convey("Then result should equal 'g.local(label())'", func() {
-				So(result.String(), ShouldEqual, "g.local(label())")
+				So(result.String(), ShouldEqual, "g.local(__.label())")
 			})
 		})
 	})
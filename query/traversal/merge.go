@@ -0,0 +1,63 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package traversal
+
+// https://tinkerpop.apache.org/docs/current/reference/#mergevertex-step
+
+// MergeV (map/sideEffect) gets the vertex that matches the map or
+// creates it when there is none. The map can be given as a
+// map[string]interface{}, a map[interface{}]interface{} with Token
+// keys or an anonymous traversal. Added in TinkerPop 3.6.0.
+// Signatures:
+// MergeV()
+// MergeV(map[string]interface{})
+// MergeV(map[interface{}]interface{})
+// MergeV(Anonymous (Traversal))
+func (g String) MergeV(searchCreate ...interface{}) String {
+	if len(searchCreate) > 1 {
+		g.argumentCount("mergeV", "searchCreate")
+	}
+
+	g.AddStep("mergeV", searchCreate...)
+
+	return g
+}
+
+// https://tinkerpop.apache.org/docs/current/reference/#mergeedge-step
+
+// MergeE (map/sideEffect) gets the edge that matches the map or
+// creates it when there is none. The map takes the label and the
+// Direction.OUT and Direction.IN vertices along with the properties.
+// Added in TinkerPop 3.6.0.
+// Signatures:
+// MergeE()
+// MergeE(map[string]interface{})
+// MergeE(map[interface{}]interface{})
+// MergeE(Anonymous (Traversal))
+func (g String) MergeE(searchCreate ...interface{}) String {
+	if len(searchCreate) > 1 {
+		g.argumentCount("mergeE", "searchCreate")
+	}
+
+	g.AddStep("mergeE", searchCreate...)
+
+	return g
+}
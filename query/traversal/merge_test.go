@@ -0,0 +1,87 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package traversal
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/query/direction"
+	"github.com/northwesternmutual/grammes/query/token"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMergeV(t *testing.T) {
+	Convey("Given a string that represents the graph's traversal", t, func() {
+		g := NewTraversal()
+
+		Convey("When 'MergeV' is called with no arguments", func() {
+			result := g.MergeV()
+			Convey("Then result should equal 'g.mergeV()'", func() {
+				So(result.String(), ShouldEqual, "g.mergeV()")
+			})
+		})
+
+		Convey("When 'MergeV' is called with a map", func() {
+			result := g.MergeV(map[string]interface{}{"name": "marko", "age": 29})
+			Convey("Then result should equal 'g.mergeV(['age':29,'name':'marko'])'", func() {
+				So(result.String(), ShouldEqual, `g.mergeV(["age":29,"name":"marko"])`)
+			})
+		})
+
+		Convey("When 'MergeV' is called with a map of tokens", func() {
+			result := g.MergeV(map[interface{}]interface{}{token.Label: "person"})
+			Convey("Then result should equal 'g.mergeV([(T.label):'person'])'", func() {
+				So(result.String(), ShouldEqual, `g.mergeV([(T.label):"person"])`)
+			})
+		})
+
+		Convey("When 'MergeV' is called with a traversal", func() {
+			result := g.MergeV(NewAnonymousTraversal().Select("m"))
+			Convey("Then result should equal 'g.mergeV(__.select('m'))'", func() {
+				So(result.String(), ShouldEqual, `g.mergeV(__.select("m"))`)
+			})
+		})
+
+		Convey("When 'MergeV' is called with too many arguments", func() {
+			result := g.MergeV(1, 2)
+			Convey("Then result should equal 'g.mergeV(1,2)'", func() {
+				So(result.String(), ShouldEqual, "g.mergeV(1,2)")
+				So(errors.Is(result.Err(), gremerror.ErrArgumentCount), ShouldBeTrue)
+			})
+		})
+	})
+}
+
+func TestMergeE(t *testing.T) {
+	Convey("Given a string that represents the graph's traversal", t, func() {
+		g := NewTraversal()
+
+		Convey("When 'MergeE' is called with a map of directions", func() {
+			result := g.MergeE(map[interface{}]interface{}{direction.Out: 1, direction.In: 2, token.Label: "knows"})
+			Convey("Then result should equal 'g.mergeE([(IN):2,(OUT):1,(T.label):'knows'])'", func() {
+				So(result.String(), ShouldEqual, `g.mergeE([(IN):2,(OUT):1,(T.label):"knows"])`)
+			})
+		})
+	})
+}
@@ -20,14 +20,27 @@
 
 package traversal
 
-import "bytes"
-
 // String is used to construct commands
-// for the Grammes API when querying
-// type String string
+// for the Grammes API when querying.
+// It holds the source of the traversal,
+// such as g, and the steps after it.
 type String struct {
-	string
-	buffer *bytes.Buffer
+	source string
+	steps  []Step
+	// raw traversals are nested in other steps, so
+	// their steps don't start with a dot.
+	raw bool
+	// errs are the errors made while building the
+	// traversal, including those of nested traversals.
+	errs []error
+}
+
+// Step is a single step of a traversal, such as out("knows"),
+// with its name and the values given to it. Nested traversals
+// are kept as a String or an Anonymous value.
+type Step struct {
+	Name string
+	Args []interface{}
 }
 
 // Parameter is used for handling all Gremlin types.
@@ -0,0 +1,63 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package traversal
+
+// stepNames are the steps that traversals are built with,
+// including the steps of the traversal source.
+var stepNames = map[string]bool{
+	"addE": true, "addV": true, "aggregate": true, "and": true,
+	"as": true, "asString": true, "barrier": true, "both": true,
+	"bothE": true, "bothV": true, "by": true, "call": true, "cap": true,
+	"choose": true, "coalesce": true, "coin": true, "concat": true,
+	"connectedComponent": true, "constant": true, "count": true,
+	"cyclicPath": true, "dateAdd": true, "dateDiff": true, "dedup": true,
+	"drop": true, "E": true, "element": true, "elementMap": true,
+	"emit": true, "explain": true, "fail": true, "filter": true,
+	"flatMap": true, "fold": true, "from": true, "group": true,
+	"groupCount": true, "has": true, "hasId": true, "hasKey": true,
+	"hasLabel": true, "hasNot": true, "hasValue": true, "id": true,
+	"identity": true, "in": true, "index": true, "inE": true,
+	"inject": true, "inV": true, "is": true, "key": true, "label": true,
+	"limit": true, "local": true, "loops": true, "map": true,
+	"match": true, "math": true, "max": true, "mean": true,
+	"mergeE": true, "mergeV": true, "min": true, "none": true,
+	"not": true, "option": true, "optional": true, "or": true,
+	"order": true, "otherV": true, "out": true, "outE": true,
+	"outV": true, "pageRank": true, "path": true, "peerPressure": true,
+	"profile": true, "program": true, "project": true, "properties": true,
+	"property": true, "propertyMap": true, "range": true, "repeat": true,
+	"sack": true, "sample": true, "select": true, "shortestPath": true,
+	"sideEffect": true, "simplePath": true, "skip": true, "split": true,
+	"store": true, "subgraph": true, "sum": true, "tail": true,
+	"timeLimit": true, "to": true, "toE": true, "toLower": true,
+	"toUpper": true, "toV": true, "tree": true, "unfold": true,
+	"union": true, "until": true, "V": true, "value": true,
+	"valueMap": true, "values": true, "where": true, "with": true,
+	"withBulk": true, "withComputer": true, "withSack": true,
+	"withSideEffect": true, "withStrategies": true,
+}
+
+// IsStep returns whether the traversals can be built with
+// the step, such as out. It's used to check traversals that
+// are written as Gremlin instead of being built.
+func IsStep(name string) bool {
+	return stepNames[name]
+}
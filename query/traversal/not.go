@@ -25,8 +25,8 @@ package traversal
 // Not (filter) removes objects from the traversal stream when
 // the traversal provided as an argument does not return any objects.
 // Signatures:
-// Not(*String (Traversal))
-func (g String) Not(traversal String) String {
+// Not(Anonymous (Traversal))
+func (g String) Not(traversal Anonymous) String {
 	g.AddStep("not", traversal)
 
 	return g
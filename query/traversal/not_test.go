@@ -31,9 +31,9 @@ func TestNot(t *testing.T) {
 		g := NewTraversal()
 
 		Convey("When 'Not' is called with a traversal", func() {
-			result := g.Not(NewTraversal().Label())
+			result := g.Not(NewAnonymousTraversal().Label())
 			Convey("Then result should equal 'g.not(label())'", func() {
-				So(result.String(), ShouldEqual, "g.not(label())")
+				So(result.String(), ShouldEqual, "g.not(__.label())")
 			})
 		})
 	})
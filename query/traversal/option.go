@@ -20,8 +20,6 @@
 
 package traversal
 
-import "fmt"
-
 // http://tinkerpop.apache.org/docs/current/reference/#option-step
 
 // Option (step modulator) is an 'option' to a Branch() or Choose()
@@ -30,21 +28,19 @@ import "fmt"
 // Option(string, string)
 func (g String) Option(params ...string) String {
 	if len(params) < 1 {
-		fmt.Println("Not enough parameters to call Option()")
+		g.argumentCount("option", "params")
 		return g
 	} else if len(params) > 2 {
-		fmt.Println("Too many paramaters to call Option()")
+		g.argumentCount("option", "params")
 	}
 
-	g = g.append(".option(\"" + params[0] + "\"")
+	p := stringParams(params[:1])
 
 	if len(params) > 1 {
-		for _, v := range params {
-			g = g.append(",\"" + v + "\"")
-		}
+		p = append(p, stringParams(params)...)
 	}
 
-	g = g.append(")")
+	g.AddStep("option", p...)
 
 	return g
 }
@@ -25,8 +25,8 @@ package traversal
 // Optional (branch/filterMap) returns the result of the specified traversal
 // if it yields a result else it returns the calling element i.e. the Indentity().
 // Signatures:
-// Optional(String (Traversal))
-func (g String) Optional(traversal String) String {
+// Optional(Anonymous (Traversal))
+func (g String) Optional(traversal Anonymous) String {
 	g.AddStep("optional", traversal)
 
 	return g
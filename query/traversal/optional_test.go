@@ -31,9 +31,9 @@ func TestOptional(t *testing.T) {
 		g := NewTraversal()
 
 		Convey("When 'Optional' is called with a traversal", func() {
-			result := g.Optional(NewTraversal().Label())
+			result := g.Optional(NewAnonymousTraversal().Label())
 			Convey("Then result should equal 'g.optional(label())'", func() {
-				So(result.String(), ShouldEqual, "g.optional(label())")
+				So(result.String(), ShouldEqual, "g.optional(__.label())")
 			})
 		})
 	})
@@ -25,8 +25,8 @@ package traversal
 // Or (filter) ensures that at least of the provided traversals yield a result.
 // Signatures:
 // Or()
-// Or(...*String (Traversal))
-func (g String) Or(traversals ...String) String {
+// Or(...Anonymous (Traversal))
+func (g String) Or(traversals ...Anonymous) String {
 	var params []interface{}
 
 	for _, t := range traversals {
@@ -37,18 +37,18 @@ func TestOr(t *testing.T) {
 		})
 
 		Convey("When 'Or' is called with a traversal", func() {
-			result := g.Or(NewTraversal().Label())
-			Convey("Then result should equal 'g.or(label())'", func() {
-				So(result.String(), ShouldEqual, "g.or(label())")
+			result := g.Or(NewAnonymousTraversal().Label())
+			Convey("Then result should equal 'g.or(__.label())'", func() {
+				So(result.String(), ShouldEqual, "g.or(__.label())")
 			})
 		})
 
 		Convey("When 'Match' is called with multiple params", func() {
-			obj1 := NewTraversal().Label()
-			obj2 := NewTraversal().Key()
+			obj1 := NewAnonymousTraversal().Label()
+			obj2 := NewAnonymousTraversal().Key()
 			result := g.Or(obj1, obj2)
-			Convey("Then result should equal 'g.or(label(),key())'", func() {
-				So(result.String(), ShouldEqual, "g.or(label(),key())")
+			Convey("Then result should equal 'g.or(__.label(),__.key())'", func() {
+				So(result.String(), ShouldEqual, "g.or(__.label(),__.key())")
 			})
 		})
 	})
@@ -0,0 +1,97 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package traversal
+
+import (
+	"sort"
+
+	"github.com/northwesternmutual/grammes/query"
+	"github.com/northwesternmutual/grammes/query/predicate"
+)
+
+// Placeholders returns the query.Placeholder values given to
+// the steps of the traversal and its nested traversals, checked
+// with query.CheckPlaceholders. Predicates and Custom values are
+// Groovy already, so the placeholders written in them are found
+// with query.Placeholders, which skips their string literals.
+func (g String) Placeholders() ([]query.Placeholder, error) {
+	var found []query.Placeholder
+	for _, s := range g.steps {
+		for _, arg := range s.Args {
+			p, err := placeholders(arg)
+			if err != nil {
+				return nil, err
+			}
+			found = append(found, p...)
+		}
+	}
+
+	return query.CheckPlaceholders(found)
+}
+
+// placeholders returns the placeholders of a step argument.
+func placeholders(arg interface{}) ([]query.Placeholder, error) {
+	switch t := arg.(type) {
+	case query.Placeholder:
+		return []query.Placeholder{t}, nil
+	case String:
+		return t.Placeholders()
+	case Anonymous:
+		return t.steps.Placeholders()
+	case *predicate.Predicate:
+		return query.Placeholders(t.String())
+	case Custom:
+		return query.Placeholders(string(t))
+	case []interface{}:
+		var found []query.Placeholder
+		for _, v := range t {
+			p, err := placeholders(v)
+			if err != nil {
+				return nil, err
+			}
+			found = append(found, p...)
+		}
+		return found, nil
+	case map[string]interface{}:
+		m := make(map[interface{}]interface{}, len(t))
+		for k, v := range t {
+			m[k] = v
+		}
+		return placeholders(m)
+	case map[interface{}]interface{}:
+		// the entries are read in the order they're written.
+		keys := make([]interface{}, 0, len(t))
+		for k := range t {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			return query.MapKey(keys[i]) < query.MapKey(keys[j])
+		})
+
+		values := make([]interface{}, 0, len(t)*2)
+		for _, k := range keys {
+			values = append(values, k, t[k])
+		}
+		return placeholders(values)
+	}
+
+	return nil, nil
+}
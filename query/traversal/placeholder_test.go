@@ -0,0 +1,64 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package traversal
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/query"
+	"github.com/northwesternmutual/grammes/query/datatype"
+	"github.com/northwesternmutual/grammes/query/predicate"
+)
+
+func TestPlaceholders(t *testing.T) {
+	Convey("Given a traversal with placeholders in its steps", t, func() {
+		age := query.Placeholder{Name: "age", Type: datatype.Long}
+		name := query.Placeholder{Name: "name", Type: datatype.String}
+		g := NewTraversal().V().
+			Has("note", "Long.valueOf(x)").
+			Has("age", predicate.GreaterThan(age)).
+			Where(NewAnonymousTraversal().Has("name", name)).
+			MergeV(map[string]interface{}{"age": age})
+		Convey("When Placeholders is called", func() {
+			res, err := g.Placeholders()
+			Convey("Then the placeholders should be returned once and strings skipped", func() {
+				So(err, ShouldBeNil)
+				So(res, ShouldResemble, []query.Placeholder{age, name})
+			})
+		})
+	})
+
+	Convey("Given a traversal with a placeholder of two types", t, func() {
+		g := NewTraversal().V().
+			Has("a", query.Placeholder{Name: "x", Type: datatype.Long}).
+			Has("b", query.Placeholder{Name: "x", Type: datatype.String})
+		Convey("When Placeholders is called", func() {
+			_, err := g.Placeholders()
+			Convey("Then an error should be returned", func() {
+				So(errors.Is(err, gremerror.ErrInvalidParameter), ShouldBeTrue)
+			})
+		})
+	})
+}
@@ -28,15 +28,7 @@ package traversal
 // Signatures:
 // Project(string, ...string)
 func (g String) Project(str string, extraStrings ...string) String {
-	g = g.append(".project(\"" + str + "\"")
-
-	if len(extraStrings) > 0 {
-		for _, v := range extraStrings {
-			g = g.append(",\"" + v + "\"")
-		}
-	}
-
-	g = g.append(")")
+	g.AddStep("project", stringParams(append([]string{str}, extraStrings...))...)
 
 	return g
 }
@@ -27,19 +27,7 @@ package traversal
 // Properties()
 // Properties(...string)
 func (g String) Properties(str ...string) String {
-	if len(str) < 1 {
-		g.AddStep("properties")
-	} else {
-		g = g.append(".properties(\"" + str[0] + "\"")
-
-		if len(str) > 1 {
-			for _, v := range str[1:] {
-				g = g.append(",\"" + v + "\"")
-			}
-		}
-
-		g = g.append(")")
-	}
+	g.AddStep("properties", stringParams(str)...)
 
 	return g
 }
@@ -26,19 +26,7 @@ package traversal
 // Signatures:
 // PropertyMap(...string)
 func (g String) PropertyMap(str ...string) String {
-	if len(str) < 1 {
-		g.AddStep("propertyMap")
-	} else {
-		g = g.append(".propertyMap(\"" + str[0] + "\"")
-
-		if len(str) > 1 {
-			for _, v := range str[1:] {
-				g = g.append(",\"" + v + "\"")
-			}
-		}
-
-		g = g.append(")")
-	}
+	g.AddStep("propertyMap", stringParams(str)...)
 
 	return g
 }
@@ -20,8 +20,6 @@
 
 package traversal
 
-import "fmt"
-
 // http://tinkerpop.apache.org/docs/current/reference/#range-step
 
 // Range (filter) allows for filtering on a low-end and high-end
@@ -32,8 +30,10 @@ import "fmt"
 // Range(Scope, int, int)
 func (g String) Range(params ...interface{}) String {
 	if len(params) < 2 {
-		fmt.Println("Not enough parameters to execute a range")
+		g.argumentCount("range", "params")
 		return g
+	} else if len(params) > 3 {
+		g.argumentCount("range", "params")
 	}
 
 	g.AddStep("range", params...)
@@ -0,0 +1,209 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package traversal
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/northwesternmutual/grammes/query"
+)
+
+// writer writes traversals as Groovy. When it has bindings
+// the string values are added to them and written as the
+// names of the bindings instead of as literals.
+type writer struct {
+	strings.Builder
+	bindings map[string]string
+}
+
+// writeString writes the string as a literal or as a binding.
+func (w *writer) writeString(s string) {
+	if w.bindings == nil {
+		w.WriteString(quote(s))
+		return
+	}
+
+	name := query.BindingPrefix + strconv.Itoa(len(w.bindings))
+	w.bindings[name] = s
+	w.WriteString(name)
+}
+
+// writeGroovy writes the traversal as the Groovy
+// script that is sent to the Gremlin server.
+func (w *writer) writeGroovy(g String) {
+	w.WriteString(g.source)
+	for i, s := range g.steps {
+		if i > 0 || !g.raw {
+			w.WriteByte('.')
+		}
+		w.writeStep(s)
+	}
+}
+
+func (w *writer) writeStep(s Step) {
+	w.WriteString(s.Name + "(")
+	for i, p := range s.Args {
+		// nil parameters are left out of the step.
+		if p != nil {
+			w.writeArg(p)
+		}
+
+		// nil parameters are left out of the separators.
+		if len(s.Args) > i+1 && s.Args[i+1] != nil {
+			w.WriteByte(',')
+		}
+	}
+	w.WriteByte(')')
+}
+
+func (w *writer) writeArg(p interface{}) {
+	switch t := p.(type) {
+	case String:
+		w.writeGroovy(t.Raw())
+	case Anonymous:
+		w.writeGroovy(t.steps)
+	case byte:
+		w.WriteByte(t)
+	case []byte:
+		w.Write(t)
+	case string:
+		w.writeString(t)
+	case []interface{}:
+		w.WriteByte('[')
+		for i, v := range t {
+			if i > 0 {
+				w.WriteByte(',')
+			}
+			w.writeArg(v)
+		}
+		w.WriteByte(']')
+	case map[string]interface{}:
+		m := make(map[interface{}]interface{}, len(t))
+		for k, v := range t {
+			m[k] = v
+		}
+		w.writeMap(m)
+	case map[interface{}]interface{}:
+		w.writeMap(t)
+	default:
+		w.WriteString(query.Literal(t))
+	}
+}
+
+// writeMap writes the map as a Groovy map literal with
+// its keys in order. Keys that aren't strings, such as
+// T.label, are put in parentheses so Groovy reads them
+// as values instead of as the names of the keys. So are
+// the keys that are bindings.
+func (w *writer) writeMap(m map[interface{}]interface{}) {
+	if len(m) == 0 {
+		w.WriteString("[:]")
+		return
+	}
+
+	type entry struct {
+		order string
+		key   interface{}
+		value interface{}
+	}
+	entries := make([]entry, 0, len(m))
+	for k, v := range m {
+		// the keys are put in the order of their literals
+		// so the bindings don't change the order.
+		entries = append(entries, entry{query.MapKey(k), k, v})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].order < entries[j].order
+	})
+
+	w.WriteByte('[')
+	for i, e := range entries {
+		if i > 0 {
+			w.WriteByte(',')
+		}
+		w.writeKey(e.key)
+		w.WriteByte(':')
+		w.writeArg(e.value)
+	}
+	w.WriteByte(']')
+}
+
+func (w *writer) writeKey(k interface{}) {
+	if _, ok := k.(string); ok && w.bindings == nil {
+		w.WriteString(query.MapKey(k))
+		return
+	}
+
+	w.WriteByte('(')
+	w.writeArg(k)
+	w.WriteByte(')')
+}
+
+// prettyWidth is how long a nested traversal can be before
+// Pretty puts its steps on their own lines too.
+const prettyWidth = 40
+
+// Pretty returns the traversal with every step on its own
+// line, which is easier to read in logs and code reviews.
+// Long nested traversals are indented under their step.
+func (g String) Pretty() string {
+	var w writer
+	w.writePretty(g, "")
+	return w.String()
+}
+
+func (w *writer) writePretty(g String, indent string) {
+	w.WriteString(g.source)
+	for i, s := range g.steps {
+		switch {
+		case i > 0 || g.source != "" && indent == "":
+			w.WriteString("\n" + indent + "  .")
+		case g.source != "":
+			// nested traversals start on the line of their step.
+			w.WriteByte('.')
+		}
+
+		w.WriteString(s.Name + "(")
+		for j, p := range s.Args {
+			var nested String
+			switch t := p.(type) {
+			case String:
+				nested = t.Raw()
+			case Anonymous:
+				nested = t.steps
+			}
+
+			if len(nested.steps) > 1 && len(nested.String()) > prettyWidth {
+				w.writePretty(nested, indent+"  ")
+			} else {
+				w.writeArg(p)
+			}
+
+			// nil parameters are left out of the separators.
+			if len(s.Args) > j+1 && s.Args[j+1] != nil {
+				w.WriteByte(',')
+			}
+		}
+		w.WriteByte(')')
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package traversal
+
+import (
+	"testing"
+
+	"github.com/northwesternmutual/grammes/query/token"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestPretty(t *testing.T) {
+	Convey("Given a traversal with steps", t, func() {
+		g := NewTraversal().V().Has("name", "marko").Out("knows")
+
+		Convey("When Pretty is called", func() {
+			Convey("Then every step should be on its own line", func() {
+				So(g.Pretty(), ShouldEqual, "g\n  .V()\n  .has(\"name\",\"marko\")\n  .out(\"knows\")")
+			})
+		})
+
+		Convey("When Pretty is called with a long nested traversal", func() {
+			g := NewTraversal().V().Repeat(NewAnonymousTraversal().Out("knows").HasLabel("person").SimplePath()).Emit()
+			Convey("Then its steps should be indented under their step", func() {
+				So(g.Pretty(), ShouldEqual, "g\n  .V()\n  .repeat(__.out(\"knows\")\n    .hasLabel(\"person\")\n    .simplePath())\n  .emit()")
+			})
+		})
+
+		Convey("When Pretty is called with a short nested traversal", func() {
+			g := NewTraversal().V().Where(NewAnonymousTraversal().Out().Count())
+			Convey("Then it should stay on the line of its step", func() {
+				So(g.Pretty(), ShouldEqual, "g\n  .V()\n  .where(__.out().count())")
+			})
+		})
+
+		Convey("When Pretty is called on a raw traversal", func() {
+			Convey("Then the first step should not start with a dot", func() {
+				So(g.Raw().Pretty(), ShouldEqual, "V()\n  .has(\"name\",\"marko\")\n  .out(\"knows\")")
+			})
+		})
+	})
+}
+
+func TestMapArguments(t *testing.T) {
+	Convey("Given a step with a map argument", t, func() {
+		Convey("When the map has string keys", func() {
+			g := NewTraversal().MergeV(map[string]interface{}{"name": "marko", "age": 29})
+			Convey("Then it should render as a Groovy map with sorted keys", func() {
+				So(g.String(), ShouldEqual, `g.mergeV(["age":29,"name":"marko"])`)
+			})
+		})
+
+		Convey("When the map has token keys", func() {
+			g := NewTraversal().MergeV(map[interface{}]interface{}{token.Label: "person", token.ID: 1})
+			Convey("Then the keys should be in parentheses", func() {
+				So(g.String(), ShouldEqual, `g.mergeV([(T.id):1,(T.label):"person"])`)
+			})
+		})
+
+		Convey("When the map holds a list", func() {
+			g := NewTraversal().MergeV(map[string]interface{}{"ids": []interface{}{1, "a"}})
+			Convey("Then the list should render as a Groovy list", func() {
+				So(g.String(), ShouldEqual, `g.mergeV(["ids":[1,"a"]])`)
+			})
+		})
+
+		Convey("When the map is empty", func() {
+			g := NewTraversal().MergeV(map[string]interface{}{})
+			Convey("Then it should render as an empty Groovy map", func() {
+				So(g.String(), ShouldEqual, "g.mergeV([:])")
+			})
+		})
+	})
+}
+
+func TestRawSteps(t *testing.T) {
+	Convey("Given a traversal with steps", t, func() {
+		g := NewTraversal().V().Label()
+
+		Convey("When Raw is called", func() {
+			Convey("Then the g source should be removed", func() {
+				So(g.Raw().String(), ShouldEqual, "V().label()")
+				So(g.Raw().Raw().String(), ShouldEqual, "V().label()")
+			})
+		})
+
+		Convey("When steps are added to the raw traversal", func() {
+			Convey("Then they should follow the raw steps", func() {
+				So(g.Raw().Count().String(), ShouldEqual, "V().label().count()")
+			})
+		})
+
+		Convey("When a custom traversal starting with g. is made raw", func() {
+			Convey("Then the prefix should be removed", func() {
+				So(NewCustomTraversal("g.V()").Out().Raw().String(), ShouldEqual, "V().out()")
+			})
+		})
+	})
+}
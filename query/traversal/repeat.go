@@ -24,9 +24,9 @@ package traversal
 
 // Repeat (branch) is used for loopping over a traversal given some break predicate.
 // Signatures:
-// Repeat(*String (Traversal))
-func (g String) Repeat(traversal String) String {
-	g.AddStep("repeat", traversal.Raw())
+// Repeat(Anonymous (Traversal))
+func (g String) Repeat(traversal Anonymous) String {
+	g.AddStep("repeat", traversal)
 
 	return g
 }
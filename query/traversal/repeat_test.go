@@ -31,9 +31,9 @@ func TestRepeat(t *testing.T) {
 		g := NewTraversal()
 
 		Convey("When 'Repeat' is called with a traversal", func() {
-			result := g.Repeat(NewTraversal().Label())
+			result := g.Repeat(NewAnonymousTraversal().Label())
 			Convey("Then result should equal 'g.repeat(label())'", func() {
-				So(result.String(), ShouldEqual, "g.repeat(label())")
+				So(result.String(), ShouldEqual, "g.repeat(__.label())")
 			})
 		})
 	})
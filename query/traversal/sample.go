@@ -20,8 +20,6 @@
 
 package traversal
 
-import "fmt"
-
 // http://tinkerpop.apache.org/docs/current/reference/#sample-step
 
 // Sample (step-modulator) is useful for sampling some number
@@ -31,8 +29,10 @@ import "fmt"
 // Sample(Scope, int)
 func (g String) Sample(params ...interface{}) String {
 	if len(params) == 0 {
-		fmt.Println("Not enough arguments to call Sample()")
+		g.argumentCount("sample", "params")
 		return g
+	} else if len(params) > 2 {
+		g.argumentCount("sample", "params")
 	}
 
 	g.AddStep("sample", params...)
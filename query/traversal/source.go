@@ -0,0 +1,105 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package traversal
+
+// http://tinkerpop.apache.org/docs/current/reference/#start-steps
+
+// TraversalSource is the start of the traversals, g, along
+// with its configuration such as strategies and side effects.
+// The traversals spawned from it keep the configuration.
+type TraversalSource struct {
+	g String
+}
+
+// NewTraversalSource returns the g traversal
+// source without any configuration.
+func NewTraversalSource() TraversalSource {
+	return TraversalSource{NewTraversal()}
+}
+
+func (s TraversalSource) String() string {
+	return s.g.String()
+}
+
+// WithStrategies adds strategies to the traversals,
+// such as a ReadOnlyStrategy or a PartitionStrategy.
+func (s TraversalSource) WithStrategies(strategies ...Strategy) TraversalSource {
+	if len(strategies) == 0 {
+		return s
+	}
+
+	params := make([]interface{}, 0, len(strategies))
+	for _, st := range strategies {
+		params = append(params, st)
+	}
+	s.g.AddStep("withStrategies", params...)
+
+	return s
+}
+
+// WithSideEffect adds a side effect with the key
+// that the steps of the traversals can use.
+func (s TraversalSource) WithSideEffect(key string, value interface{}) TraversalSource {
+	s.g.AddStep("withSideEffect", key, value)
+
+	return s
+}
+
+// WithBulk sets whether the traversers are bulked.
+// Without bulking each traverser counts as one result.
+func (s TraversalSource) WithBulk(bulk bool) TraversalSource {
+	s.g.AddStep("withBulk", bulk)
+
+	return s
+}
+
+// WithComputer runs the traversals on the
+// graph computer instead of the database.
+func (s TraversalSource) WithComputer() TraversalSource {
+	s.g.AddStep("withComputer")
+
+	return s
+}
+
+// V starts a traversal over the vertices.
+func (s TraversalSource) V(params ...interface{}) String {
+	return s.g.V(params...)
+}
+
+// E starts a traversal over the edges.
+func (s TraversalSource) E() String {
+	return s.g.E()
+}
+
+// AddV starts a traversal that adds a vertex.
+func (s TraversalSource) AddV(params ...interface{}) String {
+	return s.g.AddV(params...)
+}
+
+// AddE starts a traversal that adds an edge.
+func (s TraversalSource) AddE(param interface{}) String {
+	return s.g.AddE(param)
+}
+
+// Inject starts a traversal with the object.
+func (s TraversalSource) Inject(obj string) String {
+	return s.g.Inject(obj)
+}
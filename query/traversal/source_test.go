@@ -0,0 +1,67 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package traversal
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestTraversalSource(t *testing.T) {
+	Convey("Given a traversal source", t, func() {
+		s := NewTraversalSource()
+
+		Convey("When it has no configuration", func() {
+			Convey("Then it should be g", func() {
+				So(s.String(), ShouldEqual, "g")
+				So(s.V().String(), ShouldEqual, "g.V()")
+			})
+		})
+
+		Convey("When it's configured", func() {
+			s = s.WithStrategies(ReadOnlyStrategy{}).WithSideEffect("a", 1).WithBulk(false).WithComputer()
+			Convey("Then the configuration should be rendered in order", func() {
+				So(s.String(), ShouldEqual, `g.withStrategies(ReadOnlyStrategy.instance()).withSideEffect("a",1).withBulk(false).withComputer()`)
+			})
+
+			Convey("Then the traversals spawned from it should keep it", func() {
+				So(s.V(1).String(), ShouldStartWith, "g.withStrategies(")
+				So(s.V(1).String(), ShouldEndWith, ".withComputer().V(1)")
+				So(s.E().String(), ShouldEndWith, ".withComputer().E()")
+				So(s.AddV("person").String(), ShouldEndWith, `.withComputer().addV("person")`)
+				So(s.AddE("knows").String(), ShouldEndWith, `.withComputer().addE("knows")`)
+				So(s.Inject("x").String(), ShouldEndWith, `.withComputer().inject("x")`)
+			})
+
+			Convey("Then spawning a traversal should not change the source", func() {
+				s.V().Out()
+				So(s.String(), ShouldEndWith, ".withComputer()")
+			})
+		})
+
+		Convey("When WithStrategies is called without strategies", func() {
+			Convey("Then the source should be unchanged", func() {
+				So(s.WithStrategies().String(), ShouldEqual, "g")
+			})
+		})
+	})
+}
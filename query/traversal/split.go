@@ -0,0 +1,50 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package traversal
+
+import "github.com/northwesternmutual/grammes/query/scope"
+
+// https://tinkerpop.apache.org/docs/current/reference/#split-step
+
+// Split (map) splits the string into a list at every
+// separator. Added in TinkerPop 3.7.1.
+// Signatures:
+// Split(string)
+// Split(Scope, string)
+func (g String) Split(first interface{}, separator ...string) String {
+	switch t := first.(type) {
+	case string:
+		if len(separator) > 0 {
+			g.argumentCount("split", "separator")
+		}
+		g.AddStep("split", t)
+	case scope.Scope:
+		if len(separator) != 1 {
+			g.argumentCount("split", "separator")
+		}
+		g.AddStep("split", append([]interface{}{t}, stringParams(separator)...)...)
+	default:
+		g.argumentType("split", "first", first)
+		g.AddStep("split")
+	}
+
+	return g
+}
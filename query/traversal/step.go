@@ -0,0 +1,99 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package traversal
+
+// Source returns what the traversal starts from,
+// such as g or __. Nested traversals have none.
+func (g String) Source() string {
+	return g.source
+}
+
+// Steps returns a copy of the steps of the traversal
+// in the order that they're executed.
+func (g String) Steps() []Step {
+	steps := make([]Step, len(g.steps))
+	for i, s := range g.steps {
+		steps[i] = s.clone()
+	}
+	return steps
+}
+
+// LastStep returns the final step of the traversal, which
+// can be used to check things such as whether it ends
+// in drop(). It isn't ok when there are no steps.
+func (g String) LastStep() (Step, bool) {
+	if len(g.steps) == 0 {
+		return Step{}, false
+	}
+	return g.steps[len(g.steps)-1].clone(), true
+}
+
+// Insert returns the traversal with the steps added before
+// the step at the index. An index at or past the end adds
+// the steps to the end of the traversal.
+func (g String) Insert(index int, steps ...Step) String {
+	if index < 0 {
+		index = 0
+	}
+	if index > len(g.steps) {
+		index = len(g.steps)
+	}
+
+	res := make([]Step, 0, len(g.steps)+len(steps))
+	res = append(res, g.steps[:index]...)
+	for _, s := range steps {
+		res = append(res, s.clone())
+	}
+	g.steps = append(res, g.steps[index:]...)
+
+	return g
+}
+
+// Clone returns a traversal that shares nothing
+// with the original, so either can be changed.
+func (g String) Clone() String {
+	g.steps = g.Steps()
+	return g
+}
+
+// clone copies the arguments of the step.
+func (s Step) clone() Step {
+	s.Args = append([]interface{}(nil), s.Args...)
+	return s
+}
+
+// Replace returns the traversal with the step at the
+// index replaced by the given steps. Giving no steps
+// removes the step. An index out of range changes nothing.
+func (g String) Replace(index int, steps ...Step) String {
+	if index < 0 || index >= len(g.steps) {
+		return g
+	}
+
+	res := make([]Step, 0, len(g.steps)-1+len(steps))
+	res = append(res, g.steps[:index]...)
+	for _, s := range steps {
+		res = append(res, s.clone())
+	}
+	g.steps = append(res, g.steps[index+1:]...)
+
+	return g
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package traversal
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSteps(t *testing.T) {
+	Convey("Given a traversal with steps", t, func() {
+		g := NewTraversal().V().HasLabel("person").Drop()
+
+		Convey("When Source is called", func() {
+			Convey("Then it should be g", func() {
+				So(g.Source(), ShouldEqual, "g")
+				So(g.Raw().Source(), ShouldEqual, "")
+			})
+		})
+
+		Convey("When Steps is called", func() {
+			steps := g.Steps()
+			Convey("Then every step should be returned in order", func() {
+				So(steps, ShouldResemble, []Step{
+					{Name: "V"},
+					{Name: "hasLabel", Args: []interface{}{"person"}},
+					{Name: "drop"},
+				})
+			})
+
+			Convey("Then changing them should not change the traversal", func() {
+				steps[1].Args[0] = "software"
+				So(g.String(), ShouldEqual, `g.V().hasLabel("person").drop()`)
+			})
+		})
+
+		Convey("When LastStep is called", func() {
+			last, ok := g.LastStep()
+			Convey("Then it should be the drop step", func() {
+				So(ok, ShouldBeTrue)
+				So(last.Name, ShouldEqual, "drop")
+			})
+		})
+
+		Convey("When LastStep is called without steps", func() {
+			_, ok := NewTraversal().LastStep()
+			Convey("Then it should not be ok", func() {
+				So(ok, ShouldBeFalse)
+			})
+		})
+
+		Convey("When Insert is called", func() {
+			res := g.Insert(2, Step{Name: "limit", Args: []interface{}{1}})
+			Convey("Then the step should be added before the index", func() {
+				So(res.String(), ShouldEqual, `g.V().hasLabel("person").limit(1).drop()`)
+				So(g.String(), ShouldEqual, `g.V().hasLabel("person").drop()`)
+			})
+		})
+
+		Convey("When Insert is called past the end", func() {
+			res := g.Insert(10, Step{Name: "count"})
+			Convey("Then the step should be added to the end", func() {
+				So(res.String(), ShouldEqual, `g.V().hasLabel("person").drop().count()`)
+			})
+		})
+
+		Convey("When two branches are built from the same traversal", func() {
+			base := NewTraversal().V().Out()
+			a := base.Limit(1)
+			b := base.Count()
+			Convey("Then they should not change each other", func() {
+				So(a.String(), ShouldEqual, "g.V().out().limit(1)")
+				So(b.String(), ShouldEqual, "g.V().out().count()")
+				So(base.String(), ShouldEqual, "g.V().out()")
+			})
+		})
+
+		Convey("When Clone is called", func() {
+			c := g.Clone()
+			c.AddStep("iterate")
+			Convey("Then the clone should be separate", func() {
+				So(c.String(), ShouldEqual, `g.V().hasLabel("person").drop().iterate()`)
+				So(g.String(), ShouldEqual, `g.V().hasLabel("person").drop()`)
+			})
+		})
+	})
+}
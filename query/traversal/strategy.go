@@ -0,0 +1,169 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package traversal
+
+// http://tinkerpop.apache.org/docs/current/reference/#traversalstrategy
+
+// Strategy is a traversal strategy given to WithStrategies.
+type Strategy interface {
+	Parameter
+	// Name is the class of the strategy, such as PartitionStrategy.
+	Name() string
+	// Configuration is the options of the strategy by
+	// name, leaving out the ones that aren't set.
+	Configuration() map[string]interface{}
+}
+
+// PartitionStrategy keeps the elements in partitions. The new
+// elements are written to the WritePartition and only the elements
+// in the ReadPartitions are read. PartitionKey is the property that
+// holds the partition of the elements.
+type PartitionStrategy struct {
+	PartitionKey          string
+	WritePartition        string
+	ReadPartitions        []string
+	IncludeMetaProperties bool
+}
+
+// Name returns PartitionStrategy.
+func (PartitionStrategy) Name() string {
+	return "PartitionStrategy"
+}
+
+// Configuration returns the options of the partitions.
+func (p PartitionStrategy) Configuration() map[string]interface{} {
+	c := map[string]interface{}{}
+	if p.PartitionKey != "" {
+		c["partitionKey"] = p.PartitionKey
+	}
+	if p.WritePartition != "" {
+		c["writePartition"] = p.WritePartition
+	}
+	if len(p.ReadPartitions) > 0 {
+		c["readPartitions"] = p.ReadPartitions
+	}
+	if p.IncludeMetaProperties {
+		c["includeMetaProperties"] = true
+	}
+	return c
+}
+
+func (p PartitionStrategy) String() string {
+	return buildStrategy(p)
+}
+
+// SubgraphStrategy limits the traversals to the vertices, edges
+// and vertex properties that the traversals have results for.
+// Traversals without steps are left out.
+type SubgraphStrategy struct {
+	Vertices         Anonymous
+	Edges            Anonymous
+	VertexProperties Anonymous
+}
+
+// Name returns SubgraphStrategy.
+func (SubgraphStrategy) Name() string {
+	return "SubgraphStrategy"
+}
+
+// Configuration returns the traversals that have steps.
+func (s SubgraphStrategy) Configuration() map[string]interface{} {
+	c := map[string]interface{}{}
+	if len(s.Vertices.Steps()) > 0 {
+		c["vertices"] = s.Vertices
+	}
+	if len(s.Edges.Steps()) > 0 {
+		c["edges"] = s.Edges
+	}
+	if len(s.VertexProperties.Steps()) > 0 {
+		c["vertexProperties"] = s.VertexProperties
+	}
+	return c
+}
+
+func (s SubgraphStrategy) String() string {
+	return buildStrategy(s)
+}
+
+// ReadOnlyStrategy refuses the traversals
+// that would change the graph.
+type ReadOnlyStrategy struct{}
+
+// Name returns ReadOnlyStrategy.
+func (ReadOnlyStrategy) Name() string {
+	return "ReadOnlyStrategy"
+}
+
+// Configuration returns no options.
+func (ReadOnlyStrategy) Configuration() map[string]interface{} {
+	return map[string]interface{}{}
+}
+
+func (ReadOnlyStrategy) String() string {
+	return "ReadOnlyStrategy.instance()"
+}
+
+// EdgeLabelVerificationStrategy checks that the steps
+// that walk over edges are given edge labels. It either
+// logs a warning or throws an exception when they're not.
+type EdgeLabelVerificationStrategy struct {
+	LogWarning     bool
+	ThrowException bool
+}
+
+// Name returns EdgeLabelVerificationStrategy.
+func (EdgeLabelVerificationStrategy) Name() string {
+	return "EdgeLabelVerificationStrategy"
+}
+
+// Configuration returns the options that are set.
+func (e EdgeLabelVerificationStrategy) Configuration() map[string]interface{} {
+	c := map[string]interface{}{}
+	if e.LogWarning {
+		c["logWarning"] = true
+	}
+	if e.ThrowException {
+		c["throwException"] = true
+	}
+	return c
+}
+
+func (e EdgeLabelVerificationStrategy) String() string {
+	return buildStrategy(e)
+}
+
+// buildStrategy writes the strategy with its builder,
+// such as PartitionStrategy.build().partitionKey("p").create().
+func buildStrategy(s Strategy) string {
+	b := NewCustomTraversal(s.Name() + ".build()")
+	c := s.Configuration()
+	for _, key := range sortedKeys(c) {
+		switch t := c[key].(type) {
+		case []string:
+			b.AddStep(key, stringParams(t)...)
+		default:
+			b.AddStep(key, t)
+		}
+	}
+	b.AddStep("create")
+
+	return b.String()
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package traversal
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestStrategy(t *testing.T) {
+	Convey("Given the strategies", t, func() {
+		Convey("When a PartitionStrategy is rendered", func() {
+			p := PartitionStrategy{
+				PartitionKey:   "_partition",
+				WritePartition: "a",
+				ReadPartitions: []string{"a", "b"},
+			}
+			Convey("Then it should use its builder", func() {
+				So(p.String(), ShouldEqual, `PartitionStrategy.build().partitionKey("_partition").readPartitions("a","b").writePartition("a").create()`)
+			})
+		})
+
+		Convey("When a SubgraphStrategy is rendered", func() {
+			s := SubgraphStrategy{Vertices: NewAnonymousTraversal().HasLabel("person")}
+			Convey("Then only the traversals with steps should be set", func() {
+				So(s.String(), ShouldEqual, `SubgraphStrategy.build().vertices(__.hasLabel("person")).create()`)
+			})
+		})
+
+		Convey("When a ReadOnlyStrategy is rendered", func() {
+			Convey("Then it should be its instance", func() {
+				So(ReadOnlyStrategy{}.String(), ShouldEqual, "ReadOnlyStrategy.instance()")
+			})
+		})
+
+		Convey("When an EdgeLabelVerificationStrategy is rendered", func() {
+			e := EdgeLabelVerificationStrategy{ThrowException: true}
+			Convey("Then only the options that are set should be given", func() {
+				So(e.String(), ShouldEqual, "EdgeLabelVerificationStrategy.build().throwException(true).create()")
+				So(e.Configuration(), ShouldResemble, map[string]interface{}{"throwException": true})
+			})
+		})
+
+		Convey("When strategies are given to a traversal source", func() {
+			g := NewTraversalSource().WithStrategies(
+				PartitionStrategy{PartitionKey: "p", WritePartition: "a"},
+				ReadOnlyStrategy{},
+			).V()
+			Convey("Then they should be separated by commas", func() {
+				So(g.String(), ShouldEqual, `g.withStrategies(PartitionStrategy.build().partitionKey("p").writePartition("a").create(),ReadOnlyStrategy.instance()).V()`)
+			})
+		})
+	})
+}
@@ -30,13 +30,17 @@ package traversal
 // Tail(Scope)
 // Tail(Scope, float32)
 func (g String) Tail(first interface{}, extraFloat ...float32) String {
-	g = g.append(fmtStr(".tail(%v", first))
+	p := []interface{}{first}
+
+	if s, ok := first.(string); ok {
+		p[0] = Custom(s)
+	}
 
 	if len(extraFloat) > 0 {
-		g = g.append(fmtStr(",%v", extraFloat[0]))
+		p = append(p, extraFloat[0])
 	}
 
-	g = g.append(")")
+	g.AddStep("tail", p...)
 
 	return g
 }
@@ -21,8 +21,6 @@
 package traversal
 
 import (
-	"fmt"
-
 	"github.com/northwesternmutual/grammes/query/direction"
 )
 
@@ -36,29 +34,25 @@ import (
 // Signatures:
 // To(Direction, ...string)
 // To(string)
-// To(*String (Traversal))
+// To(Anonymous (Traversal))
 // To(string Vertex)
 func (g String) To(first interface{}, extraStrings ...string) String {
-	g = g.append(".to(")
+	var p []interface{}
 
-	switch first.(type) {
+	switch t := first.(type) {
 	case string:
-		g = g.append(first.(string))
-	case String:
-		g = g.append(first.(String).Raw().String())
-	case direction.Direction:
-		g = g.append(fmtStr("%v", first.(direction.Direction)))
+		p = append(p, Custom(t))
+	case Anonymous, String, direction.Direction:
+		p = append(p, t)
 	default:
-		fmt.Println("Type mismatch used in To()")
+		g.argumentType("to", "first", first)
 	}
 
-	if len(extraStrings) > 0 {
-		for _, v := range extraStrings {
-			g = g.append(fmtStr(",%v", v))
-		}
+	for _, v := range extraStrings {
+		p = append(p, Custom(v))
 	}
 
-	g = g.append(")")
+	g.AddStep("to", p...)
 
 	return g
 }
@@ -67,7 +61,7 @@ func (g String) To(first interface{}, extraStrings ...string) String {
 // Signatures:
 // ToE(Direction, string)
 func (g String) ToE(dir direction.Direction, str string) String {
-	g = g.append(fmtStr(".toE(%v, \"%v\")", dir, str))
+	g.AddStep("toE", Custom(fmtStr("%v, %v", dir, quote(str))))
 
 	return g
 }
@@ -84,7 +78,7 @@ func (g String) ToV(dir direction.Direction) String {
 // ToVId can be used to make a string query that will take a vertex id as a parameter,
 // and can be used to point an edge towards this vertex ID.
 func (g String) ToVId(vertexID interface{}) String {
-	g = g.append(fmtStr(".to(V().hasId(%v))", vertexID))
+	g.AddStep("to", NewAnonymousTraversal().V().HasID(vertexID))
 
 	return g
 }
@@ -103,8 +103,17 @@ func TestToVId(t *testing.T) {
 		g := NewTraversal()
 		Convey("When 'ToVId' is called", func() {
 			result := g.ToVId(1234)
-			Convey("Then result should equal 'g.to(V().hasId(1234))'", func() {
-				So(result.String(), ShouldEqual, "g.to(V().hasId(1234))")
+			Convey("Then result should equal 'g.to(__.V().hasId(1234))'", func() {
+				So(result.String(), ShouldEqual, "g.to(__.V().hasId(1234))")
+			})
+		})
+		Convey("When 'ToVId' is called with a string ID", func() {
+			result := g.ToVId("a\"b")
+			Convey("Then the ID should be quoted and bound", func() {
+				So(result.String(), ShouldEqual, `g.to(__.V().hasId("a\"b"))`)
+				script, bindings := result.Bind()
+				So(script, ShouldEqual, "g.to(__.V().hasId(_p0))")
+				So(bindings, ShouldResemble, map[string]string{"_p0": "a\"b"})
 			})
 		})
 	})
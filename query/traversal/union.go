@@ -28,8 +28,8 @@ package traversal
 // of the respective internal traversals.
 // Signatures:
 // Union()
-// Union(...*String (Traversal))
-func (g String) Union(traversals ...String) String {
+// Union(...Anonymous (Traversal))
+func (g String) Union(traversals ...Anonymous) String {
 	var params []interface{}
 
 	for _, t := range traversals {
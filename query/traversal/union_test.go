@@ -37,18 +37,18 @@ func TestUnion(t *testing.T) {
 		})
 
 		Convey("When 'Union' is called with a traversal", func() {
-			result := g.Union(NewTraversal().Label())
+			result := g.Union(NewAnonymousTraversal().Label())
 			Convey("Then result should equal 'g.union(label())'", func() {
-				So(result.String(), ShouldEqual, "g.union(label())")
+				So(result.String(), ShouldEqual, "g.union(__.label())")
 			})
 		})
 
 		Convey("When 'Union' is called with multiple params", func() {
-			obj1 := NewTraversal().Label()
-			obj2 := NewTraversal().Key()
+			obj1 := NewAnonymousTraversal().Label()
+			obj2 := NewAnonymousTraversal().Key()
 			result := g.Union(obj1, obj2)
 			Convey("Then result should equal 'g.union(label(),key())'", func() {
-				So(result.String(), ShouldEqual, "g.union(label(),key())")
+				So(result.String(), ShouldEqual, "g.union(__.label(),__.key())")
 			})
 		})
 	})
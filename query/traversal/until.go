@@ -28,34 +28,14 @@ import (
 
 // Until (step-modulator) for Repeat()
 // Signatures:
-// Until(string (Predicate))
-// Until(*String (Traversal))
+// Until(*predicate.Predicate)
+// Until(Anonymous (Traversal))
 func (g String) Until(predOrTrav interface{}) String {
-	// g = g.append(".until(")
-
-	// switch predOrTrav.(type) {
-	// case string:
-	// 	g = g.append(predOrTrav.(string))
-	// case String:
-	// 	g = g.append(predOrTrav.(String).String())
-	// default:
-	// 	fmt.Println("Mismatching types for Until()")
-	// }
-
-	// g = g.append(")")
-
-	var i interface{}
-
 	switch t := predOrTrav.(type) {
-	case *predicate.Predicate:
-		i = t
-	case String:
-		i = t
-	}
-
-	if i != nil {
-		g.AddStep("until", i)
-	} else {
+	case *predicate.Predicate, Anonymous:
+		g.AddStep("until", t)
+	default:
+		g.argumentType("until", "predOrTrav", predOrTrav)
 		g.AddStep("until")
 	}
 
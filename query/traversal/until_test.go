@@ -21,8 +21,10 @@
 package traversal
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/northwesternmutual/grammes/gremerror"
 	"github.com/northwesternmutual/grammes/query/predicate"
 
 	. "github.com/smartystreets/goconvey/convey"
@@ -33,10 +35,11 @@ func TestUntil(t *testing.T) {
 	Convey("Given a ) String { that represents the graph's traversal", t, func() {
 		g := NewTraversal()
 
-		Convey("When 'Until' is called with extras argument ) String {", func() {
-			result := g.Until(NewTraversal().Label().Raw())
-			Convey("Then result should equal 'g.until(label())'", func() {
-				So(result.String(), ShouldEqual, "g.until(label())")
+		Convey("When 'Until' is called with an anonymous traversal", func() {
+			result := g.Until(NewAnonymousTraversal().Label())
+			Convey("Then result should equal 'g.until(__.label())'", func() {
+				So(result.String(), ShouldEqual, "g.until(__.label())")
+				So(result.Err(), ShouldBeNil)
 			})
 		})
 
@@ -44,6 +47,14 @@ func TestUntil(t *testing.T) {
 			result := g.Until(1234)
 			Convey("Then result should equal 'g.until()'", func() {
 				So(result.String(), ShouldEqual, "g.until()")
+				So(errors.Is(result.Err(), gremerror.ErrArgumentType), ShouldBeTrue)
+			})
+		})
+
+		Convey("When 'Until' is called with a string or a traversal", func() {
+			Convey("Then the result should have a build error", func() {
+				So(errors.Is(g.Until("loops()").Err(), gremerror.ErrArgumentType), ShouldBeTrue)
+				So(errors.Is(g.Until(NewTraversal().V()).Err(), gremerror.ErrArgumentType), ShouldBeTrue)
 			})
 		})
 
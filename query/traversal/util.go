@@ -21,21 +21,25 @@
 package traversal
 
 import (
-	"bytes"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
+
+	"github.com/northwesternmutual/grammes/query"
 )
 
 // fmtStr is used because it prevents from
 // importing fmt in multiple files.
 var fmtStr = fmt.Sprintf
 
+// quote turns a string into an escaped string literal.
+var quote = query.Quote
+
 // NewTraversal will return a new Query with
 // a default value of 'g' to start a command.
 func NewTraversal() (g String) {
-	g.string = "g"
-	g.buffer = bytes.NewBufferString("")
+	g.source = "g"
 	return
 }
 
@@ -44,68 +48,90 @@ func NewTraversal() (g String) {
 // This can be something such as:
 //  // ==> graph.traversal().withoutStrategies(LazyBarrierStrategy)
 func NewCustomTraversal(str string) (g String) {
-	g.string = str
-	g.buffer = bytes.NewBufferString("")
+	g.source = str
 	return g
 }
 
 func (g String) String() string {
-	return g.string
+	var w writer
+	w.writeGroovy(g)
+	return w.String()
 }
 
 // Raw will return the raw traversal commands
 // to be used as a parameter for other steps.
 func (g String) Raw() String {
-	str := g.String()
-	res := strings.TrimPrefix(str, "g.")
-	cmd := NewCustomTraversal(res)
-	return cmd
+	switch {
+	case g.source == "g" && len(g.steps) > 0:
+		g.source = ""
+		g.raw = true
+	case strings.HasPrefix(g.source, "g."):
+		g.source = strings.TrimPrefix(g.source, "g.")
+	}
+	return g
 }
 
-// append will simply take this Query
-// and append a string to it
-func (g String) append(str string) String {
-	g.string += str
-	return g
+// Bind returns the traversal with its string values moved into
+// bindings named _p0, _p1 and so on, which is how the managers
+// execute it. Only the strings given to steps are bound.
+// Predicates are rendered when they're made, so their values,
+// such as "x" in Has("name", predicate.Equal("x")), are kept
+// in the script as escaped literals. Traversals that differ only
+// in those values are different scripts to the server's cache.
+func (g String) Bind() (string, map[string]string) {
+	w := writer{bindings: map[string]string{}}
+	w.writeGroovy(g)
+	return w.String(), w.bindings
 }
 
 // AddStep will add a new step to the traversal string
 // using a list of parameters.
 func (g *String) AddStep(step string, params ...interface{}) {
-	g.buffer.Reset()
-
-	g.buffer.WriteString("." + step + "(")
-
-	for i, p := range params {
+	// The steps are shared by the copies of the traversal,
+	// so the slice is always copied before it grows.
+	g.steps = append(g.steps[:len(g.steps):len(g.steps)], Step{
+		Name: step,
+		Args: append([]interface{}(nil), params...),
+	})
+
+	// errors made in nested traversals belong to this one too.
+	for _, p := range params {
 		switch t := p.(type) {
 		case String:
-			g.buffer.WriteString(t.Raw().String())
-		case Parameter:
-			g.buffer.WriteString(t.String())
-		case byte:
-			g.buffer.WriteByte(t)
-		case []byte:
-			g.buffer.Write(t)
-		case string:
-			g.buffer.WriteString("\"" + strings.ReplaceAll(t, "\"", "\\\"") + "\"")
-		default:
-			g.buffer.WriteString(fmt.Sprintf("%v", t))
+			g.addError(t.errs...)
+		case Anonymous:
+			g.addError(t.steps.errs...)
 		}
-
-		g.commaSeperator(i, params...)
 	}
+}
 
-	g.buffer.WriteString(")")
+// addError records an error made while building the
+// step. The errors are shared by the copies of the
+// traversal the same way the steps are.
+func (g *String) addError(errs ...error) {
+	if len(errs) > 0 {
+		g.errs = append(g.errs[:len(g.errs):len(g.errs)], errs...)
+	}
+}
 
-	g.string += g.buffer.String()
+// stringParams turns a list of strings
+// into the parameters of a step.
+func stringParams(strs []string) []interface{} {
+	params := make([]interface{}, 0, len(strs))
+	for _, s := range strs {
+		params = append(params, s)
+	}
+	return params
 }
 
-func (g *String) commaSeperator(i int, params ...interface{}) {
-	if len(params) > i+1 {
-		if params[i+1] != nil {
-			g.buffer.WriteString(",")
-		}
+// sortedKeys returns the keys of the map in order.
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
 	}
+	sort.Strings(keys)
+	return keys
 }
 
 // gatherInts will act as a filter for
@@ -24,6 +24,8 @@ import (
 	"testing"
 
 	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/query/predicate"
 )
 
 func TestAddStep(t *testing.T) {
@@ -69,6 +71,13 @@ func TestAddStep(t *testing.T) {
 			})
 		})
 
+		Convey("When AddStep is called with a string", func() {
+			g.AddStep("test", "a\"b\\c$d\n")
+			Convey("Then the string should be escaped", func() {
+				So(g.String(), ShouldEqual, `g.test("a\"b\\c\$d\n")`)
+			})
+		})
+
 		Convey("When AddStep is called with bool", func() {
 			b := true
 			g.AddStep("test", b)
@@ -92,6 +101,49 @@ func TestString(t *testing.T) {
 	})
 }
 
+func TestBind(t *testing.T) {
+	Convey("Given a traversal with string values", t, func() {
+		g := NewTraversal().V().HasLabel("person").Has("name", "damien")
+		Convey("When Bind is called", func() {
+			script, bindings := g.Bind()
+			Convey("Then the values should be moved into bindings", func() {
+				So(script, ShouldEqual, "g.V().hasLabel(_p0).has(_p1,_p2)")
+				So(bindings, ShouldResemble, map[string]string{
+					"_p0": "person", "_p1": "name", "_p2": "damien",
+				})
+			})
+		})
+	})
+
+	Convey("Given a traversal with nested traversals and maps", t, func() {
+		g := NewTraversal().V().Where(NewAnonymousTraversal().Out("knows")).
+			MergeV(map[string]interface{}{"b": "x", "a": 1})
+		Convey("When Bind is called", func() {
+			script, bindings := g.Bind()
+			Convey("Then the values of both should be moved into bindings", func() {
+				So(script, ShouldEqual, "g.V().where(__.out(_p0)).mergeV([(_p1):1,(_p2):_p3])")
+				So(bindings, ShouldResemble, map[string]string{
+					"_p0": "knows", "_p1": "a", "_p2": "b", "_p3": "x",
+				})
+			})
+			Convey("Then String should keep the values in the script", func() {
+				So(g.String(), ShouldEqual, `g.V().where(__.out("knows")).mergeV(["a":1,"b":"x"])`)
+			})
+		})
+	})
+
+	Convey("Given a traversal with a predicate", t, func() {
+		g := NewTraversal().V().Has("name", predicate.Within("a", "b"))
+		Convey("When Bind is called", func() {
+			script, bindings := g.Bind()
+			Convey("Then the values of the predicate should stay in the script", func() {
+				So(script, ShouldEqual, `g.V().has(_p0,within("a","b"))`)
+				So(bindings, ShouldResemble, map[string]string{"_p0": "name"})
+			})
+		})
+	})
+}
+
 func TestRaw(t *testing.T) {
 	Convey("Given a ) String { that represents the graph's traversal", t, func() {
 		g := NewTraversal()
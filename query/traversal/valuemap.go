@@ -33,22 +33,14 @@ func (g String) ValueMap(boolOrStrings ...interface{}) String {
 		return g
 	}
 
-	// append the command beginning along with the first parameter value.
-	switch boolOrStrings[0].(type) {
-	case string:
-		g = g.append(".valueMap(\"" + boolOrStrings[0].(string) + "\"")
-	default:
-		g = g.append(fmtStr(".valueMap(%v", boolOrStrings[0]))
-	}
+	// the rest of the parameters are always property names.
+	p := []interface{}{boolOrStrings[0]}
 
-	// append the rest of the parameters
-	if len(boolOrStrings) > 1 {
-		for _, v := range boolOrStrings[1:] {
-			g = g.append(fmtStr(",\"%v\"", v))
-		}
+	for _, v := range boolOrStrings[1:] {
+		p = append(p, fmtStr("%v", v))
 	}
 
-	g = g.append(")")
+	g.AddStep("valueMap", p...)
 
 	return g
 }
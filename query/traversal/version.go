@@ -0,0 +1,111 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package traversal
+
+import (
+	"strconv"
+	"strings"
+)
+
+// stepVersions are the TinkerPop versions that added the steps
+// newer than 3.3. Steps that aren't listed are in every version.
+var stepVersions = map[string]string{
+	"elementMap":         "3.4.4",
+	"index":              "3.4.0",
+	"with":               "3.4.0",
+	"shortestPath":       "3.4.0",
+	"connectedComponent": "3.4.0",
+	"none":               "3.5.0",
+	"mergeV":             "3.6.0",
+	"mergeE":             "3.6.0",
+	"call":               "3.6.0",
+	"fail":               "3.6.0",
+	"element":            "3.6.0",
+	"concat":             "3.7.1",
+	"asString":           "3.7.1",
+	"toUpper":            "3.7.1",
+	"toLower":            "3.7.1",
+	"split":              "3.7.1",
+	"dateAdd":            "3.7.1",
+	"dateDiff":           "3.7.1",
+}
+
+// MinimumVersion returns the TinkerPop version that added the
+// step, such as "3.6.0". It's empty for steps in every version.
+func MinimumVersion(step string) string {
+	return stepVersions[step]
+}
+
+// MinimumVersion returns the step of the traversal that needs the
+// newest version of TinkerPop along with that version. Nested
+// traversals are included. Both are empty when every version
+// of TinkerPop has all of the steps.
+func (g String) MinimumVersion() (step, version string) {
+	for _, s := range g.steps {
+		if v := MinimumVersion(s.Name); CompareVersions(v, version) > 0 {
+			step, version = s.Name, v
+		}
+
+		for _, arg := range s.Args {
+			var nested String
+			switch t := arg.(type) {
+			case String:
+				nested = t
+			case Anonymous:
+				nested = t.steps
+			default:
+				continue
+			}
+
+			if ns, nv := nested.MinimumVersion(); CompareVersions(nv, version) > 0 {
+				step, version = ns, nv
+			}
+		}
+	}
+
+	return step, version
+}
+
+// CompareVersions compares two TinkerPop versions such as "3.6.2"
+// and returns -1, 0 or 1 when a is older, the same or newer than
+// b. Parts that are missing or can't be read count as 0, and
+// suffixes such as "-SNAPSHOT" are ignored.
+func CompareVersions(a, b string) int {
+	as, bs := versionParts(a), versionParts(b)
+	for i := 0; i < 3; i++ {
+		switch {
+		case as[i] < bs[i]:
+			return -1
+		case as[i] > bs[i]:
+			return 1
+		}
+	}
+	return 0
+}
+
+func versionParts(version string) [3]int {
+	var parts [3]int
+	version = strings.SplitN(version, "-", 2)[0]
+	for i, p := range strings.SplitN(version, ".", 3) {
+		parts[i], _ = strconv.Atoi(p)
+	}
+	return parts
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package traversal
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMinimumVersion(t *testing.T) {
+	Convey("Given a traversal with steps from different versions", t, func() {
+		g := NewTraversal().V().ElementMap().Map(NewAnonymousTraversal().Values("name").ToUpper())
+
+		Convey("When MinimumVersion is called", func() {
+			step, version := g.MinimumVersion()
+			Convey("Then the newest step should be returned, including nested steps", func() {
+				So(step, ShouldEqual, "toUpper")
+				So(version, ShouldEqual, "3.7.1")
+			})
+		})
+
+		Convey("When MinimumVersion is called on a traversal with only old steps", func() {
+			step, version := NewTraversal().V().Out().MinimumVersion()
+			Convey("Then both should be empty", func() {
+				So(step, ShouldBeEmpty)
+				So(version, ShouldBeEmpty)
+			})
+		})
+
+		Convey("When MinimumVersion is called with a step name", func() {
+			Convey("Then the version that added it should be returned", func() {
+				So(MinimumVersion("mergeV"), ShouldEqual, "3.6.0")
+				So(MinimumVersion("out"), ShouldBeEmpty)
+			})
+		})
+	})
+}
+
+func TestCompareVersions(t *testing.T) {
+	Convey("Given two TinkerPop versions", t, func() {
+		Convey("When CompareVersions is called", func() {
+			Convey("Then the older version should be smaller", func() {
+				So(CompareVersions("3.5.4", "3.6.0"), ShouldEqual, -1)
+				So(CompareVersions("3.10.0", "3.9.2"), ShouldEqual, 1)
+				So(CompareVersions("3.6", "3.6.0"), ShouldEqual, 0)
+				So(CompareVersions("3.7.1-SNAPSHOT", "3.7.1"), ShouldEqual, 0)
+				So(CompareVersions("", "3.4.0"), ShouldEqual, -1)
+			})
+		})
+	})
+}
@@ -20,8 +20,6 @@
 
 package traversal
 
-import "fmt"
-
 // http://tinkerpop.apache.org/docs/current/reference/#where-step
 
 // Where (filter) filters the current objects based on either the object
@@ -32,27 +30,25 @@ import "fmt"
 // Signatures:
 // Where(string (P))
 // Where(string, string (P))
-// Where(*String (Traversal))
+// Where(Anonymous (Traversal))
 func (g String) Where(first interface{}, extra ...string) String {
-	g = g.append(".where(")
+	var p []interface{}
 
-	switch first.(type) {
+	switch t := first.(type) {
 	case string:
-		g = g.append(first.(string))
-	case String: // Where(*String (Traversal))
-		g = g.append(fmtStr("%v)", first.(String).String()))
+		p = append(p, Custom(t))
+	case Anonymous: // Where(Anonymous (Traversal))
+		g.AddStep("where", t)
 		return g
 	default:
-		fmt.Println("Mismatching types used for Where()")
+		g.argumentType("where", "first", first)
 	}
 
-	if len(extra) > 0 {
-		for _, v := range extra {
-			g = g.append("," + v)
-		}
+	for _, v := range extra {
+		p = append(p, Custom(v))
 	}
 
-	g = g.append(")")
+	g.AddStep("where", p...)
 
 	return g
 }
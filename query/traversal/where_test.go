@@ -21,8 +21,11 @@
 package traversal
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/northwesternmutual/grammes/gremerror"
+
 	. "github.com/smartystreets/goconvey/convey"
 )
 
@@ -44,10 +47,18 @@ func TestWhere(t *testing.T) {
 			})
 		})
 
-		Convey("When 'Where' is called with just first argument ) String {", func() {
-			result := g.Where(NewTraversal().Label().Raw())
-			Convey("Then result should equal 'g.where(label())'", func() {
-				So(result.String(), ShouldEqual, "g.where(label())")
+		Convey("When 'Where' is called with an anonymous traversal", func() {
+			result := g.Where(NewAnonymousTraversal().Label())
+			Convey("Then result should equal 'g.where(__.label())'", func() {
+				So(result.String(), ShouldEqual, "g.where(__.label())")
+			})
+		})
+
+		Convey("When 'Where' is called with a traversal that has a source", func() {
+			result := g.Where(NewTraversal().V())
+			Convey("Then the result should have a build error", func() {
+				So(result.String(), ShouldEqual, "g.where()")
+				So(errors.Is(result.Err(), gremerror.ErrArgumentType), ShouldBeTrue)
 			})
 		})
 
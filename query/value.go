@@ -0,0 +1,114 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package query
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Literal returns the value as a Groovy literal, written the
+// way traversals write the arguments of their steps. Strings
+// are quoted, times are dates, nil is null, and slices and
+// maps are Groovy lists and maps. Values with a String method,
+// such as predicates, are written with it and other values as
+// Go formats them.
+func Literal(v interface{}) string {
+	var b strings.Builder
+	writeLiteral(&b, v)
+	return b.String()
+}
+
+func writeLiteral(b *strings.Builder, v interface{}) {
+	switch t := v.(type) {
+	case nil:
+		b.WriteString("null")
+		return
+	case string:
+		b.WriteString(Quote(t))
+		return
+	case time.Time:
+		fmt.Fprintf(b, "new Date(%dL)", t.UnixNano()/int64(time.Millisecond))
+		return
+	case fmt.Stringer:
+		b.WriteString(t.String())
+		return
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		b.WriteByte('[')
+		for i := 0; i < rv.Len(); i++ {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			writeLiteral(b, rv.Index(i).Interface())
+		}
+		b.WriteByte(']')
+	case reflect.Map:
+		writeMapLiteral(b, rv)
+	default:
+		fmt.Fprintf(b, "%v", v)
+	}
+}
+
+// writeMapLiteral writes the map with its keys in order. Keys
+// that aren't strings, such as T.label, are put in parentheses
+// so Groovy reads them as values instead of as the names of
+// the keys.
+func writeMapLiteral(b *strings.Builder, m reflect.Value) {
+	if m.Len() == 0 {
+		b.WriteString("[:]")
+		return
+	}
+
+	keys := m.MapKeys()
+	entries := make(map[string]reflect.Value, len(keys))
+	order := make([]string, 0, len(keys))
+	for _, k := range keys {
+		key := MapKey(k.Interface())
+		entries[key] = m.MapIndex(k)
+		order = append(order, key)
+	}
+	sort.Strings(order)
+
+	b.WriteByte('[')
+	for i, key := range order {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(key + ":")
+		writeLiteral(b, entries[key].Interface())
+	}
+	b.WriteByte(']')
+}
+
+// MapKey returns the key as it's written in a Groovy map literal.
+func MapKey(k interface{}) string {
+	if s, ok := k.(string); ok {
+		return Quote(s)
+	}
+	return "(" + Literal(k) + ")"
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package query
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestLiteral(t *testing.T) {
+	Convey("Given values of every kind", t, func() {
+		Convey("When Literal is called", func() {
+			Convey("Then each should be a Groovy literal", func() {
+				So(Literal(nil), ShouldEqual, "null")
+				So(Literal("a\"b"), ShouldEqual, `"a\"b"`)
+				So(Literal(1.5), ShouldEqual, "1.5")
+				So(Literal(true), ShouldEqual, "true")
+				So(Literal(time.Unix(1, 0)), ShouldEqual, "new Date(1000L)")
+				So(Literal([]string{"a", "b"}), ShouldEqual, `["a","b"]`)
+				So(Literal([]interface{}{1, nil}), ShouldEqual, "[1,null]")
+				So(Literal(map[string]int{"b": 2, "a": 1}), ShouldEqual, `["a":1,"b":2]`)
+				So(Literal(map[int]string{1: "x"}), ShouldEqual, `[(1):"x"]`)
+				So(Literal(map[string]int{}), ShouldEqual, "[:]")
+			})
+		})
+	})
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quick
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes"
+	"github.com/northwesternmutual/grammes/logging"
+	"github.com/northwesternmutual/grammes/manager"
+)
+
+func TestExecuteBatch(t *testing.T) {
+	defer func() {
+		client = nil
+	}()
+	dialer := &mockDialer{}
+	client, _ = grammes.Dial(dialer)
+	execute := func(string, map[string]string, map[string]string) ([][]byte, error) {
+		return [][]byte{[]byte(`[1,2]`)}, nil
+	}
+	client.GraphManager = manager.NewGraphManager(dialer, logging.NewNilLogger(), execute)
+	Convey("Given a host string and batch", t, func() {
+		host := "testhost"
+		b := grammes.NewBatch()
+		a := b.AddVertex("person")
+		c := b.AddVertex("person")
+		Convey("When ExecuteBatch is called", func() {
+			report, err := ExecuteBatch(host, b)
+			Convey("Then the IDs should be reported", func() {
+				So(err, ShouldBeNil)
+				So(report.ID(a), ShouldEqual, 1)
+				So(report.ID(c), ShouldEqual, 2)
+			})
+		})
+	})
+}
+
+func TestExecuteBatchClientError(t *testing.T) {
+	tempcheckForClient := checkForClient
+	defer func() {
+		checkForClient = tempcheckForClient
+	}()
+	checkForClient = func(string) error { return errors.New("ERROR") }
+	Convey("Given a host string and batch", t, func() {
+		host := "testhost"
+		Convey("When ExecuteBatch is called and encounters an error checking for the client", func() {
+			_, err := ExecuteBatch(host, grammes.NewBatch())
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quick
+
+import (
+	"time"
+
+	"github.com/northwesternmutual/grammes/query/consistency"
+	"github.com/northwesternmutual/grammes/query/graph"
+)
+
+// AddConnection allows edges with the edge label to connect the
+// vertex labels on the graph that is associated with the given host.
+func AddConnection(host, edgeLabel, outVertexLabel, inVertexLabel string) error {
+	err := checkForClient(host)
+	if err != nil {
+		return err
+	}
+
+	sq := client.GraphManager.SchemaQuerier()
+	return sq.AddConnection(edgeLabel, outVertexLabel, inVertexLabel)
+}
+
+// AddProperties allows vertices with the label to have the property
+// keys on the graph that is associated with the given host.
+func AddProperties(host, vertexLabel string, keys ...string) error {
+	err := checkForClient(host)
+	if err != nil {
+		return err
+	}
+
+	sq := client.GraphManager.SchemaQuerier()
+	return sq.AddProperties(vertexLabel, keys...)
+}
+
+// AddEdgeProperties allows edges with the label to have the property
+// keys on the graph that is associated with the given host.
+func AddEdgeProperties(host, edgeLabel string, keys ...string) error {
+	err := checkForClient(host)
+	if err != nil {
+		return err
+	}
+
+	sq := client.GraphManager.SchemaQuerier()
+	return sq.AddEdgeProperties(edgeLabel, keys...)
+}
+
+// SetTTL sets how long elements with the schema element live
+// on the graph that is associated with the given host.
+func SetTTL(host string, element graph.String, ttl time.Duration) error {
+	err := checkForClient(host)
+	if err != nil {
+		return err
+	}
+
+	sq := client.GraphManager.SchemaQuerier()
+	return sq.SetTTL(element, ttl)
+}
+
+// SetConsistency sets the consistency of the schema element
+// on the graph that is associated with the given host.
+func SetConsistency(host string, element graph.String, modifier consistency.ConsistencyModifier) error {
+	err := checkForClient(host)
+	if err != nil {
+		return err
+	}
+
+	sq := client.GraphManager.SchemaQuerier()
+	return sq.SetConsistency(element, modifier)
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quick
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes"
+	"github.com/northwesternmutual/grammes/logging"
+	"github.com/northwesternmutual/grammes/manager"
+	"github.com/northwesternmutual/grammes/query/consistency"
+	"github.com/northwesternmutual/grammes/query/graph"
+)
+
+func TestSchemaConstraints(t *testing.T) {
+	defer func() {
+		client = nil
+	}()
+	dialer := &mockDialer{}
+	client, _ = grammes.Dial(dialer)
+	execute := func(string, map[string]string, map[string]string) ([][]byte, error) {
+		return nil, nil
+	}
+	client.GraphManager = manager.NewGraphManager(dialer, logging.NewNilLogger(), execute)
+	Convey("Given a host string", t, func() {
+		host := "testhost"
+		Convey("When the schema constraint functions are called", func() {
+			Convey("Then no errors should be thrown", func() {
+				So(AddConnection(host, "worksAt", "person", "company"), ShouldBeNil)
+				So(AddProperties(host, "person", "name"), ShouldBeNil)
+				So(AddEdgeProperties(host, "knows", "since"), ShouldBeNil)
+				So(SetTTL(host, graph.NewManagement().GetEdgeLabel("visits"), time.Hour), ShouldBeNil)
+				So(SetConsistency(host, graph.NewManagement().GetPropertyKey("email"), consistency.Lock), ShouldBeNil)
+			})
+		})
+	})
+}
+
+func TestSchemaConstraintsClientError(t *testing.T) {
+	tempcheckForClient := checkForClient
+	defer func() {
+		checkForClient = tempcheckForClient
+	}()
+	checkForClient = func(string) error { return errors.New("ERROR") }
+	Convey("Given a host string", t, func() {
+		host := "testhost"
+		Convey("When the schema constraint functions encounter an error checking for the client", func() {
+			Convey("Then the error should be returned", func() {
+				So(AddConnection(host, "worksAt", "person", "company"), ShouldNotBeNil)
+				So(AddProperties(host, "person", "name"), ShouldNotBeNil)
+				So(AddEdgeProperties(host, "knows", "since"), ShouldNotBeNil)
+				So(SetTTL(host, graph.NewManagement().GetEdgeLabel("visits"), time.Hour), ShouldNotBeNil)
+				So(SetConsistency(host, graph.NewManagement().GetPropertyKey("email"), consistency.Lock), ShouldNotBeNil)
+			})
+		})
+	})
+}
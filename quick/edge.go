@@ -0,0 +1,172 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quick
+
+import "github.com/northwesternmutual/grammes"
+
+// AddEdge will add an edge with the label and properties
+// provided from the vertex with outID to the vertex with inID.
+func AddEdge(host string, outID, inID interface{}, label string, properties ...interface{}) (grammes.Edge, error) {
+	err := checkForClient(host)
+	if err != nil {
+		return nilEdge, err
+	}
+
+	eq := client.GraphManager.EdgeQuerier()
+	res, err := eq.AddEdge(outID, inID, label, properties...)
+	if err != nil {
+		return nilEdge, err
+	}
+
+	return res, nil
+}
+
+// EdgeByID will get the edge with the given ID.
+func EdgeByID(host string, id interface{}) (grammes.Edge, error) {
+	err := checkForClient(host)
+	if err != nil {
+		return nilEdge, err
+	}
+
+	eq := client.GraphManager.EdgeQuerier()
+	res, err := eq.EdgeByID(id)
+	if err != nil {
+		return nilEdge, err
+	}
+
+	return res, nil
+}
+
+// EdgesByLabel will return every edge with the given label.
+func EdgesByLabel(host, label string) ([]grammes.Edge, error) {
+	err := checkForClient(host)
+	if err != nil {
+		return nil, err
+	}
+
+	eq := client.GraphManager.EdgeQuerier()
+	res, err := eq.EdgesByLabel(label)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Edges will return the edges with the given
+// label that also have the properties given.
+func Edges(host, label string, properties ...interface{}) ([]grammes.Edge, error) {
+	err := checkForClient(host)
+	if err != nil {
+		return nil, err
+	}
+
+	eq := client.GraphManager.EdgeQuerier()
+	res, err := eq.Edges(label, properties...)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// EdgesBetween will return the edges going in either
+// direction between the vertices with the IDs a and b.
+func EdgesBetween(host string, a, b interface{}, labels ...string) ([]grammes.Edge, error) {
+	err := checkForClient(host)
+	if err != nil {
+		return nil, err
+	}
+
+	eq := client.GraphManager.EdgeQuerier()
+	res, err := eq.EdgesBetween(a, b, labels...)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// EdgeCount retrieves the number of edges
+// that are currently on the graph as an int64.
+func EdgeCount(host string) (int64, error) {
+	err := checkForClient(host)
+	if err != nil {
+		return 0, err
+	}
+
+	eq := client.GraphManager.EdgeQuerier()
+	res, err := eq.EdgeCount()
+	if err != nil {
+		return 0, err
+	}
+
+	return res, nil
+}
+
+// SetEdgeProperty will search the graph for an edge
+// with the given ID and set the properties provided.
+func SetEdgeProperty(host string, id interface{}, properties ...interface{}) error {
+	err := checkForClient(host)
+	if err != nil {
+		return err
+	}
+
+	eq := client.GraphManager.EdgeQuerier()
+	err = eq.SetEdgeProperty(id, properties...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DropEdgeByID will drop the edges with the provided IDs.
+func DropEdgeByID(host string, ids ...interface{}) error {
+	err := checkForClient(host)
+	if err != nil {
+		return err
+	}
+
+	eq := client.GraphManager.EdgeQuerier()
+	err = eq.DropEdgeByID(ids...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DropEdgeLabel will drop every edge with the provided label.
+func DropEdgeLabel(host, label string) error {
+	err := checkForClient(host)
+	if err != nil {
+		return err
+	}
+
+	eq := client.GraphManager.EdgeQuerier()
+	err = eq.DropEdgeLabel(label)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quick
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes"
+	"github.com/northwesternmutual/grammes/logging"
+	"github.com/northwesternmutual/grammes/manager"
+)
+
+var edgeResponse = `
+[
+	{
+		"@type": "g:Edge",
+		"@value": {
+			"id": 1234,
+			"label": "friendsWith",
+			"inV": 53288,
+			"outV": 45280
+		}
+	}
+]
+`
+
+func TestEdgeQueries(t *testing.T) {
+	defer func() {
+		client = nil
+	}()
+	dialer := &mockDialer{}
+	client, _ = grammes.Dial(dialer)
+	execute := func(string, map[string]string, map[string]string) ([][]byte, error) {
+		return [][]byte{[]byte(edgeResponse)}, nil
+	}
+	client.GraphManager = manager.NewGraphManager(dialer, logging.NewNilLogger(), execute)
+	Convey("Given a host string", t, func() {
+		host := "testhost"
+		Convey("When AddEdge is called", func() {
+			e, err := AddEdge(host, 1, 2, "friendsWith")
+			Convey("Then the added edge should be returned", func() {
+				So(err, ShouldBeNil)
+				So(e.Label(), ShouldEqual, "friendsWith")
+			})
+		})
+		Convey("When EdgeByID is called", func() {
+			_, err := EdgeByID(host, 1234)
+			Convey("Then no errors should be thrown", func() {
+				So(err, ShouldBeNil)
+			})
+		})
+		Convey("When the edge getters are called", func() {
+			byLabel, labelErr := EdgesByLabel(host, "friendsWith")
+			edges, edgesErr := Edges(host, "friendsWith", "since", 2018)
+			between, betweenErr := EdgesBetween(host, 1, 2)
+			Convey("Then the edges should be returned", func() {
+				So(labelErr, ShouldBeNil)
+				So(edgesErr, ShouldBeNil)
+				So(betweenErr, ShouldBeNil)
+				So(byLabel, ShouldHaveLength, 1)
+				So(edges, ShouldHaveLength, 1)
+				So(between, ShouldHaveLength, 1)
+			})
+		})
+		Convey("When the edge mutations are called", func() {
+			Convey("Then no errors should be thrown", func() {
+				So(SetEdgeProperty(host, 1234, "since", 2018), ShouldBeNil)
+				So(DropEdgeByID(host, 1234), ShouldBeNil)
+				So(DropEdgeLabel(host, "friendsWith"), ShouldBeNil)
+			})
+		})
+	})
+}
+
+func TestEdgeCount(t *testing.T) {
+	defer func() {
+		client = nil
+	}()
+	dialer := &mockDialer{}
+	client, _ = grammes.Dial(dialer)
+	execute := func(string, map[string]string, map[string]string) ([][]byte, error) {
+		return [][]byte{[]byte(idResponse)}, nil
+	}
+	client.GraphManager = manager.NewGraphManager(dialer, logging.NewNilLogger(), execute)
+	Convey("Given a host string", t, func() {
+		host := "testhost"
+		Convey("When EdgeCount is called", func() {
+			_, err := EdgeCount(host)
+			Convey("Then no errors should be thrown", func() {
+				So(err, ShouldBeNil)
+			})
+		})
+	})
+}
+
+func TestEdgeQueryError(t *testing.T) {
+	defer func() {
+		client = nil
+	}()
+	dialer := &mockDialer{}
+	client, _ = grammes.Dial(dialer)
+	execute := func(string, map[string]string, map[string]string) ([][]byte, error) {
+		return nil, errors.New("ERROR")
+	}
+	client.GraphManager = manager.NewGraphManager(dialer, logging.NewNilLogger(), execute)
+	Convey("Given a host string", t, func() {
+		host := "testhost"
+		Convey("When the edge functions encounter a querying error", func() {
+			_, addErr := AddEdge(host, 1, 2, "friendsWith")
+			_, idErr := EdgeByID(host, 1234)
+			_, labelErr := EdgesByLabel(host, "friendsWith")
+			_, edgesErr := Edges(host, "friendsWith")
+			_, betweenErr := EdgesBetween(host, 1, 2)
+			_, countErr := EdgeCount(host)
+			Convey("Then the errors should be returned", func() {
+				So(addErr, ShouldNotBeNil)
+				So(idErr, ShouldNotBeNil)
+				So(labelErr, ShouldNotBeNil)
+				So(edgesErr, ShouldNotBeNil)
+				So(betweenErr, ShouldNotBeNil)
+				So(countErr, ShouldNotBeNil)
+				So(SetEdgeProperty(host, 1234), ShouldNotBeNil)
+				So(DropEdgeByID(host, 1234), ShouldNotBeNil)
+				So(DropEdgeLabel(host, "friendsWith"), ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestEdgeClientError(t *testing.T) {
+	tempcheckForClient := checkForClient
+	defer func() {
+		checkForClient = tempcheckForClient
+	}()
+	checkForClient = func(string) error { return errors.New("ERROR") }
+	Convey("Given a host string", t, func() {
+		host := "testhost"
+		Convey("When the edge functions encounter an error checking for the client", func() {
+			_, addErr := AddEdge(host, 1, 2, "friendsWith")
+			_, idErr := EdgeByID(host, 1234)
+			_, labelErr := EdgesByLabel(host, "friendsWith")
+			_, edgesErr := Edges(host, "friendsWith")
+			_, betweenErr := EdgesBetween(host, 1, 2)
+			_, countErr := EdgeCount(host)
+			Convey("Then the errors should be returned", func() {
+				So(addErr, ShouldNotBeNil)
+				So(idErr, ShouldNotBeNil)
+				So(labelErr, ShouldNotBeNil)
+				So(edgesErr, ShouldNotBeNil)
+				So(betweenErr, ShouldNotBeNil)
+				So(countErr, ShouldNotBeNil)
+				So(SetEdgeProperty(host, 1234), ShouldNotBeNil)
+				So(DropEdgeByID(host, 1234), ShouldNotBeNil)
+				So(DropEdgeLabel(host, "friendsWith"), ShouldNotBeNil)
+			})
+		})
+	})
+}
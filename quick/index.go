@@ -0,0 +1,99 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quick
+
+import (
+	"time"
+
+	"github.com/northwesternmutual/grammes"
+	"github.com/northwesternmutual/grammes/query/schemaaction"
+	"github.com/northwesternmutual/grammes/query/schemastatus"
+)
+
+// AddIndex builds the composite, mixed, or vertex-centric
+// index on the graph that is associated with the given host.
+func AddIndex(host string, index grammes.IndexSchema) error {
+	err := checkForClient(host)
+	if err != nil {
+		return err
+	}
+
+	sq := client.GraphManager.SchemaQuerier()
+	return sq.AddIndex(index)
+}
+
+// UpdateIndex performs the action on the graph index
+// of the graph that is associated with the given host.
+func UpdateIndex(host, name string, action schemaaction.SchemaAction) error {
+	err := checkForClient(host)
+	if err != nil {
+		return err
+	}
+
+	sq := client.GraphManager.SchemaQuerier()
+	return sq.UpdateIndex(name, action)
+}
+
+// UpdateRelationIndex performs the action on the vertex-centric
+// index of the graph that is associated with the given host.
+func UpdateRelationIndex(host, relationType, name string, action schemaaction.SchemaAction) error {
+	err := checkForClient(host)
+	if err != nil {
+		return err
+	}
+
+	sq := client.GraphManager.SchemaQuerier()
+	return sq.UpdateRelationIndex(relationType, name, action)
+}
+
+// AwaitIndexStatus waits for the graph index of the graph that
+// is associated with the given host to reach the status.
+func AwaitIndexStatus(host, name string, status schemastatus.SchemaStatus, timeout time.Duration) (grammes.IndexStatusReport, error) {
+	err := checkForClient(host)
+	if err != nil {
+		return grammes.IndexStatusReport{}, err
+	}
+
+	sq := client.GraphManager.SchemaQuerier()
+	res, err := sq.AwaitIndexStatus(name, status, timeout)
+	if err != nil {
+		return grammes.IndexStatusReport{}, err
+	}
+
+	return res, nil
+}
+
+// AwaitRelationIndexStatus waits for the vertex-centric index of the
+// graph that is associated with the given host to reach the status.
+func AwaitRelationIndexStatus(host, name, relationType string, status schemastatus.SchemaStatus, timeout time.Duration) (grammes.IndexStatusReport, error) {
+	err := checkForClient(host)
+	if err != nil {
+		return grammes.IndexStatusReport{}, err
+	}
+
+	sq := client.GraphManager.SchemaQuerier()
+	res, err := sq.AwaitRelationIndexStatus(name, relationType, status, timeout)
+	if err != nil {
+		return grammes.IndexStatusReport{}, err
+	}
+
+	return res, nil
+}
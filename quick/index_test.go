@@ -0,0 +1,173 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quick
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes"
+	"github.com/northwesternmutual/grammes/logging"
+	"github.com/northwesternmutual/grammes/manager"
+	"github.com/northwesternmutual/grammes/query/schemaaction"
+	"github.com/northwesternmutual/grammes/query/schemastatus"
+)
+
+func TestAddIndex(t *testing.T) {
+	defer func() {
+		client = nil
+	}()
+	dialer := &mockDialer{}
+	client, _ = grammes.Dial(dialer)
+	execute := func(string, map[string]string, map[string]string) ([][]byte, error) {
+		return nil, nil
+	}
+	client.GraphManager = manager.NewGraphManager(dialer, logging.NewNilLogger(), execute)
+	Convey("Given a host string and index", t, func() {
+		host := "testhost"
+		index := grammes.IndexSchema{Name: "byName", Keys: []grammes.IndexKeySchema{{Name: "name"}}}
+		Convey("When AddIndex is called", func() {
+			err := AddIndex(host, index)
+			Convey("Then no errors should be thrown", func() {
+				So(err, ShouldBeNil)
+			})
+		})
+	})
+}
+
+func TestAddIndexClientError(t *testing.T) {
+	tempcheckForClient := checkForClient
+	defer func() {
+		checkForClient = tempcheckForClient
+	}()
+	checkForClient = func(string) error { return errors.New("ERROR") }
+	Convey("Given a host string and index", t, func() {
+		host := "testhost"
+		Convey("When AddIndex is called and encounters an error checking for the client", func() {
+			err := AddIndex(host, grammes.IndexSchema{})
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestUpdateIndex(t *testing.T) {
+	defer func() {
+		client = nil
+	}()
+	dialer := &mockDialer{}
+	client, _ = grammes.Dial(dialer)
+	execute := func(string, map[string]string, map[string]string) ([][]byte, error) {
+		return nil, nil
+	}
+	client.GraphManager = manager.NewGraphManager(dialer, logging.NewNilLogger(), execute)
+	Convey("Given a host string and index name", t, func() {
+		host := "testhost"
+		Convey("When UpdateIndex is called", func() {
+			err := UpdateIndex(host, "byName", schemaaction.Reindex)
+			Convey("Then no errors should be thrown", func() {
+				So(err, ShouldBeNil)
+			})
+		})
+		Convey("When UpdateRelationIndex is called", func() {
+			err := UpdateRelationIndex(host, "knows", "knowsBySince", schemaaction.Reindex)
+			Convey("Then no errors should be thrown", func() {
+				So(err, ShouldBeNil)
+			})
+		})
+	})
+}
+
+func TestUpdateIndexClientError(t *testing.T) {
+	tempcheckForClient := checkForClient
+	defer func() {
+		checkForClient = tempcheckForClient
+	}()
+	checkForClient = func(string) error { return errors.New("ERROR") }
+	Convey("Given a host string and index name", t, func() {
+		host := "testhost"
+		Convey("When UpdateIndex is called and encounters an error checking for the client", func() {
+			err := UpdateIndex(host, "byName", schemaaction.Reindex)
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+		Convey("When UpdateRelationIndex is called and encounters an error checking for the client", func() {
+			err := UpdateRelationIndex(host, "knows", "knowsBySince", schemaaction.Reindex)
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestAwaitIndexStatusQueryError(t *testing.T) {
+	defer func() {
+		client = nil
+	}()
+	dialer := &mockDialer{}
+	client, _ = grammes.Dial(dialer)
+	execute := func(string, map[string]string, map[string]string) ([][]byte, error) {
+		return nil, errors.New("ERROR")
+	}
+	client.GraphManager = manager.NewGraphManager(dialer, logging.NewNilLogger(), execute)
+	Convey("Given a host string and index name", t, func() {
+		host := "testhost"
+		Convey("When AwaitIndexStatus is called and encounters a querying error", func() {
+			_, err := AwaitIndexStatus(host, "byName", schemastatus.Enabled, 0)
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+		Convey("When AwaitRelationIndexStatus is called and encounters a querying error", func() {
+			_, err := AwaitRelationIndexStatus(host, "knowsBySince", "knows", schemastatus.Enabled, 0)
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestAwaitIndexStatusClientError(t *testing.T) {
+	tempcheckForClient := checkForClient
+	defer func() {
+		checkForClient = tempcheckForClient
+	}()
+	checkForClient = func(string) error { return errors.New("ERROR") }
+	Convey("Given a host string and index name", t, func() {
+		host := "testhost"
+		Convey("When AwaitIndexStatus is called and encounters an error checking for the client", func() {
+			_, err := AwaitIndexStatus(host, "byName", schemastatus.Enabled, 0)
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+		Convey("When AwaitRelationIndexStatus is called and encounters an error checking for the client", func() {
+			_, err := AwaitRelationIndexStatus(host, "knowsBySince", "knows", schemastatus.Enabled, 0)
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -20,6 +20,8 @@
 
 package quick
 
+import "github.com/northwesternmutual/grammes"
+
 // DropAll drops everything from the graph.
 func DropAll(host string) error {
 	err := checkForClient(host)
@@ -53,6 +55,23 @@ func SetVertexProperty(host string, id interface{}, properties ...interface{}) e
 	return nil
 }
 
+// ApplyPatch will apply the property changes
+// of a patch to the vertex with the given ID.
+func ApplyPatch(host string, id interface{}, patch grammes.Patch) error {
+	err := checkForClient(host)
+	if err != nil {
+		return err
+	}
+
+	mq := client.GraphManager.MiscQuerier()
+	err = mq.ApplyPatch(id, patch)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // VertexCount retrieves the number of vertices
 // that are currently on the graph as an int64.
 func VertexCount(host string) (int64, error) {
@@ -210,3 +210,42 @@ func TestVertexCountQueryError(t *testing.T) {
 		})
 	})
 }
+
+func TestApplyPatch(t *testing.T) {
+	defer func() {
+		client = nil
+	}()
+	dialer := &mockDialer{}
+	client, _ = grammes.Dial(dialer)
+	execute := func(string, map[string]string, map[string]string) ([][]byte, error) {
+		return [][]byte{[]byte(vertexResponse)}, nil
+	}
+	client.GraphManager = manager.NewGraphManager(dialer, logging.NewNilLogger(), execute)
+	Convey("Given a host string, ID and patch", t, func() {
+		host := "testhost"
+		patch := grammes.Diff(grammes.NewVertex("person"), grammes.NewVertex("person", "name", "damien"))
+		Convey("When ApplyPatch is called", func() {
+			err := ApplyPatch(host, 1234, patch)
+			Convey("Then no errors should be thrown", func() {
+				So(err, ShouldBeNil)
+			})
+		})
+	})
+}
+
+func TestApplyPatchClientError(t *testing.T) {
+	tempcheckForClient := checkForClient
+	defer func() {
+		checkForClient = tempcheckForClient
+	}()
+	checkForClient = func(string) error { return errors.New("ERROR") }
+	Convey("Given a host string, ID and patch", t, func() {
+		host := "testhost"
+		Convey("When ApplyPatch is called and encounters an error checking for the client", func() {
+			err := ApplyPatch(host, 1234, grammes.Patch{})
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -21,9 +21,13 @@
 package quick
 
 import (
+	"io"
+
+	"github.com/northwesternmutual/grammes"
 	"github.com/northwesternmutual/grammes/query/cardinality"
 	"github.com/northwesternmutual/grammes/query/datatype"
 	"github.com/northwesternmutual/grammes/query/multiplicity"
+	"github.com/northwesternmutual/grammes/schema"
 )
 
 // AddEdgeLabel adds the edge label to the
@@ -97,3 +101,49 @@ func CommitSchema(host string) ([][]byte, error) {
 
 	return res, nil
 }
+
+// DescribeSchema will read the current schema back
+// from the graph that is associated with the given host.
+func DescribeSchema(host string) (grammes.Schema, error) {
+	err := checkForClient(host)
+	if err != nil {
+		return grammes.Schema{}, err
+	}
+
+	sq := client.GraphManager.SchemaQuerier()
+	res, err := sq.DescribeSchema()
+	if err != nil {
+		return grammes.Schema{}, err
+	}
+
+	return res, nil
+}
+
+// ExportSchema writes the schema of the graph that is associated
+// with the given host as a JSON or YAML document.
+func ExportSchema(host string, w io.Writer, format schema.Format) error {
+	err := checkForClient(host)
+	if err != nil {
+		return err
+	}
+
+	sq := client.GraphManager.SchemaQuerier()
+	return sq.ExportSchema(w, format)
+}
+
+// ImportSchema creates whatever is missing from the schema document
+// on the graph that is associated with the given host.
+func ImportSchema(host string, r io.Reader) (schema.Changes, error) {
+	err := checkForClient(host)
+	if err != nil {
+		return schema.Changes{}, err
+	}
+
+	sq := client.GraphManager.SchemaQuerier()
+	res, err := sq.ImportSchema(r)
+	if err != nil {
+		return schema.Changes{}, err
+	}
+
+	return res, nil
+}
@@ -21,7 +21,9 @@
 package quick
 
 import (
+	"bytes"
 	"errors"
+	"strings"
 	"testing"
 
 	. "github.com/smartystreets/goconvey/convey"
@@ -32,6 +34,7 @@ import (
 	"github.com/northwesternmutual/grammes/query/cardinality"
 	"github.com/northwesternmutual/grammes/query/datatype"
 	"github.com/northwesternmutual/grammes/query/multiplicity"
+	"github.com/northwesternmutual/grammes/schema"
 )
 
 func TestAddEdgeLabel(t *testing.T) {
@@ -290,3 +293,94 @@ func TestCommitSchemaQueryError(t *testing.T) {
 		})
 	})
 }
+
+func TestDescribeSchemaClientError(t *testing.T) {
+	tempcheckForClient := checkForClient
+	defer func() {
+		checkForClient = tempcheckForClient
+	}()
+	checkForClient = func(string) error { return errors.New("ERROR") }
+	Convey("Given a host string", t, func() {
+		host := "testhost"
+		Convey("When DescribeSchema is called and encounters an error checking for the client", func() {
+			_, err := DescribeSchema(host)
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestDescribeSchemaQueryError(t *testing.T) {
+	defer func() {
+		client = nil
+	}()
+	dialer := &mockDialer{}
+	client, _ = grammes.Dial(dialer)
+	execute := func(string, map[string]string, map[string]string) ([][]byte, error) {
+		return nil, errors.New("ERROR")
+	}
+	client.GraphManager = manager.NewGraphManager(dialer, logging.NewNilLogger(), execute)
+	Convey("Given a host string", t, func() {
+		host := "testhost"
+		Convey("When DescribeSchema is called and encounters a querying error", func() {
+			_, err := DescribeSchema(host)
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestImportSchema(t *testing.T) {
+	defer func() {
+		client = nil
+	}()
+	dialer := &mockDialer{}
+	client, _ = grammes.Dial(dialer)
+	execute := func(string, map[string]string, map[string]string) ([][]byte, error) {
+		return [][]byte{[]byte(`{"@type":"g:List","@value":[{"@type":"g:Map","@value":[]}]}`)}, nil
+	}
+	client.GraphManager = manager.NewGraphManager(dialer, logging.NewNilLogger(), execute)
+	Convey("Given a host string and schema document", t, func() {
+		host := "testhost"
+		doc := "vertexLabels:\n- name: person\n"
+		Convey("When ImportSchema is called", func() {
+			changes, err := ImportSchema(host, strings.NewReader(doc))
+			Convey("Then the missing vertex label should be created", func() {
+				So(err, ShouldBeNil)
+				So(changes.VertexLabels, ShouldHaveLength, 1)
+			})
+		})
+		Convey("When ExportSchema is called", func() {
+			var buf bytes.Buffer
+			err := ExportSchema(host, &buf, schema.JSON)
+			Convey("Then no errors should be thrown", func() {
+				So(err, ShouldBeNil)
+			})
+		})
+	})
+}
+
+func TestImportSchemaClientError(t *testing.T) {
+	tempcheckForClient := checkForClient
+	defer func() {
+		checkForClient = tempcheckForClient
+	}()
+	checkForClient = func(string) error { return errors.New("ERROR") }
+	Convey("Given a host string", t, func() {
+		host := "testhost"
+		Convey("When ImportSchema is called and encounters an error checking for the client", func() {
+			_, err := ImportSchema(host, strings.NewReader(""))
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+		Convey("When ExportSchema is called and encounters an error checking for the client", func() {
+			err := ExportSchema(host, &bytes.Buffer{}, schema.YAML)
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quick
+
+import "github.com/northwesternmutual/grammes"
+
+// UpsertVertex will get the vertex with the label that has the
+// match properties or create it when there is none. The set
+// properties are given to the vertex in both cases.
+func UpsertVertex(host, label string, match, set map[string]interface{}) (grammes.Vertex, bool, error) {
+	err := checkForClient(host)
+	if err != nil {
+		return nilVertex, false, err
+	}
+
+	uq := client.GraphManager.UpsertQuerier()
+	res, created, err := uq.UpsertVertex(label, match, set)
+	if err != nil {
+		return nilVertex, false, err
+	}
+
+	return res, created, nil
+}
+
+// UpsertEdge will get the edge with the label going from outID to
+// inID that has the match properties or create it when there is
+// none. The set properties are given to the edge in both cases.
+func UpsertEdge(host string, outID, inID interface{}, label string, match, set map[string]interface{}) (grammes.Edge, bool, error) {
+	err := checkForClient(host)
+	if err != nil {
+		return nilEdge, false, err
+	}
+
+	uq := client.GraphManager.UpsertQuerier()
+	res, created, err := uq.UpsertEdge(outID, inID, label, match, set)
+	if err != nil {
+		return nilEdge, false, err
+	}
+
+	return res, created, nil
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quick
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes"
+	"github.com/northwesternmutual/grammes/logging"
+	"github.com/northwesternmutual/grammes/manager"
+)
+
+func TestUpsert(t *testing.T) {
+	defer func() {
+		client = nil
+	}()
+	dialer := &mockDialer{}
+	client, _ = grammes.Dial(dialer)
+	execute := func(string, map[string]string, map[string]string) ([][]byte, error) {
+		return [][]byte{[]byte(`[{"element": {"@type": "g:Vertex", "@value": {"id": 1, "label": "person"}}, "created": true}]`)}, nil
+	}
+	client.GraphManager = manager.NewGraphManager(dialer, logging.NewNilLogger(), execute)
+	Convey("Given a host string", t, func() {
+		host := "testhost"
+		Convey("When UpsertVertex is called", func() {
+			_, created, err := UpsertVertex(host, "person", map[string]interface{}{"name": "damien"}, nil)
+			Convey("Then whether the vertex was created should be returned", func() {
+				So(err, ShouldBeNil)
+				So(created, ShouldBeTrue)
+			})
+		})
+		Convey("When UpsertEdge is called", func() {
+			_, created, err := UpsertEdge(host, 1, 2, "knows", nil, nil)
+			Convey("Then whether the edge was created should be returned", func() {
+				So(err, ShouldBeNil)
+				So(created, ShouldBeTrue)
+			})
+		})
+	})
+}
+
+func TestUpsertQueryError(t *testing.T) {
+	defer func() {
+		client = nil
+	}()
+	dialer := &mockDialer{}
+	client, _ = grammes.Dial(dialer)
+	execute := func(string, map[string]string, map[string]string) ([][]byte, error) {
+		return nil, errors.New("ERROR")
+	}
+	client.GraphManager = manager.NewGraphManager(dialer, logging.NewNilLogger(), execute)
+	Convey("Given a host string", t, func() {
+		host := "testhost"
+		Convey("When the upserts encounter a querying error", func() {
+			_, _, vertexErr := UpsertVertex(host, "person", nil, nil)
+			_, _, edgeErr := UpsertEdge(host, 1, 2, "knows", nil, nil)
+			Convey("Then the errors should be returned", func() {
+				So(vertexErr, ShouldNotBeNil)
+				So(edgeErr, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestUpsertClientError(t *testing.T) {
+	tempcheckForClient := checkForClient
+	defer func() {
+		checkForClient = tempcheckForClient
+	}()
+	checkForClient = func(string) error { return errors.New("ERROR") }
+	Convey("Given a host string", t, func() {
+		host := "testhost"
+		Convey("When the upserts encounter an error checking for the client", func() {
+			_, _, vertexErr := UpsertVertex(host, "person", nil, nil)
+			_, _, edgeErr := UpsertEdge(host, 1, 2, "knows", nil, nil)
+			Convey("Then the errors should be returned", func() {
+				So(vertexErr, ShouldNotBeNil)
+				So(edgeErr, ShouldNotBeNil)
+			})
+		})
+	})
+}
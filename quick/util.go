@@ -49,8 +49,11 @@ var (
 	// nilVertex is used for returning nothing in
 	// a vertex related function.
 	nilVertex = grammes.Vertex{}
-	logger    logging.Logger
-	client    *grammes.Client
+	// nilEdge is used for returning nothing in
+	// an edge related function.
+	nilEdge = grammes.Edge{}
+	logger  logging.Logger
+	client  *grammes.Client
 )
 
 // executeQuery is used as a backend for all the functions
@@ -0,0 +1,136 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schema
+
+import (
+	"time"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/model"
+	__ "github.com/northwesternmutual/grammes/query/anonymous"
+	"github.com/northwesternmutual/grammes/query/traversal"
+)
+
+// patch for mocking test values
+var now = time.Now
+
+// Apply creates whatever is missing from the schema of the
+// migration in a single management transaction, then records
+// the version of the migration in the graph if it hasn't been
+// already. A migration without a version isn't recorded.
+// Nothing is changed when the desired schema conflicts with
+// the graph. The planned changes are returned.
+func Apply(q Querier, m Migration) (Changes, error) {
+	current, err := q.DescribeSchema()
+	if err != nil {
+		return Changes{}, gremerror.NewGrammesError("Apply", err)
+	}
+
+	desired := m.Schema
+	if m.Version != "" {
+		desired = withMigrationSchema(desired)
+	}
+
+	changes := Plan(current, desired)
+	if err = changes.Err(); err != nil {
+		return changes, err
+	}
+
+	var recorded bool
+	if m.Version == "" {
+		recorded = true
+	} else if _, ok := current.VertexLabel(MigrationLabel); ok {
+		if recorded, err = IsApplied(q, m.Version); err != nil {
+			return changes, err
+		}
+	}
+
+	if changes.IsEmpty() && recorded {
+		return changes, nil
+	}
+
+	script, err := changes.Script()
+	if err != nil {
+		return changes, gremerror.NewGrammesError("Apply", err)
+	}
+	if !recorded {
+		script += "\n" + recordQuery(m.Version).String()
+	}
+
+	if _, err = q.ExecuteStringQuery(script); err != nil {
+		return changes, gremerror.NewQueryError("Apply", script, err)
+	}
+
+	return changes, nil
+}
+
+// AppliedVersions returns the version of every
+// migration that's been recorded in the graph.
+func AppliedVersions(q Querier) ([]string, error) {
+	query := traversal.NewTraversal().V().HasLabel(MigrationLabel).Values(VersionKey)
+
+	data, err := q.ExecuteStringQuery(query.String())
+	if err != nil {
+		return nil, gremerror.NewQueryError("AppliedVersions", query.String(), err)
+	}
+
+	var versions []string
+	for _, res := range data {
+		decoded, err := model.UnmarshalGraphSON(res)
+		if err != nil {
+			return nil, gremerror.NewUnmarshalError("AppliedVersions", res, err)
+		}
+		list, _ := decoded.([]interface{})
+		for _, v := range list {
+			if s, ok := v.(string); ok {
+				versions = append(versions, s)
+			}
+		}
+	}
+
+	return versions, nil
+}
+
+// IsApplied returns whether the migration
+// version has been recorded in the graph.
+func IsApplied(q Querier, version string) (bool, error) {
+	versions, err := AppliedVersions(q)
+	if err != nil {
+		return false, err
+	}
+	for _, v := range versions {
+		if v == version {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// recordQuery adds the vertex recording the
+// version unless it has already been added.
+func recordQuery(version string) traversal.String {
+	return traversal.NewTraversal().V().Has(MigrationLabel, VersionKey, version).Fold().Coalesce(
+		__.Unfold(),
+		__.AddV(MigrationLabel).
+			Property(VersionKey, version).
+			Property(AppliedAtKey, now().UnixNano()/int64(time.Millisecond)),
+	)
+}
@@ -0,0 +1,166 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schema
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/model"
+	"github.com/northwesternmutual/grammes/query/datatype"
+)
+
+type mockQuerier struct {
+	schema      model.Schema
+	describeErr error
+	executeErr  error
+	versions    string
+	queries     []string
+}
+
+func (m *mockQuerier) DescribeSchema() (model.Schema, error) {
+	return m.schema, m.describeErr
+}
+
+func (m *mockQuerier) ExecuteStringQuery(query string) ([][]byte, error) {
+	m.queries = append(m.queries, query)
+	if m.executeErr != nil {
+		return nil, m.executeErr
+	}
+	if strings.HasPrefix(query, "g.V().hasLabel") {
+		return [][]byte{[]byte(m.versions)}, nil
+	}
+	return nil, nil
+}
+
+func TestApply(t *testing.T) {
+	defer func() {
+		now = time.Now
+	}()
+	now = func() time.Time { return time.Unix(1, 0) }
+
+	desired := model.Schema{
+		PropertyKeys: []model.PropertyKeySchema{{Name: "name", DataType: datatype.String}},
+	}
+
+	Convey("Given a graph without any schema", t, func() {
+		q := &mockQuerier{}
+		Convey("When Apply is called", func() {
+			changes, err := Apply(q, Migration{Version: "1", Schema: desired})
+			Convey("Then the schema and migration should be created in one script", func() {
+				So(err, ShouldBeNil)
+				So(changes.PropertyKeys, ShouldHaveLength, 3)
+				So(changes.VertexLabels, ShouldHaveLength, 1)
+				So(q.queries, ShouldHaveLength, 1)
+				So(q.queries[0], ShouldStartWith, "mgmt = graph.openManagement()\n")
+				So(q.queries[0], ShouldContainSubstring, "mgmt.makePropertyKey(\"name\")")
+				So(q.queries[0], ShouldEndWith, "mgmt.commit()\n"+
+					"g.V().has(\"schemaMigration\",\"schemaMigrationVersion\",\"1\").fold()"+
+					".coalesce(__.unfold(),__.addV(\"schemaMigration\").property(\"schemaMigrationVersion\",\"1\")"+
+					".property(\"schemaMigrationAppliedAt\",1000))")
+			})
+		})
+	})
+
+	Convey("Given a graph the migration has already been applied to", t, func() {
+		q := &mockQuerier{
+			schema:   withMigrationSchema(desired),
+			versions: `{"@type":"g:List","@value":["1"]}`,
+		}
+		Convey("When Apply is called again", func() {
+			changes, err := Apply(q, Migration{Version: "1", Schema: desired})
+			Convey("Then only the applied versions should be read", func() {
+				So(err, ShouldBeNil)
+				So(changes.IsEmpty(), ShouldBeTrue)
+				So(q.queries, ShouldResemble, []string{"g.V().hasLabel(\"schemaMigration\").values(\"schemaMigrationVersion\")"})
+			})
+		})
+		Convey("When Apply is called with a new version", func() {
+			_, err := Apply(q, Migration{Version: "2", Schema: desired})
+			Convey("Then only the version should be recorded", func() {
+				So(err, ShouldBeNil)
+				So(q.queries, ShouldHaveLength, 2)
+				So(q.queries[1], ShouldEqual, "mgmt = graph.openManagement()\nmgmt.commit()\n"+
+					"g.V().has(\"schemaMigration\",\"schemaMigrationVersion\",\"2\").fold()"+
+					".coalesce(__.unfold(),__.addV(\"schemaMigration\").property(\"schemaMigrationVersion\",\"2\")"+
+					".property(\"schemaMigrationAppliedAt\",1000))")
+			})
+		})
+	})
+
+	Convey("Given a graph with a conflicting schema", t, func() {
+		q := &mockQuerier{schema: model.Schema{
+			PropertyKeys: []model.PropertyKeySchema{{Name: "name", DataType: datatype.Long}},
+		}}
+		Convey("When Apply is called", func() {
+			_, err := Apply(q, Migration{Version: "1", Schema: desired})
+			Convey("Then nothing should be changed", func() {
+				So(errors.Is(err, gremerror.ErrSchemaConflict), ShouldBeTrue)
+				So(q.queries, ShouldBeEmpty)
+			})
+		})
+	})
+
+	Convey("Given a graph that cannot be reached", t, func() {
+		q := &mockQuerier{describeErr: errors.New("ERROR")}
+		Convey("When Apply is called", func() {
+			_, err := Apply(q, Migration{Version: "1", Schema: desired})
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given a graph that fails to execute the script", t, func() {
+		q := &mockQuerier{executeErr: errors.New("ERROR")}
+		Convey("When Apply is called", func() {
+			_, err := Apply(q, Migration{Version: "1", Schema: desired})
+			Convey("Then the error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestAppliedVersions(t *testing.T) {
+	Convey("Given a graph with recorded migrations", t, func() {
+		q := &mockQuerier{versions: `{"@type":"g:List","@value":["1","2"]}`}
+		Convey("When AppliedVersions is called", func() {
+			versions, err := AppliedVersions(q)
+			Convey("Then every version should be returned", func() {
+				So(err, ShouldBeNil)
+				So(versions, ShouldResemble, []string{"1", "2"})
+			})
+		})
+		Convey("When IsApplied is called", func() {
+			applied, err := IsApplied(q, "3")
+			Convey("Then it should report whether the version was recorded", func() {
+				So(err, ShouldBeNil)
+				So(applied, ShouldBeFalse)
+			})
+		})
+	})
+}
@@ -0,0 +1,390 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schema
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/model"
+	"github.com/northwesternmutual/grammes/query/cardinality"
+	"github.com/northwesternmutual/grammes/query/datatype"
+	"github.com/northwesternmutual/grammes/query/direction"
+	"github.com/northwesternmutual/grammes/query/mapping"
+	"github.com/northwesternmutual/grammes/query/multiplicity"
+	"github.com/northwesternmutual/grammes/query/order"
+)
+
+// TagName is the struct tag read when generating a schema.
+//
+// On the Vertex or Edge marker the tag holds the label
+// followed by options:
+//
+//	static, partitioned                    vertex label options
+//	multiplicity=MULTI, unidirected        edge label options
+//	out=person, in=company                 connection the edge label allows
+//
+// On a field the tag holds the property key followed by options:
+//
+//	datatype=Long                          overrides the inferred data type
+//	cardinality=list                       overrides the inferred cardinality
+//	index=composite|mixed|vertex-centric   indexes the key
+//	indexName=byName                       names the index; keys sharing it are combined
+//	unique                                 makes a composite index unique
+//	indexOnly                              restricts the index to the label
+//	backing=search                         backing index of a mixed index
+//	mapping=TEXT                           mapping of the key in a mixed index
+//	order=desc, direction=OUT              sort order and direction of a vertex-centric index
+const TagName = "grammes"
+
+// defaultBackingIndex is used for mixed
+// indexes that don't name a backing index.
+const defaultBackingIndex = "search"
+
+// goDataTypes maps the names of Go types to data types.
+var goDataTypes = map[string]datatype.DataType{
+	"string":      datatype.String,
+	"bool":        datatype.Boolean,
+	"int8":        datatype.Byte,
+	"int16":       datatype.Short,
+	"uint8":       datatype.Short,
+	"byte":        datatype.Short,
+	"int32":       datatype.Integer,
+	"rune":        datatype.Integer,
+	"uint16":      datatype.Integer,
+	"int":         datatype.Long,
+	"int64":       datatype.Long,
+	"uint":        datatype.Long,
+	"uint32":      datatype.Long,
+	"uint64":      datatype.Long,
+	"float32":     datatype.Float,
+	"float64":     datatype.Double,
+	"time.Time":   datatype.Date,
+	"interface{}": datatype.Object,
+}
+
+// InferDataType returns the data type of a property
+// holding the named Go type, such as "int64" or "time.Time".
+func InferDataType(goType string) (datatype.DataType, bool) {
+	dt, ok := goDataTypes[goType]
+	return dt, ok
+}
+
+// Builder collects vertex labels, edge labels, property keys,
+// indexes, and connections from tagged struct definitions.
+// Element is called for each struct followed by Property
+// for each of its fields.
+type Builder struct {
+	schema model.Schema
+	label  string
+	edge   bool
+}
+
+// NewBuilder returns an empty Builder.
+func NewBuilder() *Builder {
+	return &Builder{}
+}
+
+// Element starts a new vertex or edge label from the struct with
+// the given type name and the tag of its Vertex or Edge marker.
+// When the tag doesn't name the label the type name is used with
+// its first letter lowered.
+func (b *Builder) Element(typeName, tag string, edge bool) error {
+	name, opts, err := parseTag(tag)
+	if err != nil {
+		return elementError(typeName, err)
+	}
+	if name == "" {
+		name = lowerFirst(typeName)
+	}
+
+	b.label, b.edge = name, edge
+
+	if !edge {
+		l := model.VertexLabelSchema{Name: name, Static: opts.has("static"), Partitioned: opts.has("partitioned")}
+		if err = opts.only("static", "partitioned"); err != nil {
+			return elementError(typeName, err)
+		}
+		if existing, ok := b.schema.VertexLabel(name); ok {
+			if existing.Static != l.Static || existing.Partitioned != l.Partitioned {
+				return elementError(typeName, fmt.Errorf("vertex label %q is declared twice differently", name))
+			}
+			return nil
+		}
+		b.schema.VertexLabels = append(b.schema.VertexLabels, l)
+		return nil
+	}
+
+	if err = opts.only("multiplicity", "unidirected", "out", "in"); err != nil {
+		return elementError(typeName, err)
+	}
+	l := model.EdgeLabelSchema{
+		Name:         name,
+		Multiplicity: multiplicity.Multiplicity(strings.ToUpper(opts["multiplicity"])),
+		Directed:     !opts.has("unidirected"),
+		Unidirected:  opts.has("unidirected"),
+	}
+	if existing, ok := b.schema.EdgeLabel(name); ok {
+		if existing.Multiplicity != l.Multiplicity || existing.Unidirected != l.Unidirected {
+			return elementError(typeName, fmt.Errorf("edge label %q is declared twice differently", name))
+		}
+	} else {
+		b.schema.EdgeLabels = append(b.schema.EdgeLabels, l)
+	}
+
+	out, in := opts["out"], opts["in"]
+	if (out == "") != (in == "") {
+		return elementError(typeName, fmt.Errorf("%w: both out and in are needed for a connection", gremerror.ErrInvalidTag))
+	}
+	if out != "" && !b.schema.HasConnection(name, out, in) {
+		b.schema.Connections = append(b.schema.Connections, model.ConnectionSchema{EdgeLabel: name, OutVertexLabel: out, InVertexLabel: in})
+	}
+
+	return nil
+}
+
+// Property adds the property key for a field of the current
+// element. The data type and cardinality are what's inferred
+// from the Go type of the field, and are overridden by the tag.
+// Fields tagged with "-" are skipped.
+func (b *Builder) Property(fieldName, tag string, dt datatype.DataType, card cardinality.Cardinality) error {
+	if tag == "-" {
+		return nil
+	}
+	name, opts, err := parseTag(tag)
+	if err != nil {
+		return propertyError(fieldName, err)
+	}
+	if name == "" {
+		name = lowerFirst(fieldName)
+	}
+	if err = opts.only("datatype", "cardinality", "index", "indexName", "unique",
+		"indexOnly", "backing", "mapping", "order", "direction"); err != nil {
+		return propertyError(fieldName, err)
+	}
+
+	if v, ok := opts["datatype"]; ok {
+		if !strings.HasSuffix(v, ".class") {
+			v += ".class"
+		}
+		dt = datatype.DataType(v)
+	}
+	if dt == "" {
+		return propertyError(fieldName, gremerror.ErrUnsupportedType)
+	}
+	if v, ok := opts["cardinality"]; ok {
+		card = cardinality.Cardinality(strings.ToLower(v))
+	}
+	if card == "" {
+		card = cardinality.Single
+	}
+
+	key := model.PropertyKeySchema{Name: name, DataType: dt, Cardinality: card}
+	if existing, ok := b.schema.PropertyKey(name); ok {
+		if existing != key {
+			return propertyError(fieldName, fmt.Errorf("property key %q is declared as %s %s and %s %s",
+				name, existing.DataType, existing.Cardinality, dt, card))
+		}
+	} else {
+		b.schema.PropertyKeys = append(b.schema.PropertyKeys, key)
+	}
+
+	if err = b.index(name, opts); err != nil {
+		return propertyError(fieldName, err)
+	}
+	return nil
+}
+
+// index adds the key to the index described by the options.
+// Indexes of the same name are merged, and a key that's already
+// in the index is only added once.
+func (b *Builder) index(key string, opts tagOptions) error {
+	kind, ok := opts["index"]
+	if !ok {
+		if opts.has("unique") {
+			kind = string(model.CompositeIndex)
+		} else {
+			return nil
+		}
+	}
+
+	index := model.IndexSchema{Type: model.IndexType(kind), Name: opts["indexName"], Unique: opts.has("unique")}
+	switch index.Type {
+	case model.CompositeIndex, model.MixedIndex:
+		index.Element = "Vertex"
+		if b.edge {
+			index.Element = "Edge"
+		}
+		if opts.has("indexOnly") {
+			index.IndexOnly = b.label
+		}
+		if index.Name == "" {
+			index.Name = "by" + upperFirst(key)
+		}
+	case model.VertexCentricIndex:
+		if !b.edge {
+			return fmt.Errorf("%w: vertex-centric indexes are only built on edges", gremerror.ErrInvalidTag)
+		}
+		index.Element = b.label
+		index.Direction = strings.ToUpper(opts["direction"])
+		index.Order = strings.ToLower(opts["order"])
+		if index.Direction == "" {
+			index.Direction = direction.Both.String()
+		}
+		if index.Order == "" {
+			index.Order = order.Asc.String()
+		}
+		if index.Name == "" {
+			index.Name = b.label + "By" + upperFirst(key)
+		}
+	default:
+		return fmt.Errorf("%w: unknown index %q", gremerror.ErrInvalidTag, kind)
+	}
+	if index.Type == model.MixedIndex {
+		index.BackingIndex = opts["backing"]
+		if index.BackingIndex == "" {
+			index.BackingIndex = defaultBackingIndex
+		}
+	}
+
+	indexKey := model.IndexKeySchema{Name: key}
+	if v, ok := opts["mapping"]; ok {
+		indexKey.Mapping = mapping.Mapping("Mapping." + strings.ToUpper(v))
+	}
+
+	for i := range b.schema.Indexes {
+		existing := &b.schema.Indexes[i]
+		if existing.Name != index.Name {
+			continue
+		}
+		if existing.Type != index.Type || existing.Element != index.Element {
+			return fmt.Errorf("%w: index %q is declared as both %s on %s and %s on %s", gremerror.ErrInvalidTag,
+				index.Name, existing.Type, existing.Element, index.Type, index.Element)
+		}
+		if existing.IndexOnly != index.IndexOnly || existing.BackingIndex != index.BackingIndex ||
+			existing.Direction != index.Direction || existing.Order != index.Order {
+			return fmt.Errorf("%w: index %q is declared with different options; name one of them with indexName",
+				gremerror.ErrInvalidTag, index.Name)
+		}
+		existing.Unique = existing.Unique || index.Unique
+		for _, k := range existing.Keys {
+			if k.Name != key {
+				continue
+			}
+			if k != indexKey {
+				return fmt.Errorf("%w: key %q of index %q is declared with mappings %q and %q",
+					gremerror.ErrInvalidTag, key, index.Name, k.Mapping, indexKey.Mapping)
+			}
+			return nil
+		}
+		existing.Keys = append(existing.Keys, indexKey)
+		return nil
+	}
+
+	index.Keys = []model.IndexKeySchema{indexKey}
+	b.schema.Indexes = append(b.schema.Indexes, index)
+	return nil
+}
+
+// Schema returns everything that's been collected.
+func (b *Builder) Schema() model.Schema {
+	return b.schema
+}
+
+// tagOptions are the options following the name in a tag.
+// Flags such as "unique" are stored with an empty value.
+type tagOptions map[string]string
+
+func (o tagOptions) has(name string) bool {
+	_, ok := o[name]
+	return ok
+}
+
+// only returns an error if there are any options
+// other than the ones that are allowed.
+func (o tagOptions) only(allowed ...string) error {
+	for name := range o {
+		var ok bool
+		for _, a := range allowed {
+			if name == a {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return fmt.Errorf("%w: unknown option %q", gremerror.ErrInvalidTag, name)
+		}
+	}
+	return nil
+}
+
+// parseTag splits a tag into its name and options.
+func parseTag(tag string) (string, tagOptions, error) {
+	parts := strings.Split(tag, ",")
+	opts := make(tagOptions)
+	for _, p := range parts[1:] {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		kv := strings.SplitN(p, "=", 2)
+		if _, ok := opts[kv[0]]; ok {
+			return "", nil, fmt.Errorf("%w: option %q is repeated", gremerror.ErrInvalidTag, kv[0])
+		}
+		if len(kv) == 2 {
+			if kv[1] == "" {
+				return "", nil, fmt.Errorf("%w: option %q has no value", gremerror.ErrInvalidTag, kv[0])
+			}
+			opts[kv[0]] = kv[1]
+		} else {
+			opts[kv[0]] = ""
+		}
+	}
+	return strings.TrimSpace(parts[0]), opts, nil
+}
+
+func elementError(typeName string, err error) error {
+	return gremerror.NewGrammesError("Element", fmt.Errorf("type %s: %w", typeName, err))
+}
+
+func propertyError(fieldName string, err error) error {
+	return gremerror.NewGrammesError("Property", fmt.Errorf("field %s: %w", fieldName, err))
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	r := []rune(s)
+	r[0] = unicode.ToLower(r[0])
+	return string(r)
+}
+
+func upperFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	r := []rune(s)
+	r[0] = unicode.ToUpper(r[0])
+	return string(r)
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schema
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/query/cardinality"
+	"github.com/northwesternmutual/grammes/query/datatype"
+)
+
+func TestInferDataType(t *testing.T) {
+	Convey("Given the names of Go types", t, func() {
+		Convey("When InferDataType is called", func() {
+			Convey("Then the matching data type should be returned", func() {
+				dt, ok := InferDataType("int64")
+				So(ok, ShouldBeTrue)
+				So(dt, ShouldEqual, datatype.Long)
+				dt, ok = InferDataType("time.Time")
+				So(ok, ShouldBeTrue)
+				So(dt, ShouldEqual, datatype.Date)
+				_, ok = InferDataType("complex128")
+				So(ok, ShouldBeFalse)
+			})
+		})
+	})
+}
+
+func TestBuilder(t *testing.T) {
+	Convey("Given a Builder", t, func() {
+		b := NewBuilder()
+		Convey("When an element is added without a label", func() {
+			err := b.Element("BlogPost", "", false)
+			Convey("Then the type name should be used", func() {
+				So(err, ShouldBeNil)
+				So(b.Schema().VertexLabels[0].Name, ShouldEqual, "blogPost")
+			})
+		})
+		Convey("When an element has an unknown option", func() {
+			err := b.Element("Person", "person,sharded", false)
+			Convey("Then an invalid tag error should be returned", func() {
+				So(errors.Is(err, gremerror.ErrInvalidTag), ShouldBeTrue)
+			})
+		})
+		Convey("When an edge names only one side of its connection", func() {
+			err := b.Element("Knows", "knows,out=person", true)
+			Convey("Then an invalid tag error should be returned", func() {
+				So(errors.Is(err, gremerror.ErrInvalidTag), ShouldBeTrue)
+			})
+		})
+		Convey("When a vertex property asks for a vertex-centric index", func() {
+			So(b.Element("Person", "person", false), ShouldBeNil)
+			err := b.Property("Name", "name,index=vertex-centric", datatype.String, cardinality.Single)
+			Convey("Then an invalid tag error should be returned", func() {
+				So(errors.Is(err, gremerror.ErrInvalidTag), ShouldBeTrue)
+			})
+		})
+		Convey("When a property repeats an option", func() {
+			So(b.Element("Person", "person", false), ShouldBeNil)
+			err := b.Property("Name", "name,unique,unique", datatype.String, cardinality.Single)
+			Convey("Then an invalid tag error should be returned", func() {
+				So(errors.Is(err, gremerror.ErrInvalidTag), ShouldBeTrue)
+			})
+		})
+	})
+}
@@ -0,0 +1,262 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/model"
+	"github.com/northwesternmutual/grammes/query/cardinality"
+	"github.com/northwesternmutual/grammes/query/datatype"
+	"github.com/northwesternmutual/grammes/query/direction"
+	"github.com/northwesternmutual/grammes/query/mapping"
+	"github.com/northwesternmutual/grammes/query/multiplicity"
+	"github.com/northwesternmutual/grammes/query/order"
+)
+
+// Format is how a schema document is serialized.
+type Format string
+
+const (
+	// JSON serializes the schema as indented JSON.
+	JSON Format = "json"
+	// YAML serializes the schema as YAML.
+	YAML Format = "yaml"
+)
+
+var (
+	dataTypes = enumSet(datatype.String, datatype.Character, datatype.Boolean, datatype.Byte,
+		datatype.Short, datatype.Integer, datatype.Long, datatype.Float, datatype.Double,
+		datatype.Decimal, datatype.Precision, datatype.Geoshape, datatype.Date, datatype.Instant,
+		datatype.UUID, datatype.Object)
+	cardinalities  = enumSet(cardinality.Single, cardinality.List, cardinality.Set)
+	multiplicities = enumSet(multiplicity.Multi, multiplicity.Simple, multiplicity.Many2One,
+		multiplicity.One2Many, multiplicity.One2One, multiplicity.Many2Many)
+	indexTypes = enumSet(model.CompositeIndex, model.MixedIndex, model.VertexCentricIndex)
+	mappings   = enumSet(mapping.Default, mapping.Text, mapping.String, mapping.TextString, mapping.PrefixTree)
+	directions = enumSet(direction.In, direction.Out, direction.Both)
+	orders     = enumSet(order.Asc, order.Desc)
+)
+
+// Encode writes the schema as a document in the format. The
+// document is stable so that the schema of two graphs can be
+// compared with a diff: everything is sorted by name and the
+// status of each index is left out.
+func Encode(w io.Writer, s model.Schema, format Format) error {
+	var (
+		data []byte
+		err  error
+	)
+
+	s = Normalize(s)
+
+	switch format {
+	case JSON:
+		if data, err = json.MarshalIndent(s, "", "  "); err == nil {
+			data = append(data, '\n')
+		}
+	case YAML:
+		data, err = yaml.Marshal(s)
+	default:
+		err = fmt.Errorf("unknown schema format %q", format)
+	}
+	if err != nil {
+		return gremerror.NewGrammesError("Encode", err)
+	}
+
+	_, err = w.Write(data)
+	return err
+}
+
+// Decode reads a schema document written by Encode. Both JSON
+// and YAML documents are accepted. Unknown fields and values
+// outside of the datatype, cardinality, and multiplicity
+// vocabularies are refused.
+func Decode(r io.Reader) (model.Schema, error) {
+	var s model.Schema
+
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return s, gremerror.NewGrammesError("Decode", err)
+	}
+
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		err = dec.Decode(&s)
+	} else {
+		err = yaml.UnmarshalStrict(data, &s)
+	}
+	if err != nil {
+		return s, gremerror.NewUnmarshalError("Decode", data, err)
+	}
+
+	return s, Validate(s)
+}
+
+// Normalize returns a copy of the schema with everything sorted
+// by name and without the status of the index keys, leaving only
+// what defines the schema.
+func Normalize(s model.Schema) model.Schema {
+	var n model.Schema
+
+	n.VertexLabels = append(n.VertexLabels, s.VertexLabels...)
+	for i := range n.VertexLabels {
+		n.VertexLabels[i].Properties = sortedStrings(n.VertexLabels[i].Properties)
+	}
+	sort.Slice(n.VertexLabels, func(i, j int) bool { return n.VertexLabels[i].Name < n.VertexLabels[j].Name })
+
+	n.EdgeLabels = append(n.EdgeLabels, s.EdgeLabels...)
+	for i := range n.EdgeLabels {
+		n.EdgeLabels[i].Properties = sortedStrings(n.EdgeLabels[i].Properties)
+	}
+	sort.Slice(n.EdgeLabels, func(i, j int) bool { return n.EdgeLabels[i].Name < n.EdgeLabels[j].Name })
+
+	n.PropertyKeys = append(n.PropertyKeys, s.PropertyKeys...)
+	sort.Slice(n.PropertyKeys, func(i, j int) bool { return n.PropertyKeys[i].Name < n.PropertyKeys[j].Name })
+
+	for _, index := range s.Indexes {
+		keys := make([]model.IndexKeySchema, 0, len(index.Keys))
+		for _, k := range index.Keys {
+			k.Status = ""
+			keys = append(keys, k)
+		}
+		index.Keys = keys
+		n.Indexes = append(n.Indexes, index)
+	}
+	sort.Slice(n.Indexes, func(i, j int) bool { return n.Indexes[i].Name < n.Indexes[j].Name })
+
+	n.Connections = append(n.Connections, s.Connections...)
+	sort.Slice(n.Connections, func(i, j int) bool {
+		a, b := n.Connections[i], n.Connections[j]
+		if a.EdgeLabel != b.EdgeLabel {
+			return a.EdgeLabel < b.EdgeLabel
+		}
+		if a.OutVertexLabel != b.OutVertexLabel {
+			return a.OutVertexLabel < b.OutVertexLabel
+		}
+		return a.InVertexLabel < b.InVertexLabel
+	})
+
+	return n
+}
+
+// Validate checks that everything in the schema is named,
+// declared once, and only uses known data types, cardinalities,
+// multiplicities, and index options.
+func Validate(s model.Schema) error {
+	var problems []string
+	invalid := func(format string, args ...interface{}) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	names := make(map[string]bool)
+	unique := func(kind, name string) {
+		if name == "" {
+			invalid("%s without a name", kind)
+		} else if names[kind+" "+name] {
+			invalid("%s %q is declared more than once", kind, name)
+		}
+		names[kind+" "+name] = true
+	}
+
+	for _, k := range s.PropertyKeys {
+		unique("property key", k.Name)
+		if k.DataType != "" && !dataTypes[string(k.DataType)] {
+			invalid("property key %q has unknown data type %q", k.Name, k.DataType)
+		}
+		if k.Cardinality != "" && !cardinalities[string(k.Cardinality)] {
+			invalid("property key %q has unknown cardinality %q", k.Name, k.Cardinality)
+		}
+	}
+	for _, l := range s.VertexLabels {
+		unique("vertex label", l.Name)
+	}
+	for _, l := range s.EdgeLabels {
+		unique("edge label", l.Name)
+		if l.Multiplicity != "" && !multiplicities[string(l.Multiplicity)] {
+			invalid("edge label %q has unknown multiplicity %q", l.Name, l.Multiplicity)
+		}
+	}
+	for _, c := range s.Connections {
+		if c.EdgeLabel == "" || c.OutVertexLabel == "" || c.InVertexLabel == "" {
+			invalid("connection %q needs an edge label and both vertex labels", c.EdgeLabel)
+		}
+	}
+	for _, i := range s.Indexes {
+		unique("index", i.Name)
+		if len(i.Keys) == 0 {
+			invalid("index %q has no keys", i.Name)
+		}
+		if i.Type != "" && !indexTypes[string(i.Type)] {
+			invalid("index %q has unknown type %q", i.Name, i.Type)
+		}
+		if i.Type != model.VertexCentricIndex && i.Element != "" && i.Element != "Vertex" && i.Element != "Edge" {
+			invalid("index %q has element %q instead of Vertex or Edge", i.Name, i.Element)
+		}
+		if i.Type == model.VertexCentricIndex && i.Element == "" {
+			invalid("index %q needs the edge label as its element", i.Name)
+		}
+		if i.Direction != "" && !directions[i.Direction] {
+			invalid("index %q has unknown direction %q", i.Name, i.Direction)
+		}
+		if i.Order != "" && !orders[i.Order] {
+			invalid("index %q has unknown order %q", i.Name, i.Order)
+		}
+		for _, k := range i.Keys {
+			if k.Mapping != "" && !mappings[string(k.Mapping)] {
+				invalid("index %q has unknown mapping %q", i.Name, k.Mapping)
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return gremerror.NewGrammesError("Validate", fmt.Errorf("%w: %s", gremerror.ErrInvalidSchema, strings.Join(problems, "; ")))
+	}
+	return nil
+}
+
+// enumSet returns the string values of the enum constants.
+func enumSet(values ...fmt.Stringer) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, v := range values {
+		set[v.String()] = true
+	}
+	return set
+}
+
+func sortedStrings(values []string) []string {
+	if values == nil {
+		return nil
+	}
+	sorted := append([]string(nil), values...)
+	sort.Strings(sorted)
+	return sorted
+}
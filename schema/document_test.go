@@ -0,0 +1,198 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schema
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/model"
+	"github.com/northwesternmutual/grammes/query/cardinality"
+	"github.com/northwesternmutual/grammes/query/datatype"
+	"github.com/northwesternmutual/grammes/query/multiplicity"
+	"github.com/northwesternmutual/grammes/query/schemastatus"
+)
+
+var documentSchema = model.Schema{
+	VertexLabels: []model.VertexLabelSchema{{Name: "person", Properties: []string{"name", "age"}}, {Name: "company"}},
+	EdgeLabels:   []model.EdgeLabelSchema{{Name: "worksAt", Multiplicity: multiplicity.Many2One, Directed: true}},
+	PropertyKeys: []model.PropertyKeySchema{
+		{Name: "name", DataType: datatype.String, Cardinality: cardinality.Single},
+		{Name: "age", DataType: datatype.Integer, Cardinality: cardinality.Single},
+	},
+	Indexes: []model.IndexSchema{{
+		Name: "byName", Type: model.CompositeIndex, Element: "Vertex", Unique: true,
+		Keys: []model.IndexKeySchema{{Name: "name", Status: schemastatus.Enabled}},
+	}},
+	Connections: []model.ConnectionSchema{{EdgeLabel: "worksAt", OutVertexLabel: "person", InVertexLabel: "company"}},
+}
+
+const documentYAML = `vertexLabels:
+- name: company
+  static: false
+  partitioned: false
+- name: person
+  static: false
+  partitioned: false
+  properties:
+  - age
+  - name
+edgeLabels:
+- name: worksAt
+  multiplicity: MANY2ONE
+  directed: true
+  unidirected: false
+propertyKeys:
+- name: age
+  dataType: Integer.class
+  cardinality: single
+- name: name
+  dataType: String.class
+  cardinality: single
+indexes:
+- name: byName
+  type: composite
+  element: Vertex
+  unique: true
+  keys:
+  - name: name
+connections:
+- edgeLabel: worksAt
+  outVertexLabel: person
+  inVertexLabel: company
+`
+
+func TestEncode(t *testing.T) {
+	Convey("Given a schema", t, func() {
+		Convey("When Encode is called with YAML", func() {
+			var buf bytes.Buffer
+			err := Encode(&buf, documentSchema, YAML)
+			Convey("Then the document should be sorted and leave out statuses", func() {
+				So(err, ShouldBeNil)
+				So(buf.String(), ShouldEqual, documentYAML)
+			})
+		})
+		Convey("When Encode is called with JSON", func() {
+			var buf bytes.Buffer
+			err := Encode(&buf, documentSchema, JSON)
+			Convey("Then the document should be indented JSON", func() {
+				So(err, ShouldBeNil)
+				So(buf.String(), ShouldStartWith, "{\n  \"vertexLabels\": [\n    {\n      \"name\": \"company\",")
+				So(buf.String(), ShouldNotContainSubstring, "status")
+			})
+		})
+		Convey("When Encode is called with an unknown format", func() {
+			err := Encode(&bytes.Buffer{}, documentSchema, Format("xml"))
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+		Convey("When Encode is called twice on differently ordered schemas", func() {
+			var a, b bytes.Buffer
+			reordered := documentSchema
+			reordered.PropertyKeys = []model.PropertyKeySchema{documentSchema.PropertyKeys[1], documentSchema.PropertyKeys[0]}
+			So(Encode(&a, documentSchema, JSON), ShouldBeNil)
+			So(Encode(&b, reordered, JSON), ShouldBeNil)
+			Convey("Then the documents should be the same", func() {
+				So(a.String(), ShouldEqual, b.String())
+			})
+		})
+	})
+}
+
+func TestDecode(t *testing.T) {
+	Convey("Given documents written by Encode", t, func() {
+		var j bytes.Buffer
+		So(Encode(&j, documentSchema, JSON), ShouldBeNil)
+		Convey("When Decode is called", func() {
+			fromYAML, err := Decode(strings.NewReader(documentYAML))
+			So(err, ShouldBeNil)
+			fromJSON, err := Decode(&j)
+			So(err, ShouldBeNil)
+			Convey("Then both formats should decode to the normalized schema", func() {
+				So(fromYAML, ShouldResemble, Normalize(documentSchema))
+				So(fromJSON, ShouldResemble, fromYAML)
+			})
+		})
+	})
+	Convey("Given a document with an unknown field", t, func() {
+		Convey("When Decode is called", func() {
+			_, err := Decode(strings.NewReader("vertexLabel:\n- name: person\n"))
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+	Convey("Given a document with an unknown data type", t, func() {
+		Convey("When Decode is called", func() {
+			_, err := Decode(strings.NewReader(`{"propertyKeys": [{"name": "age", "dataType": "int"}]}`))
+			Convey("Then an invalid schema error should be returned", func() {
+				So(errors.Is(err, gremerror.ErrInvalidSchema), ShouldBeTrue)
+			})
+		})
+	})
+}
+
+func TestValidate(t *testing.T) {
+	Convey("Given a schema with problems", t, func() {
+		s := model.Schema{
+			PropertyKeys: []model.PropertyKeySchema{{Name: "name", Cardinality: "many"}, {Name: "name"}},
+			EdgeLabels:   []model.EdgeLabelSchema{{Name: "knows", Multiplicity: "SOME"}},
+			Indexes: []model.IndexSchema{
+				{Name: "byName", Element: "Person", Keys: []model.IndexKeySchema{{Name: "name", Mapping: "Mapping.FUZZY"}}},
+				{Name: "knowsBy", Type: model.VertexCentricIndex, Order: "up", Direction: "LEFT"},
+			},
+			Connections: []model.ConnectionSchema{{EdgeLabel: "knows"}},
+		}
+		Convey("When Validate is called", func() {
+			err := Validate(s)
+			Convey("Then every problem should be reported", func() {
+				So(errors.Is(err, gremerror.ErrInvalidSchema), ShouldBeTrue)
+				for _, problem := range []string{
+					`property key "name" has unknown cardinality "many"`,
+					`property key "name" is declared more than once`,
+					`edge label "knows" has unknown multiplicity "SOME"`,
+					`index "byName" has element "Person" instead of Vertex or Edge`,
+					`index "byName" has unknown mapping "Mapping.FUZZY"`,
+					`index "knowsBy" has no keys`,
+					`index "knowsBy" needs the edge label as its element`,
+					`index "knowsBy" has unknown direction "LEFT"`,
+					`index "knowsBy" has unknown order "up"`,
+					`connection "knows" needs an edge label and both vertex labels`,
+				} {
+					So(err.Error(), ShouldContainSubstring, problem)
+				}
+			})
+		})
+	})
+	Convey("Given a valid schema", t, func() {
+		Convey("When Validate is called", func() {
+			Convey("Then no error should be returned", func() {
+				So(Validate(documentSchema), ShouldBeNil)
+			})
+		})
+	})
+}
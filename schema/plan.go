@@ -0,0 +1,208 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schema
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/model"
+)
+
+// Changes are what needs to be created for the
+// graph to have the desired schema.
+type Changes struct {
+	PropertyKeys []model.PropertyKeySchema
+	VertexLabels []model.VertexLabelSchema
+	EdgeLabels   []model.EdgeLabelSchema
+	Connections  []model.ConnectionSchema
+	Properties   []LabelProperties
+	Indexes      []model.IndexSchema
+	// Reindex holds the names of the new indexes that
+	// are built on keys which already exist. These will
+	// be INSTALLED once applied and need to be
+	// registered and reindexed before they're used.
+	Reindex []string
+	// Conflicts describes everything that already exists
+	// in the graph but is declared differently. JanusGraph
+	// doesn't allow these to be changed once made.
+	Conflicts []string
+}
+
+// LabelProperties are the property keys that a vertex
+// or edge label is allowed to have but hasn't been yet.
+type LabelProperties struct {
+	Label string
+	Edge  bool
+	Keys  []string
+}
+
+// IsEmpty returns whether there is nothing to create.
+func (c Changes) IsEmpty() bool {
+	return len(c.PropertyKeys) == 0 && len(c.VertexLabels) == 0 && len(c.EdgeLabels) == 0 &&
+		len(c.Connections) == 0 && len(c.Properties) == 0 && len(c.Indexes) == 0
+}
+
+// Err returns a SchemaConflictError when
+// there are conflicts, otherwise nil.
+func (c Changes) Err() error {
+	if len(c.Conflicts) == 0 {
+		return nil
+	}
+	return gremerror.NewSchemaConflictError("Plan", c.Conflicts)
+}
+
+// Plan compares the current schema of the graph against the desired
+// schema and returns what is missing. Anything in the desired schema
+// that is left empty, such as the data type of a property key or the
+// multiplicity of an edge label, is not compared.
+func Plan(current, desired model.Schema) Changes {
+	var c Changes
+
+	for _, k := range desired.PropertyKeys {
+		existing, ok := current.PropertyKey(k.Name)
+		if !ok {
+			c.PropertyKeys = append(c.PropertyKeys, k)
+			continue
+		}
+		if k.DataType != "" && k.DataType != existing.DataType {
+			c.conflict("property key", k.Name, "data type", existing.DataType, k.DataType)
+		}
+		if k.Cardinality != "" && k.Cardinality != existing.Cardinality {
+			c.conflict("property key", k.Name, "cardinality", existing.Cardinality, k.Cardinality)
+		}
+	}
+
+	for _, l := range desired.VertexLabels {
+		existing, ok := current.VertexLabel(l.Name)
+		c.properties(l.Name, false, l.Properties, existing.Properties)
+		if !ok {
+			c.VertexLabels = append(c.VertexLabels, l)
+			continue
+		}
+		if l.Static != existing.Static {
+			c.conflict("vertex label", l.Name, "static", existing.Static, l.Static)
+		}
+		if l.Partitioned != existing.Partitioned {
+			c.conflict("vertex label", l.Name, "partitioned", existing.Partitioned, l.Partitioned)
+		}
+	}
+
+	for _, l := range desired.EdgeLabels {
+		existing, ok := current.EdgeLabel(l.Name)
+		c.properties(l.Name, true, l.Properties, existing.Properties)
+		if !ok {
+			c.EdgeLabels = append(c.EdgeLabels, l)
+			continue
+		}
+		if l.Multiplicity != "" && l.Multiplicity != existing.Multiplicity {
+			c.conflict("edge label", l.Name, "multiplicity", existing.Multiplicity, l.Multiplicity)
+		}
+		if l.Unidirected != existing.Unidirected {
+			c.conflict("edge label", l.Name, "unidirected", existing.Unidirected, l.Unidirected)
+		}
+	}
+
+	for _, conn := range desired.Connections {
+		if !current.HasConnection(conn.EdgeLabel, conn.OutVertexLabel, conn.InVertexLabel) {
+			c.Connections = append(c.Connections, conn)
+		}
+	}
+
+	for _, i := range desired.Indexes {
+		existing, ok := current.Index(i.Name)
+		if !ok {
+			c.Indexes = append(c.Indexes, i)
+			if indexesExisting(current, i) {
+				c.Reindex = append(c.Reindex, i.Name)
+			}
+			continue
+		}
+		if i.Type != "" && i.Type != existing.Type {
+			c.conflict("index", i.Name, "type", existing.Type, i.Type)
+		}
+		if i.Unique != existing.Unique {
+			c.conflict("index", i.Name, "unique", existing.Unique, i.Unique)
+		}
+		if want, got := keyNames(i), keyNames(existing); want != got {
+			c.conflict("index", i.Name, "keys", got, want)
+		}
+	}
+
+	return c
+}
+
+// properties records the property keys that
+// the label is missing from what is desired.
+func (c *Changes) properties(label string, edge bool, desired, existing []string) {
+	var missing []string
+	for _, k := range desired {
+		if !contains(existing, k) {
+			missing = append(missing, k)
+		}
+	}
+	if len(missing) > 0 {
+		c.Properties = append(c.Properties, LabelProperties{Label: label, Edge: edge, Keys: missing})
+	}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// conflict records that a field of an existing
+// schema element differs from what is desired.
+func (c *Changes) conflict(kind, name, field string, existing, desired interface{}) {
+	c.Conflicts = append(c.Conflicts,
+		fmt.Sprintf("%s %q has %s %v but %v is desired", kind, name, field, existing, desired))
+}
+
+// indexesExisting returns whether the index is built on
+// anything that already exists, which means that the
+// index cannot be enabled until it's been reindexed.
+func indexesExisting(current model.Schema, index model.IndexSchema) bool {
+	if index.Type == model.VertexCentricIndex {
+		_, ok := current.EdgeLabel(index.Element)
+		return ok
+	}
+	for _, k := range index.Keys {
+		if _, ok := current.PropertyKey(k.Name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// keyNames returns the keys of the index in order
+// as a single string so they can be compared.
+func keyNames(index model.IndexSchema) string {
+	names := make([]string, 0, len(index.Keys))
+	for _, k := range index.Keys {
+		names = append(names, k.Name)
+	}
+	return strings.Join(names, ",")
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schema
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/model"
+	"github.com/northwesternmutual/grammes/query/cardinality"
+	"github.com/northwesternmutual/grammes/query/datatype"
+	"github.com/northwesternmutual/grammes/query/multiplicity"
+)
+
+var currentSchema = model.Schema{
+	VertexLabels: []model.VertexLabelSchema{{Name: "person"}},
+	EdgeLabels:   []model.EdgeLabelSchema{{Name: "knows", Multiplicity: multiplicity.Multi, Directed: true}},
+	PropertyKeys: []model.PropertyKeySchema{{Name: "name", DataType: datatype.String, Cardinality: cardinality.Single}},
+	Indexes: []model.IndexSchema{{
+		Name: "byName", Type: model.CompositeIndex, Element: "Vertex",
+		Keys: []model.IndexKeySchema{{Name: "name"}},
+	}},
+	Connections: []model.ConnectionSchema{{EdgeLabel: "knows", OutVertexLabel: "person", InVertexLabel: "person"}},
+}
+
+func TestPlan(t *testing.T) {
+	Convey("Given the current schema of the graph", t, func() {
+		Convey("When Plan is called with the same schema", func() {
+			changes := Plan(currentSchema, currentSchema)
+			Convey("Then there should be nothing to create", func() {
+				So(changes.IsEmpty(), ShouldBeTrue)
+				So(changes.Err(), ShouldBeNil)
+			})
+		})
+		Convey("When Plan is called with additions", func() {
+			desired := currentSchema
+			desired.VertexLabels = append(desired.VertexLabels, model.VertexLabelSchema{Name: "company"})
+			desired.EdgeLabels = append(desired.EdgeLabels, model.EdgeLabelSchema{Name: "worksAt"})
+			desired.PropertyKeys = append(desired.PropertyKeys, model.PropertyKeySchema{Name: "age", DataType: datatype.Integer})
+			desired.Connections = append(desired.Connections, model.ConnectionSchema{EdgeLabel: "worksAt", OutVertexLabel: "person", InVertexLabel: "company"})
+			desired.Indexes = append(desired.Indexes,
+				model.IndexSchema{Name: "byAge", Keys: []model.IndexKeySchema{{Name: "age"}}},
+				model.IndexSchema{Name: "byNameAndAge", Keys: []model.IndexKeySchema{{Name: "name"}, {Name: "age"}}},
+			)
+			changes := Plan(currentSchema, desired)
+			Convey("Then only what's missing should be created", func() {
+				So(changes.IsEmpty(), ShouldBeFalse)
+				So(changes.VertexLabels, ShouldResemble, []model.VertexLabelSchema{{Name: "company"}})
+				So(changes.EdgeLabels, ShouldResemble, []model.EdgeLabelSchema{{Name: "worksAt"}})
+				So(changes.PropertyKeys, ShouldResemble, []model.PropertyKeySchema{{Name: "age", DataType: datatype.Integer}})
+				So(changes.Connections, ShouldHaveLength, 1)
+				So(changes.Indexes, ShouldHaveLength, 2)
+			})
+			Convey("Then indexes on existing keys should need a reindex", func() {
+				So(changes.Reindex, ShouldResemble, []string{"byNameAndAge"})
+			})
+		})
+		Convey("When Plan is called with a schema that changes existing elements", func() {
+			desired := model.Schema{
+				PropertyKeys: []model.PropertyKeySchema{{Name: "name", DataType: datatype.Long, Cardinality: cardinality.List}},
+				EdgeLabels:   []model.EdgeLabelSchema{{Name: "knows", Multiplicity: multiplicity.Simple}},
+				Indexes: []model.IndexSchema{{
+					Name: "byName", Type: model.CompositeIndex, Unique: true,
+					Keys: []model.IndexKeySchema{{Name: "name"}},
+				}},
+			}
+			changes := Plan(currentSchema, desired)
+			Convey("Then every difference should be a conflict", func() {
+				So(changes.Conflicts, ShouldHaveLength, 4)
+				So(changes.Conflicts[0], ShouldEqual, `property key "name" has data type String.class but Long.class is desired`)
+				So(errors.Is(changes.Err(), gremerror.ErrSchemaConflict), ShouldBeTrue)
+			})
+		})
+		Convey("When Plan is called with properties allowed on labels", func() {
+			desired := model.Schema{
+				VertexLabels: []model.VertexLabelSchema{{Name: "person", Properties: []string{"name"}}},
+				EdgeLabels:   []model.EdgeLabelSchema{{Name: "worksAt", Properties: []string{"since"}}},
+			}
+			current := currentSchema
+			current.VertexLabels = []model.VertexLabelSchema{{Name: "person", Properties: []string{"name"}}}
+			changes := Plan(current, desired)
+			Convey("Then only the missing properties should be added", func() {
+				So(changes.Properties, ShouldResemble, []LabelProperties{{Label: "worksAt", Edge: true, Keys: []string{"since"}}})
+			})
+		})
+		Convey("When Plan is called with fields left empty", func() {
+			desired := model.Schema{
+				PropertyKeys: []model.PropertyKeySchema{{Name: "name"}},
+				EdgeLabels:   []model.EdgeLabelSchema{{Name: "knows"}},
+			}
+			changes := Plan(currentSchema, desired)
+			Convey("Then the empty fields should not be compared", func() {
+				So(changes.IsEmpty(), ShouldBeTrue)
+				So(changes.Conflicts, ShouldBeEmpty)
+			})
+		})
+	})
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+/*
+Package schema applies a declared JanusGraph schema to the graph.
+
+Rather than adding property keys, labels, and indexes one at a time,
+the desired schema is declared as a model.Schema and wrapped in a
+versioned Migration. Plan compares the desired schema against the
+schema that's read back from the graph, and Apply creates only what
+is missing inside of a single management transaction.
+
+Every applied migration version is recorded in the graph as a vertex,
+so running the same migrations on every deploy is safe.
+*/
+package schema
+
+import (
+	"github.com/northwesternmutual/grammes/model"
+	"github.com/northwesternmutual/grammes/query/cardinality"
+	"github.com/northwesternmutual/grammes/query/datatype"
+)
+
+const (
+	// MigrationLabel is the vertex label of the
+	// vertices that record applied migrations.
+	MigrationLabel = "schemaMigration"
+	// VersionKey is the property key holding the
+	// version of an applied migration.
+	VersionKey = "schemaMigrationVersion"
+	// AppliedAtKey is the property key holding when a
+	// migration was applied in milliseconds since the epoch.
+	AppliedAtKey = "schemaMigrationAppliedAt"
+)
+
+// Migration is a version of the desired schema.
+type Migration struct {
+	Version string
+	Schema  model.Schema
+}
+
+// Querier is what is needed to read the schema
+// from the graph and change it. This is implemented
+// by the grammes Client.
+type Querier interface {
+	DescribeSchema() (model.Schema, error)
+	ExecuteStringQuery(query string) ([][]byte, error)
+}
+
+// migrationSchema is the schema used to record applied migrations.
+var migrationSchema = model.Schema{
+	VertexLabels: []model.VertexLabelSchema{
+		{Name: MigrationLabel},
+	},
+	PropertyKeys: []model.PropertyKeySchema{
+		{Name: VersionKey, DataType: datatype.String, Cardinality: cardinality.Single},
+		{Name: AppliedAtKey, DataType: datatype.Long, Cardinality: cardinality.Single},
+	},
+}
+
+// withMigrationSchema adds the schema used to record
+// applied migrations to the desired schema.
+func withMigrationSchema(desired model.Schema) model.Schema {
+	desired.VertexLabels = append(append([]model.VertexLabelSchema(nil), desired.VertexLabels...), migrationSchema.VertexLabels...)
+	desired.PropertyKeys = append(append([]model.PropertyKeySchema(nil), desired.PropertyKeys...), migrationSchema.PropertyKeys...)
+	return desired
+}
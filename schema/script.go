@@ -0,0 +1,183 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schema
+
+import (
+	"strings"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/model"
+	"github.com/northwesternmutual/grammes/query"
+	"github.com/northwesternmutual/grammes/query/cardinality"
+	"github.com/northwesternmutual/grammes/query/datatype"
+	"github.com/northwesternmutual/grammes/query/direction"
+	"github.com/northwesternmutual/grammes/query/graph"
+	"github.com/northwesternmutual/grammes/query/order"
+)
+
+// quote writes schema names as escaped Groovy strings.
+var quote = query.Quote
+
+// ManagementScript wraps the statements in a script that opens
+// management once so every statement is part of the same
+// transaction, and commits it at the end.
+func ManagementScript(statements ...string) string {
+	lines := append([]string{"mgmt = " + graph.NewGraph().OpenManagement().String()}, statements...)
+	return strings.Join(append(lines, "mgmt.commit()"), "\n")
+}
+
+// Script returns the statements that create everything in
+// the changes as a single management transaction. Every
+// statement is guarded so that nothing which was created
+// since the changes were planned will be made twice.
+func (c Changes) Script() (string, error) {
+	var (
+		mgmt       = graph.NewManagement()
+		statements []string
+	)
+
+	for _, k := range c.PropertyKeys {
+		dt, card := k.DataType, k.Cardinality
+		if dt == "" {
+			dt = datatype.Object
+		}
+		if card == "" {
+			card = cardinality.Single
+		}
+		statements = append(statements, guard("!mgmt.containsPropertyKey("+quote(k.Name)+")",
+			mgmt.MakePropertyKey(k.Name, dt, card).Make()))
+	}
+
+	for _, l := range c.VertexLabels {
+		query := mgmt.MakeVertexLabel(l.Name)
+		if l.Static {
+			query = query.SetStatic()
+		}
+		if l.Partitioned {
+			query = query.Partition()
+		}
+		statements = append(statements, guard("!mgmt.containsVertexLabel("+quote(l.Name)+")", query.Make()))
+	}
+
+	for _, l := range c.EdgeLabels {
+		query := mgmt.MakeEdgeLabel(l.Name)
+		if l.Multiplicity != "" {
+			query = query.Multiplicity(l.Multiplicity)
+		}
+		if l.Unidirected {
+			query = query.Unidirected()
+		}
+		statements = append(statements, guard("!mgmt.containsEdgeLabel("+quote(l.Name)+")", query.Make()))
+	}
+
+	for _, conn := range c.Connections {
+		statements = append(statements, guard(
+			"!"+mgmt.GetEdgeLabel(conn.EdgeLabel).MappedConnections().String()+".any { c -> "+
+				"c.getOutgoingVertexLabel().name() == "+quote(conn.OutVertexLabel)+" && "+
+				"c.getIncomingVertexLabel().name() == "+quote(conn.InVertexLabel)+" }",
+			mgmt.AddConnection(conn.EdgeLabel, conn.OutVertexLabel, conn.InVertexLabel)))
+	}
+
+	for _, p := range c.Properties {
+		for _, k := range p.Keys {
+			label, query := mgmt.GetVertexLabel(p.Label), mgmt.AddProperties(p.Label, k)
+			if p.Edge {
+				label, query = mgmt.GetEdgeLabel(p.Label), mgmt.AddEdgeProperties(p.Label, k)
+			}
+			statements = append(statements, guard(
+				"!"+label.MappedProperties().String()+".any { k -> k.name() == "+quote(k)+" }", query))
+		}
+	}
+
+	for _, i := range c.Indexes {
+		query, err := IndexStatement(i)
+		if err != nil {
+			return "", err
+		}
+		condition := "!mgmt.containsGraphIndex(" + quote(i.Name) + ")"
+		if i.Type == model.VertexCentricIndex {
+			condition = "!mgmt.containsRelationIndex(" + mgmt.GetEdgeLabel(i.Element).String() + "," + quote(i.Name) + ")"
+		}
+		statements = append(statements, guard(condition, query))
+	}
+
+	return ManagementScript(statements...), nil
+}
+
+// guard only runs the query when the condition holds.
+func guard(condition string, query graph.String) string {
+	return "if (" + condition + ") { " + query.String() + " }"
+}
+
+// IndexStatement returns the statement that builds
+// the index described by the IndexSchema.
+func IndexStatement(index model.IndexSchema) (graph.String, error) {
+	if index.Name == "" || len(index.Keys) == 0 {
+		return "", gremerror.ErrInvalidIndex
+	}
+
+	mgmt := graph.NewManagement()
+
+	if index.Type == model.VertexCentricIndex {
+		dir := direction.Direction(index.Direction)
+		if dir == "" {
+			dir = direction.Both
+		}
+		ord := order.Order(index.Order)
+		if ord == "" {
+			ord = order.Asc
+		}
+		keys := make([]string, 0, len(index.Keys))
+		for _, k := range index.Keys {
+			keys = append(keys, k.Name)
+		}
+		return mgmt.BuildEdgeIndex(index.Element, index.Name, dir, ord, keys...), nil
+	}
+
+	elementClass := "Vertex.class"
+	if index.Element == "Edge" {
+		elementClass = "Edge.class"
+	}
+
+	query := mgmt.BuildIndex(index.Name, elementClass)
+	for _, k := range index.Keys {
+		if k.Mapping != "" {
+			query = query.AddKey(k.Name, k.Mapping)
+		} else {
+			query = query.AddKey(k.Name)
+		}
+	}
+	if index.Unique {
+		query = query.Unique()
+	}
+	if index.IndexOnly != "" {
+		if elementClass == "Edge.class" {
+			query = query.IndexOnlyEdge(index.IndexOnly)
+		} else {
+			query = query.IndexOnly(index.IndexOnly)
+		}
+	}
+
+	if index.Type == model.MixedIndex {
+		return query.BuildMixedIndex(index.BackingIndex), nil
+	}
+	return query.BuildCompositeIndex(), nil
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schema
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/model"
+	"github.com/northwesternmutual/grammes/query/datatype"
+	"github.com/northwesternmutual/grammes/query/mapping"
+	"github.com/northwesternmutual/grammes/query/multiplicity"
+)
+
+func TestScript(t *testing.T) {
+	Convey("Given changes to the schema", t, func() {
+		changes := Changes{
+			PropertyKeys: []model.PropertyKeySchema{{Name: "age", DataType: datatype.Integer}, {Name: "extra"}},
+			VertexLabels: []model.VertexLabelSchema{{Name: "tag", Static: true}},
+			EdgeLabels:   []model.EdgeLabelSchema{{Name: "knows", Multiplicity: multiplicity.Simple}},
+			Connections:  []model.ConnectionSchema{{EdgeLabel: "knows", OutVertexLabel: "person", InVertexLabel: "person"}},
+			Properties:   []LabelProperties{{Label: "knows", Edge: true, Keys: []string{"age"}}},
+			Indexes: []model.IndexSchema{
+				{Name: "byAge", Keys: []model.IndexKeySchema{{Name: "age"}}},
+				{Name: "knowsByAge", Type: model.VertexCentricIndex, Element: "knows", Keys: []model.IndexKeySchema{{Name: "age"}}},
+			},
+		}
+		Convey("When Script is called", func() {
+			script, err := changes.Script()
+			Convey("Then every change should be guarded inside one management transaction", func() {
+				So(err, ShouldBeNil)
+				So(script, ShouldEqual, "mgmt = graph.openManagement()\n"+
+					"if (!mgmt.containsPropertyKey(\"age\")) { mgmt.makePropertyKey(\"age\").dataType(Integer.class).cardinality(single).make() }\n"+
+					"if (!mgmt.containsPropertyKey(\"extra\")) { mgmt.makePropertyKey(\"extra\").dataType(Object.class).cardinality(single).make() }\n"+
+					"if (!mgmt.containsVertexLabel(\"tag\")) { mgmt.makeVertexLabel(\"tag\").setStatic().make() }\n"+
+					"if (!mgmt.containsEdgeLabel(\"knows\")) { mgmt.makeEdgeLabel(\"knows\").multiplicity(SIMPLE).make() }\n"+
+					"if (!mgmt.getEdgeLabel(\"knows\").mappedConnections().any { c -> c.getOutgoingVertexLabel().name() == \"person\" && c.getIncomingVertexLabel().name() == \"person\" }) "+
+					"{ mgmt.addConnection(mgmt.getEdgeLabel(\"knows\"),mgmt.getVertexLabel(\"person\"),mgmt.getVertexLabel(\"person\")) }\n"+
+					"if (!mgmt.getEdgeLabel(\"knows\").mappedProperties().any { k -> k.name() == \"age\" }) "+
+					"{ mgmt.addProperties(mgmt.getEdgeLabel(\"knows\"),mgmt.getPropertyKey(\"age\")) }\n"+
+					"if (!mgmt.containsGraphIndex(\"byAge\")) { mgmt.buildIndex(\"byAge\",Vertex.class).addKey(mgmt.getPropertyKey(\"age\")).buildCompositeIndex() }\n"+
+					"if (!mgmt.containsRelationIndex(mgmt.getEdgeLabel(\"knows\"),\"knowsByAge\")) "+
+					"{ mgmt.buildEdgeIndex(mgmt.getEdgeLabel(\"knows\"),\"knowsByAge\",BOTH,asc,mgmt.getPropertyKey(\"age\")) }\n"+
+					"mgmt.commit()")
+			})
+		})
+		Convey("When Script is called with an invalid index", func() {
+			changes.Indexes = []model.IndexSchema{{Name: "empty"}}
+			_, err := changes.Script()
+			Convey("Then an invalid index error should be returned", func() {
+				So(err, ShouldEqual, gremerror.ErrInvalidIndex)
+			})
+		})
+	})
+
+	Convey("Given changes with names that would break out of a string", t, func() {
+		changes := Changes{
+			PropertyKeys: []model.PropertyKeySchema{{Name: `a") || true || ("`, DataType: datatype.String}},
+			Connections:  []model.ConnectionSchema{{EdgeLabel: "knows", OutVertexLabel: "${x}", InVertexLabel: `p"`}},
+			Indexes:      []model.IndexSchema{{Name: `by"${x}`, Keys: []model.IndexKeySchema{{Name: "age"}}}},
+		}
+		Convey("When Script is called", func() {
+			script, err := changes.Script()
+			Convey("Then the names should be escaped in the guards", func() {
+				So(err, ShouldBeNil)
+				So(script, ShouldContainSubstring, `!mgmt.containsPropertyKey("a\") || true || (\"")`)
+				So(script, ShouldContainSubstring, `name() == "\${x}" && c.getIncomingVertexLabel().name() == "p\""`)
+				So(script, ShouldContainSubstring, `!mgmt.containsGraphIndex("by\"\${x}")`)
+			})
+		})
+	})
+}
+
+func TestIndexStatement(t *testing.T) {
+	Convey("Given a mixed index on edges", t, func() {
+		index := model.IndexSchema{
+			Name: "search", Type: model.MixedIndex, Element: "Edge", BackingIndex: "search", IndexOnly: "knows",
+			Keys: []model.IndexKeySchema{{Name: "note", Mapping: mapping.Text}},
+		}
+		Convey("When IndexStatement is called", func() {
+			query, err := IndexStatement(index)
+			Convey("Then the mixed index should be built on the edge label", func() {
+				So(err, ShouldBeNil)
+				So(query.String(), ShouldEqual, "mgmt.buildIndex(\"search\",Edge.class)"+
+					".addKey(mgmt.getPropertyKey(\"note\"),Mapping.TEXT.asParameter())"+
+					".indexOnly(mgmt.getEdgeLabel(\"knows\")).buildMixedIndex(\"search\")")
+			})
+		})
+	})
+}
@@ -0,0 +1,149 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schema
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/model"
+	"github.com/northwesternmutual/grammes/query/cardinality"
+	"github.com/northwesternmutual/grammes/query/datatype"
+)
+
+// Vertex is embedded in a struct to mark it as a vertex.
+// The grammes tag on it holds the vertex label. Structs
+// without a marker are treated as vertices as well.
+type Vertex struct{}
+
+// Edge is embedded in a struct to mark it as an edge.
+// The grammes tag on it holds the edge label.
+type Edge struct{}
+
+var (
+	vertexType = reflect.TypeOf(Vertex{})
+	edgeType   = reflect.TypeOf(Edge{})
+	timeType   = reflect.TypeOf(time.Time{})
+)
+
+// FromStructs generates the schema from tagged structs.
+// Every struct becomes a vertex or edge label and every
+// exported field becomes a property key, with its data
+// type and cardinality inferred from the Go type. See
+// TagName for the options the struct tags accept.
+func FromStructs(values ...interface{}) (model.Schema, error) {
+	b := NewBuilder()
+
+	for _, v := range values {
+		t := reflect.TypeOf(v)
+		for t != nil && t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		if t == nil || t.Kind() != reflect.Struct {
+			return model.Schema{}, gremerror.NewGrammesError("FromStructs",
+				fmt.Errorf("%w: %v is not a struct", gremerror.ErrUnsupportedType, t))
+		}
+
+		tag, edge := markerTag(t)
+		if err := b.Element(t.Name(), tag, edge); err != nil {
+			return model.Schema{}, err
+		}
+		if err := addFields(b, t); err != nil {
+			return model.Schema{}, err
+		}
+	}
+
+	return b.Schema(), nil
+}
+
+// markerTag finds the Vertex or Edge marker of the struct
+// and returns its tag and whether the struct is an edge.
+func markerTag(t reflect.Type) (string, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Type == vertexType || f.Type == edgeType {
+			return f.Tag.Get(TagName), f.Type == edgeType
+		}
+	}
+	return "", false
+}
+
+// addFields adds a property for every exported field of
+// the struct. The fields of embedded structs are added as
+// if they were fields of the struct itself.
+func addFields(b *Builder, t reflect.Type) error {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Type == vertexType || f.Type == edgeType {
+			continue
+		}
+		tag, tagged := f.Tag.Lookup(TagName)
+		if f.Anonymous && !tagged && f.Type.Kind() == reflect.Struct && f.Type != timeType {
+			if err := addFields(b, f.Type); err != nil {
+				return err
+			}
+			continue
+		}
+		if f.PkgPath != "" {
+			continue
+		}
+
+		dt, card := InferType(f.Type)
+		if err := b.Property(f.Name, tag, dt, card); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// InferType returns the data type and cardinality of a
+// property holding the Go type. Slices and arrays are list
+// properties and maps to bool or struct{} are set properties.
+// The data type is empty when there is no matching one.
+func InferType(t reflect.Type) (datatype.DataType, cardinality.Cardinality) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch {
+	case t == timeType:
+		return datatype.Date, cardinality.Single
+	case t.Kind() == reflect.Interface:
+		return datatype.Object, cardinality.Single
+	case t.Kind() == reflect.Slice || t.Kind() == reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return "", cardinality.Single
+		}
+		dt, _ := InferType(t.Elem())
+		return dt, cardinality.List
+	case t.Kind() == reflect.Map:
+		if v := t.Elem(); v.Kind() == reflect.Bool || (v.Kind() == reflect.Struct && v.NumField() == 0) {
+			dt, _ := InferType(t.Key())
+			return dt, cardinality.Set
+		}
+		return "", cardinality.Single
+	}
+
+	dt, _ := InferDataType(t.Kind().String())
+	return dt, cardinality.Single
+}
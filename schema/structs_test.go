@@ -0,0 +1,173 @@
+// Copyright (c) 2018 Northwestern Mutual.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schema
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/northwesternmutual/grammes/gremerror"
+	"github.com/northwesternmutual/grammes/model"
+	"github.com/northwesternmutual/grammes/query/cardinality"
+	"github.com/northwesternmutual/grammes/query/datatype"
+	"github.com/northwesternmutual/grammes/query/mapping"
+	"github.com/northwesternmutual/grammes/query/multiplicity"
+)
+
+type audit struct {
+	CreatedAt time.Time `grammes:"createdAt"`
+}
+
+type testPerson struct {
+	Vertex `grammes:"person"`
+	audit
+	Name       string              `grammes:"name,index=composite,unique,indexOnly"`
+	Age        int32               `grammes:"age,index=mixed,indexName=search"`
+	Bio        *string             `grammes:"bio,index=mixed,indexName=search,mapping=TEXT"`
+	Nicknames  []string            `grammes:"nicknames"`
+	Tags       map[string]struct{} `grammes:"tags"`
+	Score      float64
+	Extra      interface{} `grammes:"extra,cardinality=list"`
+	Ignored    string      `grammes:"-"`
+	unexported string
+}
+
+type testKnows struct {
+	Edge  `grammes:"knows,multiplicity=multi,out=person,in=person"`
+	Since time.Time `grammes:"since,index=vertex-centric,order=desc"`
+}
+
+func TestFromStructs(t *testing.T) {
+	Convey("Given tagged vertex and edge structs", t, func() {
+		Convey("When FromStructs is called", func() {
+			s, err := FromStructs(testPerson{}, &testKnows{})
+			Convey("Then the labels and connections should be generated", func() {
+				So(err, ShouldBeNil)
+				So(s.VertexLabels, ShouldResemble, []model.VertexLabelSchema{{Name: "person"}})
+				So(s.EdgeLabels, ShouldResemble, []model.EdgeLabelSchema{{Name: "knows", Multiplicity: multiplicity.Multi, Directed: true}})
+				So(s.Connections, ShouldResemble, []model.ConnectionSchema{{EdgeLabel: "knows", OutVertexLabel: "person", InVertexLabel: "person"}})
+			})
+			Convey("Then the data types and cardinalities should be inferred", func() {
+				So(s.PropertyKeys, ShouldResemble, []model.PropertyKeySchema{
+					{Name: "createdAt", DataType: datatype.Date, Cardinality: cardinality.Single},
+					{Name: "name", DataType: datatype.String, Cardinality: cardinality.Single},
+					{Name: "age", DataType: datatype.Integer, Cardinality: cardinality.Single},
+					{Name: "bio", DataType: datatype.String, Cardinality: cardinality.Single},
+					{Name: "nicknames", DataType: datatype.String, Cardinality: cardinality.List},
+					{Name: "tags", DataType: datatype.String, Cardinality: cardinality.Set},
+					{Name: "score", DataType: datatype.Double, Cardinality: cardinality.Single},
+					{Name: "extra", DataType: datatype.Object, Cardinality: cardinality.List},
+					{Name: "since", DataType: datatype.Date, Cardinality: cardinality.Single},
+				})
+			})
+			Convey("Then the indexes should be generated", func() {
+				So(s.Indexes, ShouldResemble, []model.IndexSchema{
+					{Name: "byName", Type: model.CompositeIndex, Element: "Vertex", Unique: true, IndexOnly: "person",
+						Keys: []model.IndexKeySchema{{Name: "name"}}},
+					{Name: "search", Type: model.MixedIndex, Element: "Vertex", BackingIndex: "search",
+						Keys: []model.IndexKeySchema{{Name: "age"}, {Name: "bio", Mapping: mapping.Text}}},
+					{Name: "knowsBySince", Type: model.VertexCentricIndex, Element: "knows", Direction: "BOTH", Order: "desc",
+						Keys: []model.IndexKeySchema{{Name: "since"}}},
+				})
+			})
+		})
+	})
+}
+
+func TestFromStructsErrors(t *testing.T) {
+	Convey("Given a struct with an unsupported field", t, func() {
+		type bad struct {
+			Data []byte
+		}
+		Convey("When FromStructs is called", func() {
+			_, err := FromStructs(bad{})
+			Convey("Then an unsupported type error should be returned", func() {
+				So(errors.Is(err, gremerror.ErrUnsupportedType), ShouldBeTrue)
+			})
+		})
+	})
+	Convey("Given the same field overridden with a data type", t, func() {
+		type raw struct {
+			Data []byte `grammes:"data,datatype=Object"`
+		}
+		Convey("When FromStructs is called", func() {
+			s, err := FromStructs(raw{})
+			Convey("Then the override should be used", func() {
+				So(err, ShouldBeNil)
+				So(s.PropertyKeys[0].DataType, ShouldEqual, datatype.Object)
+			})
+		})
+	})
+	Convey("Given structs that declare a property key differently", t, func() {
+		type first struct {
+			Name string `grammes:"name"`
+		}
+		type second struct {
+			Name int64 `grammes:"name"`
+		}
+		Convey("When FromStructs is called", func() {
+			_, err := FromStructs(first{}, second{})
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+	Convey("Given vertex structs that index the same property", t, func() {
+		type person struct {
+			Vertex `grammes:"person"`
+			Name   string `grammes:"name,index=composite"`
+		}
+		type company struct {
+			Vertex `grammes:"company"`
+			Name   string `grammes:"name,index=composite"`
+		}
+		type label struct {
+			Vertex `grammes:"label"`
+			Name   string `grammes:"name,index=composite,indexOnly"`
+		}
+		Convey("When FromStructs is called with the same options", func() {
+			s, err := FromStructs(person{}, company{})
+			Convey("Then the key should be in the index once", func() {
+				So(err, ShouldBeNil)
+				So(s.Indexes, ShouldResemble, []model.IndexSchema{
+					{Name: "byName", Type: model.CompositeIndex, Element: "Vertex", Keys: []model.IndexKeySchema{{Name: "name"}}},
+				})
+			})
+		})
+		Convey("When FromStructs is called with different options", func() {
+			_, err := FromStructs(person{}, label{})
+			Convey("Then an invalid tag error should be returned", func() {
+				So(errors.Is(err, gremerror.ErrInvalidTag), ShouldBeTrue)
+			})
+		})
+	})
+	Convey("Given a value that isn't a struct", t, func() {
+		Convey("When FromStructs is called", func() {
+			_, err := FromStructs("person")
+			Convey("Then an unsupported type error should be returned", func() {
+				So(errors.Is(err, gremerror.ErrUnsupportedType), ShouldBeTrue)
+			})
+		})
+	})
+}
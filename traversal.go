@@ -34,6 +34,13 @@ func Traversal() traversal.String {
 	return traversal.NewTraversal()
 }
 
+// TraversalSource will return the g traversal source, which
+// is configured with strategies and side effects before the
+// traversals are started from it.
+func TraversalSource() traversal.TraversalSource {
+	return traversal.NewTraversalSource()
+}
+
 // CustomTraversal could be used when you need to specifically
 // need to change some property of the traversal.
 // This can be something such as:
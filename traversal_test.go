@@ -41,6 +41,18 @@ func TestTraversal(t *testing.T) {
 	})
 }
 
+func TestTraversalSource(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given we call the TraversalSource function", t, func() {
+		expected := traversal.NewTraversalSource()
+		s := TraversalSource()
+		Convey("Then the return value should match the expected result", func() {
+			So(s, ShouldResemble, expected)
+		})
+	})
+}
+
 func TestCustomTraversal(t *testing.T) {
 	t.Parallel()
 